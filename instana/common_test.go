@@ -1,14 +1,18 @@
 package instana_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/stretchr/testify/require"
+	"io"
 	"log"
 	"net/http"
 	"os"
+	"sync"
 	"testing"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
 	"github.com/gorilla/mux"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -18,17 +22,19 @@ import (
 const contentType = "Content-Type"
 const trueAsString = "true"
 const falseAsString = "false"
+const testProviderVersion = "1.2.3"
 
 var providerConfig = `
 provider "instana" {
 	api_token 			= "test-token"
 	endpoint 			= "localhost:%d"
     tls_skip_verify     = true
+	skip_backend_validation = true
 }
 `
 
 var testProviderFactory = map[string]func() (*schema.Provider, error){
-	"instana": func() (*schema.Provider, error) { return Provider(), nil },
+	"instana": func() (*schema.Provider, error) { return Provider(testProviderVersion), nil },
 }
 
 func appendProviderConfig(resourceConfig string, serverPort int) string {
@@ -155,3 +161,68 @@ func setValueOnResourceData(t *testing.T, r *schema.ResourceData, key string, va
 	err := r.Set(key, value)
 	require.NoError(t, err)
 }
+
+// addGeoLocationAndIPMaskingRoutes adds the sub resources of the geo location and IP masking settings of websites and
+// mobile apps to the given test server. The settings are stored in memory per website or mobile app.
+func addGeoLocationAndIPMaskingRoutes(httpServer testutils.TestHTTPServer, pathTemplate string) {
+	var mutex sync.Mutex
+	settings := make(map[string]*restapi.GeoLocationAndIPMaskingSettings)
+	getSettings := func(r *http.Request) *restapi.GeoLocationAndIPMaskingSettings {
+		id := mux.Vars(r)["id"]
+		if _, ok := settings[id]; !ok {
+			rules := ""
+			settings[id] = &restapi.GeoLocationAndIPMaskingSettings{
+				GeoLocation:     &restapi.GeoLocationConfiguration{GeoDetailRemoval: restapi.GeoDetailRemovalNoRemoval},
+				GeoMappingRules: &rules,
+				IPMasking:       &restapi.IPMaskingConfiguration{IPMasking: restapi.IPMaskingDefault},
+			}
+		}
+		return settings[id]
+	}
+	jsonHandler := func(target func(s *restapi.GeoLocationAndIPMaskingSettings) interface{}) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			value := target(getSettings(r))
+			if r.Method == http.MethodPut {
+				if err := json.NewDecoder(r.Body).Decode(value); err != nil {
+					httpServer.WriteInternalServerError(w, err)
+					return
+				}
+			}
+			data, err := json.Marshal(value)
+			if err != nil {
+				httpServer.WriteInternalServerError(w, err)
+				return
+			}
+			httpServer.WriteJSONResponse(w, data)
+		}
+	}
+	geoLocationHandler := jsonHandler(func(s *restapi.GeoLocationAndIPMaskingSettings) interface{} { return s.GeoLocation })
+	ipMaskingHandler := jsonHandler(func(s *restapi.GeoLocationAndIPMaskingSettings) interface{} { return s.IPMasking })
+	geoMappingRulesHandler := func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		current := getSettings(r)
+		if r.Method == http.MethodPut {
+			data, err := io.ReadAll(r.Body)
+			if err != nil {
+				httpServer.WriteInternalServerError(w, err)
+				return
+			}
+			rules := string(data)
+			current.GeoMappingRules = &rules
+		}
+		w.Header().Set(contentType, "text/csv")
+		_, err := w.Write([]byte(*current.GeoMappingRules))
+		if err != nil {
+			log.Fatalf("failed to write response: %s", err)
+		}
+	}
+
+	for _, method := range []string{http.MethodGet, http.MethodPut} {
+		httpServer.AddRoute(method, pathTemplate+"/geo-location", geoLocationHandler)
+		httpServer.AddRoute(method, pathTemplate+"/geo-mapping-rules", geoMappingRulesHandler)
+		httpServer.AddRoute(method, pathTemplate+"/ip-masking", ipMaskingHandler)
+	}
+}
@@ -81,13 +81,13 @@ func (ds *alertingChannelDataSource) convertSchemaMap(schemaMap map[string]*sche
 	return result
 }
 
-func (ds *alertingChannelDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (ds *alertingChannelDataSource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 
 	name := d.Get(AlertingChannelFieldName).(string)
 
-	data, err := instanaAPI.AlertingChannels().GetAll()
+	data, err := instanaAPI.AlertingChannels().GetAll(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
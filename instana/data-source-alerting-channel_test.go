@@ -337,7 +337,7 @@ func (r *dataSourceAlertingChannelUnitTest) shouldSuccessfullyReadChannel(t *tes
 		}
 
 		AlertingChannelAPI := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
-		AlertingChannelAPI.EXPECT().GetAll().Times(1).Return(&[]*restapi.AlertingChannel{&data}, nil)
+		AlertingChannelAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&[]*restapi.AlertingChannel{&data}, nil)
 		mockInstanaApi.EXPECT().AlertingChannels().Return(AlertingChannelAPI).Times(1)
 
 		sut := NewAlertingChannelDataSource().CreateResource()
@@ -370,7 +370,7 @@ func (r *dataSourceAlertingChannelUnitTest) shouldFailToReadChannelWhenApiCallFa
 		expectedError := errors.New("test")
 
 		AlertingChannelAPI := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
-		AlertingChannelAPI.EXPECT().GetAll().Times(1).Return(nil, expectedError)
+		AlertingChannelAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(nil, expectedError)
 		mockInstanaApi.EXPECT().AlertingChannels().Return(AlertingChannelAPI).Times(1)
 
 		sut := NewAlertingChannelDataSource().CreateResource()
@@ -397,7 +397,7 @@ func (r *dataSourceAlertingChannelUnitTest) shouldFailToReadChannelWhenNoChannel
 		}
 
 		AlertingChannelAPI := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
-		AlertingChannelAPI.EXPECT().GetAll().Times(1).Return(&[]*restapi.AlertingChannel{&data}, nil)
+		AlertingChannelAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&[]*restapi.AlertingChannel{&data}, nil)
 		mockInstanaApi.EXPECT().AlertingChannels().Return(AlertingChannelAPI).Times(1)
 
 		sut := NewAlertingChannelDataSource().CreateResource()
@@ -423,7 +423,7 @@ func (r *dataSourceAlertingChannelUnitTest) shouldFailToReadChannelWhenChannelTy
 		}
 
 		AlertingChannelAPI := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
-		AlertingChannelAPI.EXPECT().GetAll().Times(1).Return(&[]*restapi.AlertingChannel{&data}, nil)
+		AlertingChannelAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&[]*restapi.AlertingChannel{&data}, nil)
 		mockInstanaApi.EXPECT().AlertingChannels().Return(AlertingChannelAPI).Times(1)
 
 		sut := NewAlertingChannelDataSource().CreateResource()
@@ -0,0 +1,135 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	//DataSourceApdexReport the name of the terraform-provider-instana data source for Apdex reports
+	DataSourceApdexReport = "instana_apdex_report"
+
+	//ApdexReportFieldApdexID constant value for the schema field apdex_id
+	ApdexReportFieldApdexID = "apdex_id"
+	//ApdexReportFieldFrom constant value for the schema field from
+	ApdexReportFieldFrom = "from"
+	//ApdexReportFieldTo constant value for the schema field to
+	ApdexReportFieldTo = "to"
+	//ApdexReportFieldScores constant value for the schema field scores
+	ApdexReportFieldScores = "scores"
+	//ApdexReportFieldTimestamp constant value for the schema field scores.timestamp
+	ApdexReportFieldTimestamp = "timestamp"
+	//ApdexReportFieldScore constant value for the schema field scores.score
+	ApdexReportFieldScore = "score"
+)
+
+// NewApdexReportDataSource creates a new DataSource for Apdex reports
+func NewApdexReportDataSource() DataSource {
+	return &apdexReportDataSource{}
+}
+
+type apdexReportDataSource struct{}
+
+// CreateResource creates the terraform Resource for the data source for Instana Apdex reports
+func (ds *apdexReportDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			ApdexReportFieldApdexID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the Apdex configuration",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			ApdexReportFieldFrom: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The start of the time range as unix timestamp in milliseconds",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			ApdexReportFieldTo: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The end of the time range as unix timestamp in milliseconds",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			ApdexReportFieldScores: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Apdex scores within the time range",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						ApdexReportFieldTimestamp: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The timestamp of the score as unix timestamp in milliseconds",
+						},
+						ApdexReportFieldScore: {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The Apdex score between 0 and 1",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ds *apdexReportDataSource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	apdexID := d.Get(ApdexReportFieldApdexID).(string)
+	from := d.Get(ApdexReportFieldFrom).(int)
+	to := d.Get(ApdexReportFieldTo).(int)
+	if from > to {
+		return diag.Errorf("%s must not be after %s", ApdexReportFieldFrom, ApdexReportFieldTo)
+	}
+	queryParams := map[string]string{
+		ApdexReportFieldFrom: strconv.Itoa(from),
+		ApdexReportFieldTo:   strconv.Itoa(to),
+	}
+
+	data, err := instanaAPI.ApdexReports(apdexID).GetByQuery(ctx, queryParams)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = ds.updateState(d, apdexID, from, to, data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *apdexReportDataSource) updateState(d *schema.ResourceData, apdexID string, from int, to int, reports *[]*restapi.ApdexReport) error {
+	d.SetId(fmt.Sprintf("%s-%d-%d", apdexID, from, to))
+	return tfutils.UpdateState(d, map[string]interface{}{
+		ApdexReportFieldScores: ds.mapScoresToSchema(reports),
+	})
+}
+
+func (ds *apdexReportDataSource) mapScoresToSchema(reports *[]*restapi.ApdexReport) []interface{} {
+	result := make([]interface{}, 0)
+	for _, report := range *reports {
+		for _, score := range report.ApdexScore {
+			if len(score) < 2 {
+				continue
+			}
+			result = append(result, map[string]interface{}{
+				ApdexReportFieldTimestamp: int(score[0]),
+				ApdexReportFieldScore:     score[1],
+			})
+		}
+	}
+	return result
+}
@@ -0,0 +1,124 @@
+package instana_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+type dataSourceApdexReportUnitTest struct{}
+
+func TestApdexReportDataSource(t *testing.T) {
+	unitTest := &dataSourceApdexReportUnitTest{}
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("schema version should be 0", unitTest.schemaShouldHaveVersion0)
+	t.Run("should successfully read apdex report", unitTest.shouldSuccessfullyReadApdexReport)
+	t.Run("should fail to read apdex report when api returns error", unitTest.shouldFailToReadApdexReportWhenAPIReturnsError)
+	t.Run("should fail to read apdex report when from is after to", unitTest.shouldFailToReadApdexReportWhenFromIsAfterTo)
+}
+
+func (ut *dataSourceApdexReportUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewApdexReportDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 4)
+
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ApdexReportFieldApdexID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeInt(ApdexReportFieldFrom)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeInt(ApdexReportFieldTo)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(ApdexReportFieldScores)
+
+	scoreSchema := schemaData[ApdexReportFieldScores].Elem.(*schema.Resource).Schema
+	require.Len(t, scoreSchema, 2)
+
+	schemaAssert = testutils.NewTerraformSchemaAssert(scoreSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeInt(ApdexReportFieldTimestamp)
+	require.Equal(t, schema.TypeFloat, scoreSchema[ApdexReportFieldScore].Type)
+	require.True(t, scoreSchema[ApdexReportFieldScore].Computed)
+}
+
+func (ut *dataSourceApdexReportUnitTest) schemaShouldHaveVersion0(t *testing.T) {
+	require.Equal(t, 0, NewApdexReportDataSource().CreateResource().SchemaVersion)
+}
+
+func (ut *dataSourceApdexReportUnitTest) shouldSuccessfullyReadApdexReport(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexReport](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		data := restapi.ApdexReport{
+			ApdexID:    "apdex-id",
+			ApdexScore: [][]float64{{1698796800000, 0.95}, {1698796860000}, {1698796920000, 0.5}},
+			From:       1698796800000,
+			To:         1698796980000,
+		}
+
+		expectedQueryParams := map[string]string{"from": "1698796800000", "to": "1698796980000"}
+
+		apdexReportAPI := mocks.NewMockReadOnlyRestResource[*restapi.ApdexReport](ctrl)
+		apdexReportAPI.EXPECT().GetByQuery(gomock.Any(), expectedQueryParams).Times(1).Return(&[]*restapi.ApdexReport{&data}, nil)
+		mockInstanaApi.EXPECT().ApdexReports("apdex-id").Return(apdexReportAPI).Times(1)
+
+		sut := NewApdexReportDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			ApdexReportFieldApdexID: "apdex-id",
+			ApdexReportFieldFrom:    1698796800000,
+			ApdexReportFieldTo:      1698796980000,
+		})
+
+		diag := sut.ReadContext(context.Background(), resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, "apdex-id-1698796800000-1698796980000", resourceData.Id())
+		require.Equal(t, []interface{}{
+			map[string]interface{}{ApdexReportFieldTimestamp: 1698796800000, ApdexReportFieldScore: 0.95},
+			map[string]interface{}{ApdexReportFieldTimestamp: 1698796920000, ApdexReportFieldScore: 0.5},
+		}, resourceData.Get(ApdexReportFieldScores))
+	})
+}
+
+func (ut *dataSourceApdexReportUnitTest) shouldFailToReadApdexReportWhenAPIReturnsError(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexReport](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+		apdexReportAPI := mocks.NewMockReadOnlyRestResource[*restapi.ApdexReport](ctrl)
+		apdexReportAPI.EXPECT().GetByQuery(gomock.Any(), gomock.Any()).Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().ApdexReports("apdex-id").Return(apdexReportAPI).Times(1)
+
+		sut := NewApdexReportDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			ApdexReportFieldApdexID: "apdex-id",
+			ApdexReportFieldFrom:    1000,
+			ApdexReportFieldTo:      2000,
+		})
+
+		diag := sut.ReadContext(context.Background(), resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Equal(t, expectedError.Error(), diag[0].Summary)
+	})
+}
+
+func (ut *dataSourceApdexReportUnitTest) shouldFailToReadApdexReportWhenFromIsAfterTo(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexReport](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		sut := NewApdexReportDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			ApdexReportFieldApdexID: "apdex-id",
+			ApdexReportFieldFrom:    2000,
+			ApdexReportFieldTo:      1000,
+		})
+
+		diag := sut.ReadContext(context.Background(), resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+	})
+}
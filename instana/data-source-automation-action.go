@@ -52,14 +52,14 @@ func (ds *automationActionDataSource) CreateResource() *schema.Resource {
 	}
 }
 
-func (ds *automationActionDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (ds *automationActionDataSource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 
 	name := d.Get(AutomationActionFieldName).(string)
 	actionType := d.Get(AutomationActionFieldType).(string)
 
-	data, err := instanaAPI.AutomationActions().GetAll()
+	data, err := instanaAPI.AutomationActions().GetAll(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
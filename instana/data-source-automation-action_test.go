@@ -52,7 +52,7 @@ func (r *dataSourceAutomationActionUnitTest) shouldSuccessfullyReadAction(t *tes
 		}
 
 		AutomationActionAPI := mocks.NewMockRestResource[*restapi.AutomationAction](ctrl)
-		AutomationActionAPI.EXPECT().GetAll().Times(1).Return(&[]*restapi.AutomationAction{&data}, nil)
+		AutomationActionAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&[]*restapi.AutomationAction{&data}, nil)
 		mockInstanaApi.EXPECT().AutomationActions().Return(AutomationActionAPI).Times(1)
 
 		sut := NewAutomationActionDataSource().CreateResource()
@@ -83,7 +83,7 @@ func (r *dataSourceAutomationActionUnitTest) shouldFailToReadActionWhenApiCallFa
 		expectedError := errors.New("test")
 
 		AutomationActionAPI := mocks.NewMockRestResource[*restapi.AutomationAction](ctrl)
-		AutomationActionAPI.EXPECT().GetAll().Times(1).Return(nil, expectedError)
+		AutomationActionAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(nil, expectedError)
 		mockInstanaApi.EXPECT().AutomationActions().Return(AutomationActionAPI).Times(1)
 
 		sut := NewAutomationActionDataSource().CreateResource()
@@ -112,7 +112,7 @@ func (r *dataSourceAutomationActionUnitTest) shouldFailToReadActionWhenNoActionI
 		}
 
 		AutomationActionAPI := mocks.NewMockRestResource[*restapi.AutomationAction](ctrl)
-		AutomationActionAPI.EXPECT().GetAll().Times(1).Return(&[]*restapi.AutomationAction{&data}, nil)
+		AutomationActionAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&[]*restapi.AutomationAction{&data}, nil)
 		mockInstanaApi.EXPECT().AutomationActions().Return(AutomationActionAPI).Times(1)
 
 		sut := NewAutomationActionDataSource().CreateResource()
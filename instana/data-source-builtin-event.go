@@ -82,14 +82,14 @@ func (ds *builtInEventDataSource) CreateResource() *schema.Resource {
 	}
 }
 
-func (ds *builtInEventDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (ds *builtInEventDataSource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 
 	name := d.Get(BuiltinEventSpecificationFieldName).(string)
 	shortPluginID := d.Get(BuiltinEventSpecificationFieldShortPluginID).(string)
 
-	data, err := instanaAPI.BuiltinEventSpecifications().GetAll()
+	data, err := instanaAPI.BuiltinEventSpecifications().GetAll(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
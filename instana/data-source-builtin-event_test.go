@@ -108,7 +108,7 @@ func executeReadWithTenGeneratedObjectsAsResponse(requestedName string, requeste
 
 	response := createBuiltinEventSpecifications(10)
 	builtInEventSpecificationAPI := mocks.NewMockReadOnlyRestResource[*restapi.BuiltinEventSpecification](ctrl)
-	builtInEventSpecificationAPI.EXPECT().GetAll().Times(1).Return(response, nil)
+	builtInEventSpecificationAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(response, nil)
 	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
 	mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Times(1).Return(builtInEventSpecificationAPI)
 
@@ -133,7 +133,7 @@ func TestShouldFailtToReadBuiltInEventWhenAPIRequestFails(t *testing.T) {
 	requestedPluginId := "plugin-id-1"
 
 	builtInEventSpecificationAPI := mocks.NewMockReadOnlyRestResource[*restapi.BuiltinEventSpecification](ctrl)
-	builtInEventSpecificationAPI.EXPECT().GetAll().Times(1).Return(nil, expectedError)
+	builtInEventSpecificationAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(nil, expectedError)
 	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
 	mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Times(1).Return(builtInEventSpecificationAPI)
 
@@ -160,7 +160,7 @@ func TestShouldFailtToReadBuiltInEventWhenSeverityCannotBeConvertedFromItsCodeRe
 	builtinEvent.Severity = 100
 	response := []*restapi.BuiltinEventSpecification{builtinEvent}
 	builtInEventSpecificationAPI := mocks.NewMockReadOnlyRestResource[*restapi.BuiltinEventSpecification](ctrl)
-	builtInEventSpecificationAPI.EXPECT().GetAll().Times(1).Return(&response, nil)
+	builtInEventSpecificationAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&response, nil)
 	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
 	mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Times(1).Return(builtInEventSpecificationAPI)
 
@@ -64,14 +64,14 @@ func (ds *customEventSpecificationDataSource) CreateResource() *schema.Resource
 	}
 }
 
-func (ds *customEventSpecificationDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (ds *customEventSpecificationDataSource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 
 	name := d.Get(CustomEventSpecificationFieldName).(string)
 	entityType := d.Get(CustomEventSpecificationFieldEntityType).(string)
 
-	data, err := instanaAPI.CustomEventSpecifications().GetAll()
+	data, err := instanaAPI.CustomEventSpecifications().GetAll(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -73,7 +73,7 @@ func (r *dataSourceCustomEventSpecificationUnitTest) shouldSuccessfullyReadCusto
 		}
 
 		CustomEventSpecificationAPI := mocks.NewMockRestResource[*restapi.CustomEventSpecification](ctrl)
-		CustomEventSpecificationAPI.EXPECT().GetAll().Times(1).Return(&[]*restapi.CustomEventSpecification{&data}, nil)
+		CustomEventSpecificationAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&[]*restapi.CustomEventSpecification{&data}, nil)
 		mockInstanaApi.EXPECT().CustomEventSpecifications().Return(CustomEventSpecificationAPI).Times(1)
 
 		sut := NewCustomEventSpecificationDataSource().CreateResource()
@@ -102,7 +102,7 @@ func (r *dataSourceCustomEventSpecificationUnitTest) shouldFailToReadCustomEvent
 		expectedError := errors.New("test")
 
 		CustomEventSpecificationAPI := mocks.NewMockRestResource[*restapi.CustomEventSpecification](ctrl)
-		CustomEventSpecificationAPI.EXPECT().GetAll().Times(1).Return(nil, expectedError)
+		CustomEventSpecificationAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(nil, expectedError)
 		mockInstanaApi.EXPECT().CustomEventSpecifications().Return(CustomEventSpecificationAPI).Times(1)
 
 		sut := NewCustomEventSpecificationDataSource().CreateResource()
@@ -138,7 +138,7 @@ func (r *dataSourceCustomEventSpecificationUnitTest) shouldFailToReadCustomEvent
 		}
 
 		CustomEventSpecificationAPI := mocks.NewMockRestResource[*restapi.CustomEventSpecification](ctrl)
-		CustomEventSpecificationAPI.EXPECT().GetAll().Times(1).Return(&[]*restapi.CustomEventSpecification{&data}, nil)
+		CustomEventSpecificationAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&[]*restapi.CustomEventSpecification{&data}, nil)
 		mockInstanaApi.EXPECT().CustomEventSpecifications().Return(CustomEventSpecificationAPI).Times(1)
 
 		sut := NewCustomEventSpecificationDataSource().CreateResource()
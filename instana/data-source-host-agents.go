@@ -81,14 +81,14 @@ func (ds *hostAgentsDataSource) CreateResource() *schema.Resource {
 	}
 }
 
-func (ds *hostAgentsDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (ds *hostAgentsDataSource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 
 	filter := d.Get(HostAgentFieldFilter).(string)
 	queryParams := map[string]string{"query": filter}
 
-	data, err := instanaAPI.HostAgents().GetByQuery(queryParams)
+	data, err := instanaAPI.HostAgents().GetByQuery(ctx, queryParams)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -59,7 +59,7 @@ func (ut *dataSourceHostAgentsUnitTest) shouldSuccessfullyReadHostAgents(t *test
 		expectedQueryParams["query"] = "entity.agent.capability:action-script"
 
 		HostAgentAPI := mocks.NewMockReadOnlyRestResource[*restapi.HostAgent](ctrl)
-		HostAgentAPI.EXPECT().GetByQuery(expectedQueryParams).Times(1).Return(&[]*restapi.HostAgent{&data}, nil)
+		HostAgentAPI.EXPECT().GetByQuery(gomock.Any(), expectedQueryParams).Times(1).Return(&[]*restapi.HostAgent{&data}, nil)
 		mockInstanaApi.EXPECT().HostAgents().Return(HostAgentAPI).Times(1)
 
 		sut := NewHostAgentsDataSource().CreateResource()
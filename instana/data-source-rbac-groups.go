@@ -0,0 +1,111 @@
+package instana
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	//DataSourceRBACGroups the name of the terraform-provider-instana data source to read RBAC groups
+	DataSourceRBACGroups = "instana_rbac_groups"
+
+	//RBACGroupsFieldName constant value for the schema field name
+	RBACGroupsFieldName = "name"
+	//RBACGroupsFieldUserEmail constant value for the schema field user_email
+	RBACGroupsFieldUserEmail = "user_email"
+	//RBACGroupsFieldGroups constant value for the computed schema field groups
+	RBACGroupsFieldGroups = "groups"
+	//RBACGroupFieldID constant value for the schema field id of a group
+	RBACGroupFieldID = "id"
+	//RBACGroupFieldName constant value for the schema field name of a group
+	RBACGroupFieldName = "name"
+)
+
+// NewRBACGroupsDataSource creates a new DataSource for RBAC groups
+func NewRBACGroupsDataSource() DataSource {
+	return &rbacGroupsDataSource{}
+}
+
+type rbacGroupsDataSource struct{}
+
+// CreateResource creates the terraform Resource for the data source for Instana RBAC groups
+func (ds *rbacGroupsDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			RBACGroupsFieldName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only groups with the given name are returned.",
+			},
+			RBACGroupsFieldUserEmail: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only groups of the user with the given email address are returned.",
+			},
+			RBACGroupsFieldGroups: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of RBAC groups.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						RBACGroupFieldID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the group.",
+						},
+						RBACGroupFieldName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the group.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ds *rbacGroupsDataSource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	name := d.Get(RBACGroupsFieldName).(string)
+	userEmail := d.Get(RBACGroupsFieldUserEmail).(string)
+
+	var data *[]*restapi.Group
+	var err error
+	if len(userEmail) > 0 {
+		data, err = instanaAPI.UserGroups(userEmail).GetAll(ctx)
+	} else {
+		data, err = instanaAPI.Groups().GetAll(ctx)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groups := make([]interface{}, 0)
+	for _, group := range *data {
+		if len(name) == 0 || group.Name == name {
+			groups = append(groups, map[string]interface{}{
+				RBACGroupFieldID:   group.ID,
+				RBACGroupFieldName: group.Name,
+			})
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+	err = tfutils.UpdateState(d, map[string]interface{}{
+		RBACGroupsFieldGroups: groups,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
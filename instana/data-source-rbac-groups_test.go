@@ -0,0 +1,110 @@
+package instana_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+type dataSourceRBACGroupsUnitTest struct{}
+
+func TestRBACGroupsDataSource(t *testing.T) {
+	unitTest := &dataSourceRBACGroupsUnitTest{}
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should read all groups", unitTest.shouldReadAllGroups)
+	t.Run("should filter groups by name", unitTest.shouldFilterGroupsByName)
+	t.Run("should read groups of user", unitTest.shouldReadGroupsOfUser)
+	t.Run("should fail when groups cannot be read", unitTest.shouldFailWhenGroupsCannotBeRead)
+}
+
+var dataSourceRBACGroupsTestData = []*restapi.Group{
+	{ID: "group-1", Name: "admins"},
+	{ID: "group-2", Name: "developers"},
+}
+
+func (ut *dataSourceRBACGroupsUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewRBACGroupsDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 3)
+
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(RBACGroupsFieldName)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(RBACGroupsFieldUserEmail)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(RBACGroupsFieldGroups)
+
+	groupSchema := schemaData[RBACGroupsFieldGroups].Elem.(*schema.Resource).Schema
+	require.Len(t, groupSchema, 2)
+
+	schemaAssert = testutils.NewTerraformSchemaAssert(groupSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(RBACGroupFieldID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(RBACGroupFieldName)
+}
+
+func (ut *dataSourceRBACGroupsUnitTest) shouldReadAllGroups(t *testing.T) {
+	ut.executeReadTest(t, map[string]interface{}{}, func(ctrl *gomock.Controller, mockInstanaApi *mocks.MockInstanaAPI) {
+		groupsAPI := mocks.NewMockRestResource[*restapi.Group](ctrl)
+		groupsAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&dataSourceRBACGroupsTestData, nil)
+		mockInstanaApi.EXPECT().Groups().Return(groupsAPI).Times(1)
+	}, "group-1", "group-2")
+}
+
+func (ut *dataSourceRBACGroupsUnitTest) shouldFilterGroupsByName(t *testing.T) {
+	ut.executeReadTest(t, map[string]interface{}{RBACGroupsFieldName: "developers"}, func(ctrl *gomock.Controller, mockInstanaApi *mocks.MockInstanaAPI) {
+		groupsAPI := mocks.NewMockRestResource[*restapi.Group](ctrl)
+		groupsAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&dataSourceRBACGroupsTestData, nil)
+		mockInstanaApi.EXPECT().Groups().Return(groupsAPI).Times(1)
+	}, "group-2")
+}
+
+func (ut *dataSourceRBACGroupsUnitTest) shouldReadGroupsOfUser(t *testing.T) {
+	ut.executeReadTest(t, map[string]interface{}{RBACGroupsFieldUserEmail: "john.doe@example.com"}, func(ctrl *gomock.Controller, mockInstanaApi *mocks.MockInstanaAPI) {
+		userGroupsAPI := mocks.NewMockReadOnlyRestResource[*restapi.Group](ctrl)
+		userGroupsAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&[]*restapi.Group{dataSourceRBACGroupsTestData[0]}, nil)
+		mockInstanaApi.EXPECT().UserGroups("john.doe@example.com").Return(userGroupsAPI).Times(1)
+	}, "group-1")
+}
+
+func (ut *dataSourceRBACGroupsUnitTest) shouldFailWhenGroupsCannotBeRead(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Group](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		groupsAPI := mocks.NewMockRestResource[*restapi.Group](ctrl)
+		groupsAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(nil, errors.New("test"))
+		mockInstanaApi.EXPECT().Groups().Return(groupsAPI).Times(1)
+
+		sut := NewRBACGroupsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{})
+
+		diag := sut.ReadContext(context.Background(), resourceData, meta)
+
+		require.True(t, diag.HasError())
+	})
+}
+
+func (ut *dataSourceRBACGroupsUnitTest) executeReadTest(t *testing.T, config map[string]interface{}, setupMocks func(ctrl *gomock.Controller, mockInstanaApi *mocks.MockInstanaAPI), expectedGroupIDs ...string) {
+	testHelper := NewTestHelper[*restapi.Group](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		setupMocks(ctrl, mockInstanaApi)
+
+		sut := NewRBACGroupsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, config)
+
+		diag := sut.ReadContext(context.Background(), resourceData, meta)
+
+		require.Nil(t, diag)
+		require.NotEmpty(t, resourceData.Id())
+		groups := resourceData.Get(RBACGroupsFieldGroups).([]interface{})
+		groupIDs := make([]string, len(groups))
+		for i, group := range groups {
+			groupIDs[i] = group.(map[string]interface{})[RBACGroupFieldID].(string)
+		}
+		require.Equal(t, expectedGroupIDs, groupIDs)
+	})
+}
@@ -56,14 +56,14 @@ func (ds *syntheticLocationDataSource) CreateResource() *schema.Resource {
 	}
 }
 
-func (ds *syntheticLocationDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (ds *syntheticLocationDataSource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 
 	label := d.Get(SyntheticLocationFieldLabel).(string)
 	locationType := d.Get(SyntheticLocationFieldLocationType).(string)
 
-	data, err := instanaAPI.SyntheticLocation().GetAll()
+	data, err := instanaAPI.SyntheticLocation().GetAll(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,78 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceUser the name of the terraform-provider-instana data source to read a single user by email address
+const DataSourceUser = "instana_user"
+
+// NewUserDataSource creates a new DataSource for a single user
+func NewUserDataSource() DataSource {
+	return &userDataSource{}
+}
+
+type userDataSource struct{}
+
+// CreateResource creates the terraform Resource for the data source for a single Instana user
+func (ds *userDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			UserFieldEmail: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The email address of the user (case-insensitive).",
+			},
+			UserFieldFullName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The full name of the user.",
+			},
+		},
+	}
+}
+
+func (ds *userDataSource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	email := d.Get(UserFieldEmail).(string)
+
+	data, err := instanaAPI.Users().GetAll(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user, err := ds.findUser(email, data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(user.ID)
+	err = tfutils.UpdateState(d, map[string]interface{}{
+		UserFieldEmail:    user.Email,
+		UserFieldFullName: user.FullName,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *userDataSource) findUser(email string, data *[]*restapi.User) (*restapi.User, error) {
+	for _, user := range *data {
+		if strings.EqualFold(user.Email, email) {
+			return user, nil
+		}
+	}
+	return nil, fmt.Errorf("no user found with email address %s", email)
+}
@@ -0,0 +1,87 @@
+package instana_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+type dataSourceUserUnitTest struct{}
+
+func TestUserDataSource(t *testing.T) {
+	unitTest := &dataSourceUserUnitTest{}
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should successfully read user by email", unitTest.shouldSuccessfullyReadUserByEmail)
+	t.Run("should fail when user does not exist", unitTest.shouldFailWhenUserDoesNotExist)
+	t.Run("should fail when users cannot be read", unitTest.shouldFailWhenUsersCannotBeRead)
+}
+
+func (ut *dataSourceUserUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewUserDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 2)
+
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(UserFieldEmail)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(UserFieldFullName)
+}
+
+func (ut *dataSourceUserUnitTest) shouldSuccessfullyReadUserByEmail(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.User](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		usersAPI := mocks.NewMockReadOnlyRestResource[*restapi.User](ctrl)
+		usersAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&dataSourceUsersTestData, nil)
+		mockInstanaApi.EXPECT().Users().Return(usersAPI).Times(1)
+
+		sut := NewUserDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{UserFieldEmail: "Jane.Doe@example.com"})
+
+		diag := sut.ReadContext(context.Background(), resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, "user-2", resourceData.Id())
+		require.Equal(t, "jane.doe@example.com", resourceData.Get(UserFieldEmail))
+		require.Equal(t, "Jane Doe", resourceData.Get(UserFieldFullName))
+	})
+}
+
+func (ut *dataSourceUserUnitTest) shouldFailWhenUserDoesNotExist(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.User](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		usersAPI := mocks.NewMockReadOnlyRestResource[*restapi.User](ctrl)
+		usersAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&dataSourceUsersTestData, nil)
+		mockInstanaApi.EXPECT().Users().Return(usersAPI).Times(1)
+
+		sut := NewUserDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{UserFieldEmail: "unknown@example.com"})
+
+		diag := sut.ReadContext(context.Background(), resourceData, meta)
+
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, "no user found with email address unknown@example.com")
+	})
+}
+
+func (ut *dataSourceUserUnitTest) shouldFailWhenUsersCannotBeRead(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.User](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		usersAPI := mocks.NewMockReadOnlyRestResource[*restapi.User](ctrl)
+		usersAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(nil, errors.New("test"))
+		mockInstanaApi.EXPECT().Users().Return(usersAPI).Times(1)
+
+		sut := NewUserDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{UserFieldEmail: "jane.doe@example.com"})
+
+		diag := sut.ReadContext(context.Background(), resourceData, meta)
+
+		require.True(t, diag.HasError())
+	})
+}
@@ -0,0 +1,163 @@
+package instana
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	//DataSourceUsers the name of the terraform-provider-instana data source to read users
+	DataSourceUsers = "instana_users"
+
+	//UsersFieldEmail constant value for the schema field email
+	UsersFieldEmail = "email"
+	//UsersFieldFullName constant value for the schema field full_name
+	UsersFieldFullName = "full_name"
+	//UsersFieldGroupID constant value for the schema field group_id
+	UsersFieldGroupID = "group_id"
+	//UsersFieldUsers constant value for the computed schema field users
+	UsersFieldUsers = "users"
+	//UserFieldID constant value for the schema field id of a user
+	UserFieldID = "id"
+	//UserFieldEmail constant value for the schema field email of a user
+	UserFieldEmail = "email"
+	//UserFieldFullName constant value for the schema field full_name of a user
+	UserFieldFullName = "full_name"
+)
+
+// NewUsersDataSource creates a new DataSource for users
+func NewUsersDataSource() DataSource {
+	return &usersDataSource{}
+}
+
+type usersDataSource struct{}
+
+// CreateResource creates the terraform Resource for the data source for Instana users
+func (ds *usersDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			UsersFieldEmail: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only users with the given email address (case-insensitive) are returned.",
+			},
+			UsersFieldFullName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only users with the given full name are returned.",
+			},
+			UsersFieldGroupID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only members of the RBAC group with the given ID are returned.",
+			},
+			UsersFieldUsers: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of users.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						UserFieldID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the user.",
+						},
+						UserFieldEmail: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email address of the user.",
+						},
+						UserFieldFullName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The full name of the user.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ds *usersDataSource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	email := d.Get(UsersFieldEmail).(string)
+	fullName := d.Get(UsersFieldFullName).(string)
+	groupID := d.Get(UsersFieldGroupID).(string)
+
+	var data *[]*restapi.User
+	var err error
+	if len(email) > 0 || len(fullName) > 0 {
+		data, err = instanaAPI.UsersOverview().GetAll(ctx)
+	} else {
+		data, err = instanaAPI.Users().GetAll(ctx)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var members []restapi.APIMember
+	if len(groupID) > 0 {
+		group, err := instanaAPI.Groups().GetOne(ctx, groupID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		members = group.Members
+	}
+
+	users := make([]*restapi.User, 0)
+	for _, user := range *data {
+		if ds.matches(user, email, fullName) && (len(groupID) == 0 || ds.isMember(user, members)) {
+			users = append(users, user)
+		}
+	}
+
+	err = ds.updateState(d, users)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// matches checks if the user matches the given email address and full name. The users overview of the Instana API
+// does not support filtering. Therefore, the users are filtered client-side.
+func (ds *usersDataSource) matches(user *restapi.User, email string, fullName string) bool {
+	if len(email) > 0 && !strings.EqualFold(user.Email, email) {
+		return false
+	}
+	return len(fullName) == 0 || user.FullName == fullName
+}
+
+func (ds *usersDataSource) isMember(user *restapi.User, members []restapi.APIMember) bool {
+	for _, member := range members {
+		if member.Matches(restapi.APIMember{UserID: user.ID, Email: &user.Email}) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ds *usersDataSource) updateState(d *schema.ResourceData, users []*restapi.User) error {
+	result := make([]interface{}, len(users))
+	for i, user := range users {
+		result[i] = map[string]interface{}{
+			UserFieldID:       user.ID,
+			UserFieldEmail:    user.Email,
+			UserFieldFullName: user.FullName,
+		}
+	}
+	d.SetId(time.Now().UTC().String())
+	return tfutils.UpdateState(d, map[string]interface{}{
+		UsersFieldUsers: result,
+	})
+}
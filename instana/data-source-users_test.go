@@ -0,0 +1,146 @@
+package instana_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+type dataSourceUsersUnitTest struct{}
+
+func TestUsersDataSource(t *testing.T) {
+	unitTest := &dataSourceUsersUnitTest{}
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should read all users when no filter is provided", unitTest.shouldReadAllUsersWhenNoFilterIsProvided)
+	t.Run("should filter users by email", unitTest.shouldFilterUsersByEmail)
+	t.Run("should filter users by full name", unitTest.shouldFilterUsersByFullName)
+	t.Run("should filter users by group", unitTest.shouldFilterUsersByGroup)
+	t.Run("should fail when users cannot be read", unitTest.shouldFailWhenUsersCannotBeRead)
+	t.Run("should fail when group cannot be read", unitTest.shouldFailWhenGroupCannotBeRead)
+}
+
+var dataSourceUsersTestData = []*restapi.User{
+	{ID: "user-1", Email: "john.doe@example.com", FullName: "John Doe"},
+	{ID: "user-2", Email: "jane.doe@example.com", FullName: "Jane Doe"},
+	{ID: "user-3", Email: "john.smith@example.com", FullName: "John Doe"},
+}
+
+func (ut *dataSourceUsersUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewUsersDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 4)
+
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(UsersFieldEmail)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(UsersFieldFullName)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(UsersFieldGroupID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(UsersFieldUsers)
+
+	userSchema := schemaData[UsersFieldUsers].Elem.(*schema.Resource).Schema
+	require.Len(t, userSchema, 3)
+
+	schemaAssert = testutils.NewTerraformSchemaAssert(userSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(UserFieldID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(UserFieldEmail)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(UserFieldFullName)
+}
+
+func (ut *dataSourceUsersUnitTest) shouldReadAllUsersWhenNoFilterIsProvided(t *testing.T) {
+	ut.executeReadTest(t, map[string]interface{}{}, func(ctrl *gomock.Controller, mockInstanaApi *mocks.MockInstanaAPI) {
+		usersAPI := mocks.NewMockReadOnlyRestResource[*restapi.User](ctrl)
+		usersAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&dataSourceUsersTestData, nil)
+		mockInstanaApi.EXPECT().Users().Return(usersAPI).Times(1)
+	}, "user-1", "user-2", "user-3")
+}
+
+func (ut *dataSourceUsersUnitTest) shouldFilterUsersByEmail(t *testing.T) {
+	ut.executeReadTest(t, map[string]interface{}{UsersFieldEmail: "JOHN.DOE@example.com"}, func(ctrl *gomock.Controller, mockInstanaApi *mocks.MockInstanaAPI) {
+		usersAPI := mocks.NewMockReadOnlyRestResource[*restapi.User](ctrl)
+		usersAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&dataSourceUsersTestData, nil)
+		mockInstanaApi.EXPECT().UsersOverview().Return(usersAPI).Times(1)
+	}, "user-1")
+}
+
+func (ut *dataSourceUsersUnitTest) shouldFilterUsersByFullName(t *testing.T) {
+	ut.executeReadTest(t, map[string]interface{}{UsersFieldFullName: "John Doe"}, func(ctrl *gomock.Controller, mockInstanaApi *mocks.MockInstanaAPI) {
+		usersAPI := mocks.NewMockReadOnlyRestResource[*restapi.User](ctrl)
+		usersAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&dataSourceUsersTestData, nil)
+		mockInstanaApi.EXPECT().UsersOverview().Return(usersAPI).Times(1)
+	}, "user-1", "user-3")
+}
+
+func (ut *dataSourceUsersUnitTest) shouldFilterUsersByGroup(t *testing.T) {
+	email := "jane.doe@example.com"
+	ut.executeReadTest(t, map[string]interface{}{UsersFieldGroupID: "group-id"}, func(ctrl *gomock.Controller, mockInstanaApi *mocks.MockInstanaAPI) {
+		usersAPI := mocks.NewMockReadOnlyRestResource[*restapi.User](ctrl)
+		usersAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&dataSourceUsersTestData, nil)
+		mockInstanaApi.EXPECT().Users().Return(usersAPI).Times(1)
+		groupsAPI := mocks.NewMockRestResource[*restapi.Group](ctrl)
+		groupsAPI.EXPECT().GetOne(gomock.Any(), "group-id").Times(1).Return(&restapi.Group{ID: "group-id", Members: []restapi.APIMember{{UserID: "user-3"}, {Email: &email}}}, nil)
+		mockInstanaApi.EXPECT().Groups().Return(groupsAPI).Times(1)
+	}, "user-2", "user-3")
+}
+
+func (ut *dataSourceUsersUnitTest) shouldFailWhenUsersCannotBeRead(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.User](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		usersAPI := mocks.NewMockReadOnlyRestResource[*restapi.User](ctrl)
+		usersAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(nil, errors.New("test"))
+		mockInstanaApi.EXPECT().Users().Return(usersAPI).Times(1)
+
+		sut := NewUsersDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{})
+
+		diag := sut.ReadContext(context.Background(), resourceData, meta)
+
+		require.True(t, diag.HasError())
+	})
+}
+
+func (ut *dataSourceUsersUnitTest) shouldFailWhenGroupCannotBeRead(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.User](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		usersAPI := mocks.NewMockReadOnlyRestResource[*restapi.User](ctrl)
+		usersAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&dataSourceUsersTestData, nil)
+		mockInstanaApi.EXPECT().Users().Return(usersAPI).Times(1)
+		groupsAPI := mocks.NewMockRestResource[*restapi.Group](ctrl)
+		groupsAPI.EXPECT().GetOne(gomock.Any(), "group-id").Times(1).Return(nil, restapi.ErrEntityNotFound)
+		mockInstanaApi.EXPECT().Groups().Return(groupsAPI).Times(1)
+
+		sut := NewUsersDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{UsersFieldGroupID: "group-id"})
+
+		diag := sut.ReadContext(context.Background(), resourceData, meta)
+
+		require.True(t, diag.HasError())
+	})
+}
+
+func (ut *dataSourceUsersUnitTest) executeReadTest(t *testing.T, config map[string]interface{}, setupMocks func(ctrl *gomock.Controller, mockInstanaApi *mocks.MockInstanaAPI), expectedUserIDs ...string) {
+	testHelper := NewTestHelper[*restapi.User](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		setupMocks(ctrl, mockInstanaApi)
+
+		sut := NewUsersDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, config)
+
+		diag := sut.ReadContext(context.Background(), resourceData, meta)
+
+		require.Nil(t, diag)
+		require.NotEmpty(t, resourceData.Id())
+		users := resourceData.Get(UsersFieldUsers).([]interface{})
+		userIDs := make([]string, len(users))
+		for i, user := range users {
+			userIDs[i] = user.(map[string]interface{})[UserFieldID].(string)
+		}
+		require.Equal(t, expectedUserIDs, userIDs)
+	})
+}
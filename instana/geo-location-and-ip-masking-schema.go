@@ -0,0 +1,174 @@
+package instana
+
+import (
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	//GeoLocationFieldGeoLocation constant value for the schema field geo_location
+	GeoLocationFieldGeoLocation = "geo_location"
+	//GeoLocationFieldGeoDetailRemoval constant value for the schema field geo_location.geo_detail_removal
+	GeoLocationFieldGeoDetailRemoval = "geo_detail_removal"
+	//GeoMappingRulesFieldGeoMappingRules constant value for the schema field geo_mapping_rules
+	GeoMappingRulesFieldGeoMappingRules = "geo_mapping_rules"
+	//GeoMappingRulesFieldCSV constant value for the schema field geo_mapping_rules.csv
+	GeoMappingRulesFieldCSV = "csv"
+	//IPMaskingFieldIPMasking constant value for the schema field ip_masking
+	IPMaskingFieldIPMasking = "ip_masking"
+	//IPMaskingFieldMode constant value for the schema field ip_masking.mode
+	IPMaskingFieldMode = "mode"
+)
+
+var (
+	geoLocationSchema = &schema.Schema{
+		Type:        schema.TypeList,
+		MinItems:    0,
+		MaxItems:    1,
+		Optional:    true,
+		Computed:    true,
+		Description: "The geo location settings. The settings are not managed by terraform when the block is omitted. Removing the block leaves the settings in Instana unchanged",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				GeoLocationFieldGeoDetailRemoval: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The geo location details which are removed from the beacons",
+					ValidateFunc: validation.StringInSlice(restapi.SupportedGeoDetailRemovals.ToStringSlice(), false),
+				},
+			},
+		},
+	}
+	geoMappingRulesSchema = &schema.Schema{
+		Type:        schema.TypeList,
+		MinItems:    0,
+		MaxItems:    1,
+		Optional:    true,
+		Computed:    true,
+		Description: "The custom geo mapping rules. The rules are not managed by terraform when the block is omitted. Removing the block leaves the rules in Instana unchanged",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				GeoMappingRulesFieldCSV: {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "The custom geo mapping rules in CSV format as exported from the Instana UI",
+					DiffSuppressFunc: suppressGeoMappingRulesDiff,
+				},
+			},
+		},
+	}
+	ipMaskingSchema = &schema.Schema{
+		Type:        schema.TypeList,
+		MinItems:    0,
+		MaxItems:    1,
+		Optional:    true,
+		Computed:    true,
+		Description: "The IP masking settings. The settings are not managed by terraform when the block is omitted. Removing the block leaves the settings in Instana unchanged",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				IPMaskingFieldMode: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The masking of the IP addresses of the beacons",
+					ValidateFunc: validation.StringInSlice(restapi.SupportedIPMaskings.ToStringSlice(), false),
+				},
+			},
+		},
+	}
+)
+
+// withoutComputedValue returns a copy of the given schema of a settings block which is not computed. The block is not
+// read from the Instana API when it is omitted.
+func withoutComputedValue(settingsSchema *schema.Schema) *schema.Schema {
+	result := *settingsSchema
+	result.Computed = false
+	return &result
+}
+
+// suppressGeoMappingRulesDiff ignores differences of line endings and leading and trailing whitespaces as the Instana
+// API does not return the CSV exactly as provided
+func suppressGeoMappingRulesDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return normalizeGeoMappingRules(old) == normalizeGeoMappingRules(new)
+}
+
+func normalizeGeoMappingRules(rules string) string {
+	return strings.TrimSpace(strings.ReplaceAll(rules, "\r\n", "\n"))
+}
+
+func mapGeoLocationToState(config *restapi.GeoLocationConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			GeoLocationFieldGeoDetailRemoval: string(config.GeoDetailRemoval),
+		},
+	}
+}
+
+func mapGeoMappingRulesToState(rules *string) []interface{} {
+	if rules == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			GeoMappingRulesFieldCSV: *rules,
+		},
+	}
+}
+
+func mapIPMaskingToState(config *restapi.IPMaskingConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			IPMaskingFieldMode: string(config.IPMasking),
+		},
+	}
+}
+
+func mapGeoLocationAndIPMaskingSettingsFromState(d *schema.ResourceData) restapi.GeoLocationAndIPMaskingSettings {
+	return restapi.GeoLocationAndIPMaskingSettings{
+		GeoLocation:     mapGeoLocationFromState(d),
+		GeoMappingRules: mapGeoMappingRulesFromState(d),
+		IPMasking:       mapIPMaskingFromState(d),
+	}
+}
+
+func mapGeoLocationFromState(d *schema.ResourceData) *restapi.GeoLocationConfiguration {
+	if data, ok := getSingleNestedBlockFromState(d, GeoLocationFieldGeoLocation); ok {
+		return &restapi.GeoLocationConfiguration{
+			GeoDetailRemoval: restapi.GeoDetailRemoval(data[GeoLocationFieldGeoDetailRemoval].(string)),
+		}
+	}
+	return nil
+}
+
+func mapGeoMappingRulesFromState(d *schema.ResourceData) *string {
+	if data, ok := getSingleNestedBlockFromState(d, GeoMappingRulesFieldGeoMappingRules); ok {
+		rules := data[GeoMappingRulesFieldCSV].(string)
+		return &rules
+	}
+	return nil
+}
+
+func mapIPMaskingFromState(d *schema.ResourceData) *restapi.IPMaskingConfiguration {
+	if data, ok := getSingleNestedBlockFromState(d, IPMaskingFieldIPMasking); ok {
+		return &restapi.IPMaskingConfiguration{
+			IPMasking: restapi.IPMasking(data[IPMaskingFieldMode].(string)),
+		}
+	}
+	return nil
+}
+
+func getSingleNestedBlockFromState(d *schema.ResourceData, key string) (map[string]interface{}, bool) {
+	list, ok := d.Get(key).([]interface{})
+	if !ok || len(list) != 1 || list[0] == nil {
+		return nil, false
+	}
+	return list[0].(map[string]interface{}), true
+}
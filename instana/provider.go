@@ -2,9 +2,14 @@ package instana
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,25 +18,92 @@ import (
 // SchemaFieldAPIToken the name of the provider configuration option for the api token
 const SchemaFieldAPIToken = "api_token"
 
+// SchemaFieldAPITokenFile the name of the provider configuration option for the path to a file containing the api token
+const SchemaFieldAPITokenFile = "api_token_file"
+
+// SchemaFieldAPITokenCommand the name of the provider configuration option for the command printing the api token
+const SchemaFieldAPITokenCommand = "api_token_command"
+
+// SchemaFieldAuthorizationScheme the name of the provider configuration option for the scheme of the Authorization header
+const SchemaFieldAuthorizationScheme = "authorization_scheme"
+
 // SchemaFieldEndpoint the name of the provider configuration option for the instana endpoint
 const SchemaFieldEndpoint = "endpoint"
 
 // SchemaFieldTlsSkipVerify flag to deactivate skip tls verification
 const SchemaFieldTlsSkipVerify = "tls_skip_verify"
 
+// SchemaFieldMaxRetries the name of the provider configuration option for the maximum number of retries of failed API requests
+const SchemaFieldMaxRetries = "max_retries"
+
+// SchemaFieldMaxRetryWait the name of the provider configuration option for the maximum wait time in seconds between two retries
+const SchemaFieldMaxRetryWait = "max_retry_wait"
+
+// SchemaFieldRetryableStatusCodes the name of the provider configuration option for the HTTP status codes which should be retried
+const SchemaFieldRetryableStatusCodes = "retryable_status_codes"
+
+// SchemaFieldMaxRequestsPerSecond the name of the provider configuration option for the maximum number of API requests per second
+const SchemaFieldMaxRequestsPerSecond = "max_requests_per_second"
+
+// SchemaFieldMaxWriteRequestsPerSecond the name of the provider configuration option for the maximum number of write API requests per second
+const SchemaFieldMaxWriteRequestsPerSecond = "max_write_requests_per_second"
+
+// SchemaFieldMaxThrottlePause the name of the provider configuration option for the maximum time in seconds requests are paused when requested by the API
+const SchemaFieldMaxThrottlePause = "max_throttle_pause"
+
+// SchemaFieldProxyURL the name of the provider configuration option for the URL of the HTTP proxy
+const SchemaFieldProxyURL = "proxy_url"
+
+// SchemaFieldCACertificate the name of the provider configuration option for the PEM encoded CA certificates
+const SchemaFieldCACertificate = "ca_certificate"
+
+// SchemaFieldCACertificateFile the name of the provider configuration option for the path to the PEM encoded CA certificates
+const SchemaFieldCACertificateFile = "ca_certificate_file"
+
+// SchemaFieldClientCertificate the name of the provider configuration option for the PEM encoded client certificate
+const SchemaFieldClientCertificate = "client_certificate"
+
+// SchemaFieldClientCertificateFile the name of the provider configuration option for the path to the PEM encoded client certificate
+const SchemaFieldClientCertificateFile = "client_certificate_file"
+
+// SchemaFieldClientKey the name of the provider configuration option for the PEM encoded private key of the client certificate
+const SchemaFieldClientKey = "client_key"
+
+// SchemaFieldClientKeyFile the name of the provider configuration option for the path to the PEM encoded private key of the client certificate
+const SchemaFieldClientKeyFile = "client_key_file"
+
+// SchemaFieldSkipBackendValidation the name of the provider configuration option to skip the validation of the credentials and the backend during provider configuration
+const SchemaFieldSkipBackendValidation = "skip_backend_validation"
+
+// MinimumSupportedBackendVersion the oldest release of the Instana backend which is supported by this provider
+var MinimumSupportedBackendVersion = restapi.BackendVersion{Major: 3, Minor: 220}
+
 // ProviderMeta data structure for the metadata which is configured and provided to the resources by this provider
 type ProviderMeta struct {
 	InstanaAPI restapi.InstanaAPI
 }
 
-// Provider interface implementation of hashicorp terraform provider
-func Provider() *schema.Provider {
-	return &schema.Provider{
-		Schema:               providerSchema(),
-		ResourcesMap:         providerResources(),
-		DataSourcesMap:       providerDataSources(),
-		ConfigureContextFunc: providerConfigure,
+// Provider interface implementation of hashicorp terraform provider. The version is the version of the provider which
+// is injected at build time and reported to the Instana API as part of the User-Agent header
+func Provider(version string) *schema.Provider {
+	provider := &schema.Provider{
+		Schema:         providerSchema(),
+		ResourcesMap:   providerResources(),
+		DataSourcesMap: providerDataSources(),
+	}
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		return providerConfigure(ctx, d, createUserAgent(version, provider.TerraformVersion))
+	}
+	return provider
+}
+
+// createUserAgent creates the User-Agent header value for requests to the Instana API
+func createUserAgent(providerVersion string, terraformVersion string) string {
+	userAgent := fmt.Sprintf("terraform-provider-instana/%s", providerVersion)
+	if terraformVersion != "" {
+		userAgent = fmt.Sprintf("%s (terraform %s)", userAgent, terraformVersion)
 	}
+	return userAgent
 }
 
 func providerSchema() map[string]*schema.Schema {
@@ -39,15 +111,35 @@ func providerSchema() map[string]*schema.Schema {
 		SchemaFieldAPIToken: {
 			Type:        schema.TypeString,
 			Sensitive:   true,
-			Required:    true,
+			Optional:    true,
 			DefaultFunc: schema.EnvDefaultFunc("INSTANA_API_TOKEN", nil),
-			Description: "API token used to authenticate with the Instana Backend",
+			Description: "API token used to authenticate with the Instana Backend. Exactly one of api_token, api_token_file or api_token_command must be provided",
+		},
+		SchemaFieldAPITokenFile: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("INSTANA_API_TOKEN_FILE", nil),
+			Description: "Path to a file containing the API token used to authenticate with the Instana Backend. The file is read again when the token is rejected so that rotated tokens are picked up",
+		},
+		SchemaFieldAPITokenCommand: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MinItems:    1,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Command (executable followed by its arguments) printing the API token used to authenticate with the Instana Backend to stdout. The command is executed again when the token is rejected",
+		},
+		SchemaFieldAuthorizationScheme: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      string(restapi.AuthorizationSchemeAPIToken),
+			ValidateFunc: validation.StringInSlice(restapi.SupportedAuthorizationSchemes.ToStringSlice(), false),
+			Description:  "The scheme of the Authorization header. Use apiToken for API tokens created in Instana and Bearer for OAuth style bearer tokens",
 		},
 		SchemaFieldEndpoint: {
 			Type:        schema.TypeString,
 			Required:    true,
 			DefaultFunc: schema.EnvDefaultFunc("INSTANA_ENDPOINT", nil),
-			Description: "The DNS Name of the Instana Endpoint (eg. saas-eu-west-1.instana.io)",
+			Description: "The DNS Name of the Instana Endpoint (eg. saas-eu-west-1.instana.io) or the full URL of the Instana API including scheme, port and base path (eg. https://instana.example.com:8443/instana)",
 		},
 		SchemaFieldTlsSkipVerify: {
 			Type:        schema.TypeBool,
@@ -55,6 +147,99 @@ func providerSchema() map[string]*schema.Schema {
 			Default:     false,
 			Description: "If set to true, TLS verification will be skipped when calling Instana API",
 		},
+		SchemaFieldProxyURL: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			Description:  "The URL of the HTTP proxy used to call the Instana API. If not set, the proxy is taken from the environment variables HTTP_PROXY, HTTPS_PROXY and NO_PROXY",
+		},
+		SchemaFieldCACertificate: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{SchemaFieldCACertificateFile},
+			Description:   "PEM encoded CA certificates which are trusted in addition to the system certificates when calling Instana API",
+		},
+		SchemaFieldCACertificateFile: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{SchemaFieldCACertificate},
+			Description:   "Path to a file containing PEM encoded CA certificates which are trusted in addition to the system certificates when calling Instana API",
+		},
+		SchemaFieldClientCertificate: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{SchemaFieldClientCertificateFile},
+			Description:   "PEM encoded client certificate used for mutual TLS authentication with the Instana API",
+		},
+		SchemaFieldClientCertificateFile: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{SchemaFieldClientCertificate},
+			Description:   "Path to a file containing the PEM encoded client certificate used for mutual TLS authentication with the Instana API",
+		},
+		SchemaFieldClientKey: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ConflictsWith: []string{SchemaFieldClientKeyFile},
+			Description:   "PEM encoded private key of the client certificate used for mutual TLS authentication with the Instana API",
+		},
+		SchemaFieldClientKeyFile: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{SchemaFieldClientKey},
+			Description:   "Path to a file containing the PEM encoded private key of the client certificate used for mutual TLS authentication with the Instana API",
+		},
+		SchemaFieldSkipBackendValidation: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "If set to true, the API token, the reachability and the version of the Instana backend are not validated during provider configuration. Useful for air-gapped plans",
+		},
+		SchemaFieldMaxRetries: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      restapi.DefaultMaxRetries,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The maximum number of retries of API requests which failed due to transient errors. Set to 0 to disable retries",
+		},
+		SchemaFieldMaxRetryWait: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      int(restapi.DefaultMaxRetryWait / time.Second),
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The maximum time in seconds to wait between two retries of an API request",
+		},
+		SchemaFieldRetryableStatusCodes: {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(400, 599),
+			},
+			Optional:    true,
+			Description: "The HTTP status codes which are considered as transient errors and retried (default: 429, 502, 503, 504)",
+		},
+		SchemaFieldMaxRequestsPerSecond: {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      float64(restapi.DefaultMaxRequestsPerSecond),
+			ValidateFunc: validation.FloatAtLeast(0),
+			Description:  "The maximum number of requests per second sent to the Instana API. Set to 0 to disable the limit",
+		},
+		SchemaFieldMaxWriteRequestsPerSecond: {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      float64(restapi.DefaultMaxWriteRequestsPerSecond),
+			ValidateFunc: validation.FloatAtLeast(0),
+			Description:  "The maximum number of write requests (POST, PUT, DELETE) per second sent to the Instana API. Set to 0 to disable the limit",
+		},
+		SchemaFieldMaxThrottlePause: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      int(restapi.DefaultMaxThrottlePause / time.Second),
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The maximum time in seconds requests are paused when the Instana API reports that the rate limit is exceeded",
+		},
 	}
 }
 
@@ -64,9 +249,14 @@ func providerResources() map[string]*schema.Resource {
 	bindResourceHandle(resources, NewApplicationConfigResourceHandle())
 	bindResourceHandle(resources, NewApplicationAlertConfigResourceHandle())
 	bindResourceHandle(resources, NewGlobalApplicationAlertConfigResourceHandle())
+	bindResourceHandle(resources, NewHTTPEndpointConfigResourceHandle())
+	bindResourceHandle(resources, NewManualServiceResourceHandle())
+	bindResourceHandle(resources, NewServiceConfigResourceHandle())
+	bindResourceHandle(resources, NewApdexConfigResourceHandle())
 	bindResourceHandle(resources, NewCustomEventSpecificationResourceHandle())
 	bindResourceHandle(resources, NewAlertingChannelResourceHandle())
 	bindResourceHandle(resources, NewAlertingConfigResourceHandle())
+	bindResourceHandle(resources, NewGlobalCustomPayloadConfigurationResourceHandle())
 	bindResourceHandle(resources, NewSliConfigResourceHandle())
 	bindResourceHandle(resources, NewSloConfigResourceHandle())
 	bindResourceHandle(resources, NewSloAlertConfigResourceHandle())
@@ -75,10 +265,23 @@ func providerResources() map[string]*schema.Resource {
 	bindResourceHandle(resources, NewInfraAlertConfigResourceHandle())
 	bindResourceHandle(resources, NewWebsiteAlertConfigResourceHandle())
 	bindResourceHandle(resources, NewGroupResourceHandle())
+	bindResourceHandle(resources, NewGroupMappingResourceHandle())
+	bindResourceHandle(resources, NewGroupMappingSettingsResourceHandle())
+	bindResourceHandle(resources, NewGroupMembershipResourceHandle())
+	bindResourceHandle(resources, NewUserInvitationResourceHandle())
+	bindResourceHandle(resources, NewSessionSettingsResourceHandle())
+	bindResourceHandle(resources, NewSyntheticCallsSettingsResourceHandle())
 	bindResourceHandle(resources, NewCustomDashboardResourceHandle())
 	bindResourceHandle(resources, NewSyntheticTestResourceHandle())
+	bindResourceHandle(resources, NewSyntheticCredentialResourceHandle())
 	bindResourceHandle(resources, NewAutomationActionResourceHandle())
 	bindResourceHandle(resources, NewAutomationPolicyResourceHandle())
+	bindResourceHandle(resources, NewMaintenanceWindowResourceHandle())
+	bindResourceHandle(resources, NewReleaseResourceHandle())
+	bindResourceHandle(resources, NewMobileAppConfigResourceHandle())
+	bindResourceHandle(resources, NewMobileAppAlertConfigResourceHandle())
+	bindResourceHandle(resources, NewGlobalSyntheticAlertConfigResourceHandle())
+	bindResourceHandle(resources, NewWebsiteSourceMapUploadResourceHandle())
 	return resources
 }
 
@@ -86,14 +289,158 @@ func bindResourceHandle[T restapi.InstanaDataObject](resources map[string]*schem
 	resources[resourceHandle.MetaData().ResourceName] = NewTerraformResource(resourceHandle).ToSchemaResource()
 }
 
-func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	apiToken := strings.TrimSpace(d.Get(SchemaFieldAPIToken).(string))
-	endpoint := strings.TrimSpace(d.Get(SchemaFieldEndpoint).(string))
-	skipTlsVerify := d.Get(SchemaFieldTlsSkipVerify).(bool)
-	instanaAPI := restapi.NewInstanaAPI(apiToken, endpoint, skipTlsVerify)
+func providerConfigure(ctx context.Context, d *schema.ResourceData, userAgent string) (interface{}, diag.Diagnostics) {
+	tlsConfig, err := mapTLSConfig(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	authenticator, err := mapAuthenticator(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	clientConfig := restapi.ClientConfig{
+		Authenticator: authenticator,
+		Endpoint:      strings.TrimSpace(d.Get(SchemaFieldEndpoint).(string)),
+		UserAgent:     userAgent,
+		ProxyURL:      strings.TrimSpace(d.Get(SchemaFieldProxyURL).(string)),
+		TLS:           tlsConfig,
+		Retry:         mapRetryConfig(d),
+		Throttle:      mapThrottleConfig(d),
+	}
+	instanaAPI, err := restapi.NewInstanaAPI(clientConfig)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	var diags diag.Diagnostics
+	if !d.Get(SchemaFieldSkipBackendValidation).(bool) {
+		diags = validateBackend(ctx, instanaAPI, clientConfig.Endpoint)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
 	return &ProviderMeta{
 		InstanaAPI: instanaAPI,
-	}, nil
+	}, diags
+}
+
+// validateBackend verifies that the Instana backend is reachable, accepts the API token and runs a supported version.
+// An unhealthy backend is reported as a warning only
+func validateBackend(ctx context.Context, instanaAPI restapi.InstanaAPI, endpoint string) diag.Diagnostics {
+	versionInfo, err := instanaAPI.Version(ctx)
+	if errors.Is(err, restapi.ErrUnauthorized) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Invalid Instana API token",
+			Detail:   fmt.Sprintf("The Instana backend at %s rejected the API token. Please verify the configured %s.", endpoint, SchemaFieldAPIToken),
+		}}
+	}
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Instana backend not reachable",
+			Detail:   fmt.Sprintf("Failed to retrieve the version of the Instana backend at %s. Please verify the configured %s or set %s to true to skip this check; %s", endpoint, SchemaFieldEndpoint, SchemaFieldSkipBackendValidation, err),
+		}}
+	}
+
+	var diags diag.Diagnostics
+	backendVersion, err := restapi.ParseBackendVersion(versionInfo.ImageTag)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unknown Instana backend version",
+			Detail:   fmt.Sprintf("The version of the Instana backend at %s cannot be determined; %s", endpoint, err),
+		})
+	} else if !backendVersion.IsAtLeast(MinimumSupportedBackendVersion) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unsupported Instana backend version",
+			Detail:   fmt.Sprintf("The Instana backend at %s runs version %s. This provider requires at least version %s.", endpoint, backendVersion, MinimumSupportedBackendVersion),
+		}}
+	}
+
+	healthState, err := instanaAPI.Health(ctx)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unknown Instana backend health state",
+			Detail:   fmt.Sprintf("Failed to retrieve the health state of the Instana backend at %s; %s", endpoint, err),
+		})
+	}
+	if healthState.Health != restapi.HealthStateGreen {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Instana backend health state is %s", healthState.Health),
+			Detail:   strings.Join(healthState.Messages, "\n"),
+		})
+	}
+	return diags
+}
+
+func mapAuthenticator(d *schema.ResourceData) (restapi.Authenticator, error) {
+	tokenSources := make([]restapi.TokenSource, 0, 1)
+	if apiToken, ok := d.GetOk(SchemaFieldAPIToken); ok {
+		tokenSources = append(tokenSources, restapi.NewStaticTokenSource(strings.TrimSpace(apiToken.(string))))
+	}
+	if apiTokenFile, ok := d.GetOk(SchemaFieldAPITokenFile); ok {
+		tokenSources = append(tokenSources, restapi.NewFileTokenSource(apiTokenFile.(string)))
+	}
+	if _, ok := d.GetOk(SchemaFieldAPITokenCommand); ok {
+		tokenSources = append(tokenSources, restapi.NewCommandTokenSource(ReadArrayParameterFromResource[string](d, SchemaFieldAPITokenCommand)))
+	}
+	if len(tokenSources) != 1 {
+		return nil, fmt.Errorf("exactly one of %s, %s or %s must be provided", SchemaFieldAPIToken, SchemaFieldAPITokenFile, SchemaFieldAPITokenCommand)
+	}
+	scheme := restapi.AuthorizationScheme(d.Get(SchemaFieldAuthorizationScheme).(string))
+	return restapi.NewTokenAuthenticator(scheme, tokenSources[0]), nil
+}
+
+func mapTLSConfig(d *schema.ResourceData) (restapi.TLSConfig, error) {
+	tlsConfig := restapi.TLSConfig{SkipVerification: d.Get(SchemaFieldTlsSkipVerify).(bool)}
+	var err error
+	if tlsConfig.CACertificates, err = readInlineOrFileValue(d, SchemaFieldCACertificate, SchemaFieldCACertificateFile); err != nil {
+		return tlsConfig, err
+	}
+	if tlsConfig.ClientCertificate, err = readInlineOrFileValue(d, SchemaFieldClientCertificate, SchemaFieldClientCertificateFile); err != nil {
+		return tlsConfig, err
+	}
+	if tlsConfig.ClientKey, err = readInlineOrFileValue(d, SchemaFieldClientKey, SchemaFieldClientKeyFile); err != nil {
+		return tlsConfig, err
+	}
+	return tlsConfig, nil
+}
+
+// readInlineOrFileValue returns the value of the inline field when set. Otherwise, the content of the file referenced by
+// the file field is returned. An empty string is returned when none of the fields is set.
+func readInlineOrFileValue(d *schema.ResourceData, inlineField string, fileField string) (string, error) {
+	if value, ok := d.GetOk(inlineField); ok {
+		return value.(string), nil
+	}
+	if path, ok := d.GetOk(fileField); ok {
+		content, err := os.ReadFile(path.(string))
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s; %w", fileField, err)
+		}
+		return string(content), nil
+	}
+	return "", nil
+}
+
+func mapRetryConfig(d *schema.ResourceData) restapi.RetryConfig {
+	retryConfig := restapi.DefaultRetryConfig()
+	retryConfig.MaxRetries = d.Get(SchemaFieldMaxRetries).(int)
+	retryConfig.MaxWait = time.Duration(d.Get(SchemaFieldMaxRetryWait).(int)) * time.Second
+	if statusCodes, ok := d.GetOk(SchemaFieldRetryableStatusCodes); ok {
+		retryConfig.RetryableStatusCodes = ConvertInterfaceSlice[int](statusCodes.(*schema.Set).List())
+	}
+	return retryConfig
+}
+
+func mapThrottleConfig(d *schema.ResourceData) restapi.ThrottleConfig {
+	return restapi.ThrottleConfig{
+		MaxRequestsPerSecond:      d.Get(SchemaFieldMaxRequestsPerSecond).(float64),
+		MaxWriteRequestsPerSecond: d.Get(SchemaFieldMaxWriteRequestsPerSecond).(float64),
+		MaxPause:                  time.Duration(d.Get(SchemaFieldMaxThrottlePause).(int)) * time.Second,
+	}
 }
 
 func providerDataSources() map[string]*schema.Resource {
@@ -104,5 +451,9 @@ func providerDataSources() map[string]*schema.Resource {
 	dataSources[DataSourceAutomationAction] = NewAutomationActionDataSource().CreateResource()
 	dataSources[DataSourceCustomEventSpec] = NewCustomEventSpecificationDataSource().CreateResource()
 	dataSources[DataSourceHostAgents] = NewHostAgentsDataSource().CreateResource()
+	dataSources[DataSourceApdexReport] = NewApdexReportDataSource().CreateResource()
+	dataSources[DataSourceUsers] = NewUsersDataSource().CreateResource()
+	dataSources[DataSourceUser] = NewUserDataSource().CreateResource()
+	dataSources[DataSourceRBACGroups] = NewRBACGroupsDataSource().CreateResource()
 	return dataSources
 }
@@ -1,40 +1,118 @@
 package instana_test
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestProviderShouldValidateInternally(t *testing.T) {
-	err := Provider().InternalValidate()
+	err := Provider(testProviderVersion).InternalValidate()
 
 	assert.Nil(t, err)
 }
 
 func TestProviderShouldContainValidSchemaDefinition(t *testing.T) {
-	config := Provider()
+	config := Provider(testProviderVersion)
 
 	assert.NotNil(t, config.Schema)
-	assert.Equal(t, 3, len(config.Schema))
+	assert.Equal(t, 20, len(config.Schema))
 
 	schemaAssert := testutils.NewTerraformSchemaAssert(config.Schema, t)
-	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SchemaFieldAPIToken)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldAPIToken)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldAPITokenFile)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfStrings(SchemaFieldAPITokenCommand)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeStringWithDefault(SchemaFieldAuthorizationScheme, string(restapi.AuthorizationSchemeAPIToken))
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SchemaFieldEndpoint)
 	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SchemaFieldTlsSkipVerify, false)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SchemaFieldSkipBackendValidation, false)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SchemaFieldMaxRetries)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SchemaFieldMaxRetryWait)
+	assert.Equal(t, schema.TypeSet, config.Schema[SchemaFieldRetryableStatusCodes].Type)
+	assert.True(t, config.Schema[SchemaFieldRetryableStatusCodes].Optional)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeFloat(SchemaFieldMaxRequestsPerSecond)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeFloat(SchemaFieldMaxWriteRequestsPerSecond)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SchemaFieldMaxThrottlePause)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldProxyURL)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldCACertificate)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldCACertificateFile)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldClientCertificate)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldClientCertificateFile)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldClientKey)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldClientKeyFile)
+	assert.True(t, config.Schema[SchemaFieldClientKey].Sensitive)
+}
+
+func TestProviderShouldFailToConfigureWhenCACertificateFileDoesNotExist(t *testing.T) {
+	provider := Provider(testProviderVersion)
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		SchemaFieldAPIToken:          "api-token",
+		SchemaFieldEndpoint:          "localhost",
+		SchemaFieldCACertificateFile: "/not/existing/ca.pem",
+	})
+
+	diags := provider.Configure(context.Background(), config)
+
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, SchemaFieldCACertificateFile)
+}
+
+func TestProviderShouldConfigureClientWithCACertificateAndClientCertificateFiles(t *testing.T) {
+	rootFolder, err := testutils.GetRootFolder()
+	assert.NoError(t, err)
+	provider := Provider(testProviderVersion)
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		SchemaFieldAPIToken:              "api-token",
+		SchemaFieldEndpoint:              "https://localhost:8443/instana",
+		SchemaFieldProxyURL:              "http://proxy.example.com:3128",
+		SchemaFieldCACertificateFile:     rootFolder + "/testutils/test-server.pem",
+		SchemaFieldClientCertificateFile: rootFolder + "/testutils/test-server.pem",
+		SchemaFieldClientKeyFile:         rootFolder + "/testutils/test-server.key",
+		SchemaFieldSkipBackendValidation: true,
+	})
+
+	diags := provider.Configure(context.Background(), config)
+
+	assert.False(t, diags.HasError())
+	assert.NotNil(t, provider.Meta())
+}
+
+func TestProviderShouldFailToConfigureWhenEndpointIsInvalid(t *testing.T) {
+	provider := Provider(testProviderVersion)
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		SchemaFieldAPIToken: "api-token",
+		SchemaFieldEndpoint: "ftp://localhost",
+	})
+
+	diags := provider.Configure(context.Background(), config)
+
+	assert.True(t, diags.HasError())
 }
 
 func TestProviderShouldContainValidResourceDefinitions(t *testing.T) {
-	config := Provider()
+	config := Provider(testProviderVersion)
 
-	assert.Equal(t, 19, len(config.ResourcesMap))
+	assert.Equal(t, 37, len(config.ResourcesMap))
 
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAPIToken])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApplicationConfig])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApplicationAlertConfig])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGlobalApplicationAlertConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaHTTPEndpointConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaManualService])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaServiceConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApdexConfig])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaSliConfig])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaSloConfig])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaSloAlertConfig])
@@ -43,19 +121,33 @@ func TestProviderShouldContainValidResourceDefinitions(t *testing.T) {
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaWebsiteAlertConfig])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaInfraAlertConfig])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGroup])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGroupMapping])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGroupMappingSettings])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGroupMembership])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaUserInvitation])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaSessionSettings])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaSyntheticCallsSettings])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaCustomDashboard])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaSyntheticTest])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaSyntheticCredential])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaCustomEventSpecification])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAlertingChannel])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAlertingConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGlobalCustomPayloadConfiguration])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAutomationAction])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAutomationPolicy])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMaintenanceWindow])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaRelease])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMobileAppConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMobileAppAlertConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGlobalSyntheticAlertConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaWebsiteSourceMapUpload])
 }
 
 func TestProviderShouldContainValidDataSourceDefinitions(t *testing.T) {
-	config := Provider()
+	config := Provider(testProviderVersion)
 
-	assert.Equal(t, 6, len(config.DataSourcesMap))
+	assert.Equal(t, 10, len(config.DataSourcesMap))
 
 	assert.NotNil(t, config.DataSourcesMap[DataSourceBuiltinEvent])
 	assert.NotNil(t, config.DataSourcesMap[DataSourceCustomEventSpec])
@@ -63,4 +155,217 @@ func TestProviderShouldContainValidDataSourceDefinitions(t *testing.T) {
 	assert.NotNil(t, config.DataSourcesMap[DataSourceAlertingChannel])
 	assert.NotNil(t, config.DataSourcesMap[DataSourceAutomationAction])
 	assert.NotNil(t, config.DataSourcesMap[DataSourceHostAgents])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceApdexReport])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceUsers])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceUser])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceRBACGroups])
+}
+
+func TestProviderShouldSendUserAgentWithProviderAndTerraformVersion(t *testing.T) {
+	var userAgent string
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, restapi.BuiltinEventSpecificationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		httpServer.WriteJSONResponse(w, []byte("[]"))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	provider := Provider(testProviderVersion)
+	provider.TerraformVersion = "1.5.7"
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		SchemaFieldAPIToken:              "api-token",
+		SchemaFieldEndpoint:              fmt.Sprintf("localhost:%d", httpServer.GetPort()),
+		SchemaFieldTlsSkipVerify:         true,
+		SchemaFieldSkipBackendValidation: true,
+	})
+
+	diags := provider.Configure(context.Background(), config)
+	assert.False(t, diags.HasError())
+
+	_, err := provider.Meta().(*ProviderMeta).InstanaAPI.BuiltinEventSpecifications().GetAll(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "terraform-provider-instana/1.2.3 (terraform 1.5.7)", userAgent)
+}
+
+func TestProviderShouldValidateBackendDuringConfiguration(t *testing.T) {
+	httpServer := createBackendValidationServer(http.StatusOK, "3.265.377-0", restapi.HealthStateGreen)
+	defer httpServer.Close()
+
+	_, diags := configureProviderForBackendValidation(httpServer.GetPort(), false)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, 1, httpServer.GetCallCount(http.MethodGet, restapi.InstanaVersionResourcePath))
+	assert.Equal(t, 1, httpServer.GetCallCount(http.MethodGet, restapi.InstanaHealthResourcePath))
+}
+
+func TestProviderShouldSkipBackendValidationWhenConfigured(t *testing.T) {
+	httpServer := createBackendValidationServer(http.StatusUnauthorized, "3.265.377-0", restapi.HealthStateGreen)
+	defer httpServer.Close()
+
+	_, diags := configureProviderForBackendValidation(httpServer.GetPort(), true)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, 0, httpServer.GetCallCount(http.MethodGet, restapi.InstanaVersionResourcePath))
+}
+
+func TestProviderShouldFailToConfigureWhenAPITokenIsRejected(t *testing.T) {
+	httpServer := createBackendValidationServer(http.StatusUnauthorized, "3.265.377-0", restapi.HealthStateGreen)
+	defer httpServer.Close()
+
+	_, diags := configureProviderForBackendValidation(httpServer.GetPort(), false)
+
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Invalid Instana API token", diags[0].Summary)
+}
+
+func TestProviderShouldFailToConfigureWhenBackendIsNotReachable(t *testing.T) {
+	httpServer := createBackendValidationServer(http.StatusOK, "3.265.377-0", restapi.HealthStateGreen)
+	httpServer.Close()
+
+	_, diags := configureProviderForBackendValidation(httpServer.GetPort(), false)
+
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Instana backend not reachable", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, SchemaFieldSkipBackendValidation)
+}
+
+func TestProviderShouldFailToConfigureWhenBackendVersionIsNotSupported(t *testing.T) {
+	httpServer := createBackendValidationServer(http.StatusOK, "3.100.1-0", restapi.HealthStateGreen)
+	defer httpServer.Close()
+
+	_, diags := configureProviderForBackendValidation(httpServer.GetPort(), false)
+
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Unsupported Instana backend version", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "3.100.1")
+}
+
+func TestProviderShouldWarnWhenBackendVersionIsUnknown(t *testing.T) {
+	httpServer := createBackendValidationServer(http.StatusOK, "latest", restapi.HealthStateGreen)
+	defer httpServer.Close()
+
+	_, diags := configureProviderForBackendValidation(httpServer.GetPort(), false)
+
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "Unknown Instana backend version", diags[0].Summary)
+}
+
+func TestProviderShouldWarnWhenBackendIsNotHealthy(t *testing.T) {
+	httpServer := createBackendValidationServer(http.StatusOK, "3.265.377-0", "YELLOW")
+	defer httpServer.Close()
+
+	_, diags := configureProviderForBackendValidation(httpServer.GetPort(), false)
+
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "Instana backend health state is YELLOW", diags[0].Summary)
+	assert.Equal(t, "No data arriving from agents", diags[0].Detail)
+}
+
+func createBackendValidationServer(versionStatusCode int, imageTag string, health string) testutils.TestHTTPServer {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, restapi.InstanaVersionResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		if versionStatusCode != http.StatusOK {
+			w.WriteHeader(versionStatusCode)
+			return
+		}
+		httpServer.WriteJSONResponse(w, []byte(fmt.Sprintf(`{"branch":"release","commit":"abc","imageTag":"%s"}`, imageTag)))
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.InstanaHealthResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, []byte(fmt.Sprintf(`{"health":"%s","messages":["No data arriving from agents"]}`, health)))
+	})
+	httpServer.Start()
+	return httpServer
+}
+
+func configureProviderForBackendValidation(port int, skipBackendValidation bool) (*schema.Provider, diag.Diagnostics) {
+	provider := Provider(testProviderVersion)
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		SchemaFieldAPIToken:              "api-token",
+		SchemaFieldEndpoint:              fmt.Sprintf("localhost:%d", port),
+		SchemaFieldTlsSkipVerify:         true,
+		SchemaFieldSkipBackendValidation: skipBackendValidation,
+		SchemaFieldMaxRetries:            0,
+	})
+	return provider, provider.Configure(context.Background(), config)
+}
+
+func TestProviderShouldAuthenticateWithTokenFromFile(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("file-token\n"), 0600))
+
+	authorizationHeader := configureProviderAndCaptureAuthorizationHeader(t, map[string]interface{}{
+		SchemaFieldAPITokenFile:        tokenFile,
+		SchemaFieldAuthorizationScheme: string(restapi.AuthorizationSchemeBearer),
+	})
+
+	assert.Equal(t, "Bearer file-token", authorizationHeader)
+}
+
+func TestProviderShouldAuthenticateWithTokenFromCommand(t *testing.T) {
+	authorizationHeader := configureProviderAndCaptureAuthorizationHeader(t, map[string]interface{}{
+		SchemaFieldAPITokenCommand: []interface{}{"echo", "command-token"},
+	})
+
+	assert.Equal(t, "apiToken command-token", authorizationHeader)
+}
+
+func TestProviderShouldFailToConfigureWhenMultipleOrNoTokenSourcesAreProvided(t *testing.T) {
+	testCases := map[string]map[string]interface{}{
+		"none": {},
+		"multiple": {
+			SchemaFieldAPIToken:     "api-token",
+			SchemaFieldAPITokenFile: "/path/to/token",
+		},
+	}
+	for name, tokenConfig := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("INSTANA_API_TOKEN", "")
+			t.Setenv("INSTANA_API_TOKEN_FILE", "")
+			rawConfig := map[string]interface{}{
+				SchemaFieldEndpoint:              "localhost",
+				SchemaFieldSkipBackendValidation: true,
+			}
+			for k, v := range tokenConfig {
+				rawConfig[k] = v
+			}
+
+			diags := Provider(testProviderVersion).Configure(context.Background(), terraform.NewResourceConfigRaw(rawConfig))
+
+			assert.True(t, diags.HasError())
+			assert.Equal(t, "exactly one of api_token, api_token_file or api_token_command must be provided", diags[0].Summary)
+		})
+	}
+}
+
+func configureProviderAndCaptureAuthorizationHeader(t *testing.T, tokenConfig map[string]interface{}) string {
+	t.Setenv("INSTANA_API_TOKEN", "")
+	t.Setenv("INSTANA_API_TOKEN_FILE", "")
+	var authorizationHeader string
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, restapi.BuiltinEventSpecificationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		authorizationHeader = r.Header.Get("Authorization")
+		httpServer.WriteJSONResponse(w, []byte("[]"))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	rawConfig := map[string]interface{}{
+		SchemaFieldEndpoint:              fmt.Sprintf("localhost:%d", httpServer.GetPort()),
+		SchemaFieldTlsSkipVerify:         true,
+		SchemaFieldSkipBackendValidation: true,
+	}
+	for k, v := range tokenConfig {
+		rawConfig[k] = v
+	}
+	provider := Provider(testProviderVersion)
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(rawConfig))
+	assert.False(t, diags.HasError())
+
+	_, err := provider.Meta().(*ProviderMeta).InstanaAPI.BuiltinEventSpecifications().GetAll(context.Background())
+	assert.NoError(t, err)
+	return authorizationHeader
 }
@@ -0,0 +1,266 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaApdexConfig the name of the terraform-provider-instana resource to manage Apdex configurations
+const ResourceInstanaApdexConfig = "instana_apdex_config"
+
+const (
+	//ApdexConfigFieldName constant value for the schema field name
+	ApdexConfigFieldName = "name"
+	//ApdexConfigFieldThreshold constant value for the schema field threshold
+	ApdexConfigFieldThreshold = "threshold"
+	//ApdexConfigFieldTagFilter constant value for the schema field tag_filter
+	ApdexConfigFieldTagFilter = "tag_filter"
+	//ApdexConfigFieldApplication constant value for the schema field application
+	ApdexConfigFieldApplication = "application"
+	//ApdexConfigFieldApplicationID constant value for the schema field application.application_id
+	ApdexConfigFieldApplicationID = "application_id"
+	//ApdexConfigFieldApplicationBoundaryScope constant value for the schema field application.boundary_scope
+	ApdexConfigFieldApplicationBoundaryScope = "boundary_scope"
+	//ApdexConfigFieldApplicationIncludeInternal constant value for the schema field application.include_internal
+	ApdexConfigFieldApplicationIncludeInternal = "include_internal"
+	//ApdexConfigFieldApplicationIncludeSynthetic constant value for the schema field application.include_synthetic
+	ApdexConfigFieldApplicationIncludeSynthetic = "include_synthetic"
+	//ApdexConfigFieldWebsite constant value for the schema field website
+	ApdexConfigFieldWebsite = "website"
+	//ApdexConfigFieldWebsiteID constant value for the schema field website.website_id
+	ApdexConfigFieldWebsiteID = "website_id"
+	//ApdexConfigFieldWebsiteBeaconType constant value for the schema field website.beacon_type
+	ApdexConfigFieldWebsiteBeaconType = "beacon_type"
+)
+
+var apdexConfigEntityFields = []string{ApdexConfigFieldApplication, ApdexConfigFieldWebsite}
+
+// NewApdexConfigResourceHandle creates the resource handle for Apdex configurations
+func NewApdexConfigResourceHandle() ResourceHandle[*restapi.ApdexConfig] {
+	return &apdexConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaApdexConfig,
+			Schema: map[string]*schema.Schema{
+				ApdexConfigFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "The name of the Apdex configuration",
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+				ApdexConfigFieldThreshold: {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "The threshold in milliseconds. Calls or beacons up to the threshold are satisfied, up to four times the threshold are tolerated and all others are frustrated",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				ApdexConfigFieldTagFilter: {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					Description:      "The tag filter expression limiting the calls or beacons which are considered for the Apdex score",
+					DiffSuppressFunc: tagFilterDiffSuppressFunc,
+					StateFunc:        tagFilterStateFunc,
+					ValidateFunc:     tagFilterValidateFunc,
+				},
+				ApdexConfigFieldApplication: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					ForceNew:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "Configures the Apdex score for an application",
+					ExactlyOneOf: apdexConfigEntityFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							ApdexConfigFieldApplicationID: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								Description:  "The ID of the application",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							ApdexConfigFieldApplicationBoundaryScope: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      string(restapi.BoundaryScopeInbound),
+								Description:  "The boundary scope of the calls which are considered for the Apdex score",
+								ValidateFunc: validation.StringInSlice(restapi.SupportedApdexApplicationBoundaryScopes.ToStringSlice(), false),
+							},
+							ApdexConfigFieldApplicationIncludeInternal: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								ForceNew:    true,
+								Default:     false,
+								Description: "Flag to indicate whether internal calls are considered for the Apdex score",
+							},
+							ApdexConfigFieldApplicationIncludeSynthetic: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								ForceNew:    true,
+								Default:     false,
+								Description: "Flag to indicate whether synthetic calls are considered for the Apdex score",
+							},
+						},
+					},
+				},
+				ApdexConfigFieldWebsite: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					ForceNew:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "Configures the Apdex score for a website",
+					ExactlyOneOf: apdexConfigEntityFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							ApdexConfigFieldWebsiteID: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								Description:  "The ID of the website",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							ApdexConfigFieldWebsiteBeaconType: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      "pageLoad",
+								Description:  "The type of the beacons which are considered for the Apdex score",
+								ValidateFunc: validation.StringInSlice(restapi.SupportedApdexWebsiteBeaconTypes, false),
+							},
+						},
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			CreateOnly:       true,
+		},
+	}
+}
+
+type apdexConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *apdexConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *apdexConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *apdexConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.ApdexConfig] {
+	return api.ApdexConfigs()
+}
+
+func (r *apdexConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *apdexConfigResource) UpdateState(d *schema.ResourceData, config *restapi.ApdexConfig) error {
+	data := map[string]interface{}{
+		ApdexConfigFieldName:        config.Name,
+		ApdexConfigFieldThreshold:   int(config.Entity.Threshold),
+		ApdexConfigFieldApplication: []interface{}{},
+		ApdexConfigFieldWebsite:     []interface{}{},
+	}
+	if config.Entity.TagFilterExpression != nil {
+		normalizedTagFilterString, err := tagfilter.MapTagFilterToNormalizedString(config.Entity.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+		data[ApdexConfigFieldTagFilter] = normalizedTagFilterString
+	}
+	if config.Entity.Type == restapi.ApdexEntityTypeWebsite {
+		data[ApdexConfigFieldWebsite] = []interface{}{r.mapWebsiteToState(config.Entity)}
+	} else {
+		data[ApdexConfigFieldApplication] = []interface{}{r.mapApplicationToState(config.Entity)}
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *apdexConfigResource) mapApplicationToState(entity restapi.ApdexEntity) map[string]interface{} {
+	boundaryScope := restapi.BoundaryScopeInbound
+	if entity.BoundaryScope != nil {
+		boundaryScope = *entity.BoundaryScope
+	}
+	return map[string]interface{}{
+		ApdexConfigFieldApplicationID:               entity.EntityID,
+		ApdexConfigFieldApplicationBoundaryScope:    string(boundaryScope),
+		ApdexConfigFieldApplicationIncludeInternal:  entity.IncludeInternal != nil && *entity.IncludeInternal,
+		ApdexConfigFieldApplicationIncludeSynthetic: entity.IncludeSynthetic != nil && *entity.IncludeSynthetic,
+	}
+}
+
+func (r *apdexConfigResource) mapWebsiteToState(entity restapi.ApdexEntity) map[string]interface{} {
+	beaconType := ""
+	if entity.BeaconType != nil {
+		beaconType = *entity.BeaconType
+	}
+	return map[string]interface{}{
+		ApdexConfigFieldWebsiteID:         entity.EntityID,
+		ApdexConfigFieldWebsiteBeaconType: beaconType,
+	}
+}
+
+// MapStateToDataObject maps the state to the Apdex configuration. The Instana API requires a tag filter expression.
+// Therefore, an empty expression is sent when no tag filter is configured.
+func (r *apdexConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.ApdexConfig, error) {
+	tagFilter := restapi.NewLogicalAndTagFilter([]*restapi.TagFilter{})
+	if tagFilterString, ok := d.GetOk(ApdexConfigFieldTagFilter); ok {
+		var err error
+		tagFilter, err = r.mapTagFilterStringToAPIModel(tagFilterString.(string))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	entity := restapi.ApdexEntity{
+		TagFilterExpression: tagFilter,
+		Threshold:           int32(d.Get(ApdexConfigFieldThreshold).(int)),
+	}
+	if websites, ok := d.GetOk(ApdexConfigFieldWebsite); ok && len(websites.([]interface{})) > 0 {
+		website := websites.([]interface{})[0].(map[string]interface{})
+		beaconType := website[ApdexConfigFieldWebsiteBeaconType].(string)
+		entity.Type = restapi.ApdexEntityTypeWebsite
+		entity.EntityID = website[ApdexConfigFieldWebsiteID].(string)
+		entity.BeaconType = &beaconType
+	} else if applications, ok := d.GetOk(ApdexConfigFieldApplication); ok && len(applications.([]interface{})) > 0 {
+		application := applications.([]interface{})[0].(map[string]interface{})
+		boundaryScope := restapi.BoundaryScope(application[ApdexConfigFieldApplicationBoundaryScope].(string))
+		includeInternal := application[ApdexConfigFieldApplicationIncludeInternal].(bool)
+		includeSynthetic := application[ApdexConfigFieldApplicationIncludeSynthetic].(bool)
+		entity.Type = restapi.ApdexEntityTypeApplication
+		entity.EntityID = application[ApdexConfigFieldApplicationID].(string)
+		entity.BoundaryScope = &boundaryScope
+		entity.IncludeInternal = &includeInternal
+		entity.IncludeSynthetic = &includeSynthetic
+	}
+
+	return &restapi.ApdexConfig{
+		ID:     d.Id(),
+		Name:   d.Get(ApdexConfigFieldName).(string),
+		Entity: entity,
+	}, nil
+}
+
+func (r *apdexConfigResource) mapTagFilterStringToAPIModel(input string) (*restapi.TagFilter, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return mapper.ToAPIModel(expr), nil
+}
@@ -0,0 +1,312 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestApdexConfigResource(t *testing.T) {
+	ut := &apdexConfigUnitTest{}
+	t.Run("CRUD integration test", apdexConfigIntegrationTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should be create only", ut.shouldBeCreateOnly)
+	t.Run("should update resource state for application", ut.shouldUpdateResourceStateForApplication)
+	t.Run("should update resource state for website", ut.shouldUpdateResourceStateForWebsite)
+	t.Run("should map state to data model for application", ut.shouldMapStateToDataModelForApplication)
+	t.Run("should map state to data model for website without tag filter", ut.shouldMapStateToDataModelForWebsiteWithoutTagFilter)
+	t.Run("should fail to map state to data model when tag filter is not valid", ut.shouldFailToMapStateToDataModelWhenTagFilterIsNotValid)
+}
+
+const (
+	apdexConfigDefinition    = "instana_apdex_config.example"
+	apdexConfigApplicationID = "application-id"
+	apdexConfigWebsiteID     = "website-id"
+	apdexConfigTagFilter     = "call.http.path@dest EQUALS '/checkout'"
+)
+
+const resourceApdexConfigDefinitionTemplate = `
+resource "instana_apdex_config" "example" {
+  name       = "name %d"
+  threshold  = 500
+  tag_filter = "call.http.path EQUALS '/checkout'"
+
+  application {
+    application_id    = "application-id"
+    include_synthetic = true
+  }
+}
+`
+
+func apdexConfigIntegrationTest(t *testing.T) {
+	var mutex sync.Mutex
+	configs := make(map[string]*restapi.ApdexConfig)
+	creations := 0
+	deletions := 0
+	resourceInstancePath := restapi.ApdexConfigResourcePath + "/{id}"
+	httpServer := testutils.NewTestHTTPServer()
+	writeJSON := func(w http.ResponseWriter, data interface{}) {
+		response, err := json.Marshal(data)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		httpServer.WriteJSONResponse(w, response)
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.ApdexConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		config := &restapi.ApdexConfig{}
+		if err := json.NewDecoder(r.Body).Decode(config); err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		config.ID = fmt.Sprintf("apdex-id-%d", creations)
+		configs[config.ID] = config
+		creations++
+		writeJSON(w, config)
+	})
+	httpServer.AddRoute(http.MethodGet, resourceInstancePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		config, ok := configs[mux.Vars(r)["id"]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeJSON(w, config)
+	})
+	httpServer.AddRoute(http.MethodDelete, resourceInstancePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		delete(configs, mux.Vars(r)["id"])
+		deletions++
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createApdexConfigTestStep(httpServer.GetPort(), 0),
+			testStepImportWithCustomID(apdexConfigDefinition, "apdex-id-0"),
+			createApdexConfigTestStep(httpServer.GetPort(), 1),
+			testStepImportWithCustomID(apdexConfigDefinition, "apdex-id-1"),
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if creations != 2 || deletions != 2 {
+				return fmt.Errorf("expected 2 creations and 2 deletions but got %d creations and %d deletions", creations, deletions)
+			}
+			return nil
+		},
+	})
+}
+
+func createApdexConfigTestStep(httpPort int, iteration int) resource.TestStep {
+	applicationPrefix := ApdexConfigFieldApplication + ".0."
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(resourceApdexConfigDefinitionTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(apdexConfigDefinition, "id", fmt.Sprintf("apdex-id-%d", iteration)),
+			resource.TestCheckResourceAttr(apdexConfigDefinition, ApdexConfigFieldName, fmt.Sprintf("name %d", iteration)),
+			resource.TestCheckResourceAttr(apdexConfigDefinition, ApdexConfigFieldThreshold, "500"),
+			resource.TestCheckResourceAttr(apdexConfigDefinition, ApdexConfigFieldTagFilter, apdexConfigTagFilter),
+			resource.TestCheckResourceAttr(apdexConfigDefinition, applicationPrefix+ApdexConfigFieldApplicationID, apdexConfigApplicationID),
+			resource.TestCheckResourceAttr(apdexConfigDefinition, applicationPrefix+ApdexConfigFieldApplicationBoundaryScope, string(restapi.BoundaryScopeInbound)),
+			resource.TestCheckResourceAttr(apdexConfigDefinition, applicationPrefix+ApdexConfigFieldApplicationIncludeInternal, falseAsString),
+			resource.TestCheckResourceAttr(apdexConfigDefinition, applicationPrefix+ApdexConfigFieldApplicationIncludeSynthetic, trueAsString),
+			resource.TestCheckResourceAttr(apdexConfigDefinition, ApdexConfigFieldWebsite+".#", "0"),
+		),
+	}
+}
+
+type apdexConfigUnitTest struct{}
+
+func (ut *apdexConfigUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	schemaMap := NewApdexConfigResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 5)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ApdexConfigFieldName)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeInt(ApdexConfigFieldThreshold)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ApdexConfigFieldTagFilter)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(ApdexConfigFieldApplication)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(ApdexConfigFieldWebsite)
+	for key, fieldSchema := range schemaMap {
+		require.Truef(t, fieldSchema.ForceNew, "field %s should force a new resource", key)
+	}
+}
+
+func (ut *apdexConfigUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_apdex_config", NewApdexConfigResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *apdexConfigUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewApdexConfigResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *apdexConfigUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewApdexConfigResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *apdexConfigUnitTest) shouldBeCreateOnly(t *testing.T) {
+	require.True(t, NewApdexConfigResourceHandle().MetaData().CreateOnly)
+}
+
+func (ut *apdexConfigUnitTest) shouldUpdateResourceStateForApplication(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+	resourceHandle := NewApdexConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	boundaryScope := restapi.BoundaryScopeAll
+	includeInternal := true
+	includeSynthetic := false
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.ApdexConfig{
+		ID:   "apdex-id",
+		Name: "name",
+		Entity: restapi.ApdexEntity{
+			Type:                restapi.ApdexEntityTypeApplication,
+			EntityID:            apdexConfigApplicationID,
+			TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "call.http.path", restapi.EqualsOperator, "/checkout"),
+			Threshold:           500,
+			BoundaryScope:       &boundaryScope,
+			IncludeInternal:     &includeInternal,
+			IncludeSynthetic:    &includeSynthetic,
+		},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "apdex-id", resourceData.Id())
+	require.Equal(t, "name", resourceData.Get(ApdexConfigFieldName))
+	require.Equal(t, 500, resourceData.Get(ApdexConfigFieldThreshold))
+	require.Equal(t, apdexConfigTagFilter, resourceData.Get(ApdexConfigFieldTagFilter))
+	require.Equal(t, []interface{}{map[string]interface{}{
+		ApdexConfigFieldApplicationID:               apdexConfigApplicationID,
+		ApdexConfigFieldApplicationBoundaryScope:    string(restapi.BoundaryScopeAll),
+		ApdexConfigFieldApplicationIncludeInternal:  true,
+		ApdexConfigFieldApplicationIncludeSynthetic: false,
+	}}, resourceData.Get(ApdexConfigFieldApplication))
+	require.Empty(t, resourceData.Get(ApdexConfigFieldWebsite))
+}
+
+func (ut *apdexConfigUnitTest) shouldUpdateResourceStateForWebsite(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+	resourceHandle := NewApdexConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	beaconType := "httpRequest"
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.ApdexConfig{
+		ID:   "apdex-id",
+		Name: "name",
+		Entity: restapi.ApdexEntity{
+			Type:                restapi.ApdexEntityTypeWebsite,
+			EntityID:            apdexConfigWebsiteID,
+			TagFilterExpression: restapi.NewLogicalAndTagFilter([]*restapi.TagFilter{}),
+			Threshold:           2000,
+			BeaconType:          &beaconType,
+		},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "apdex-id", resourceData.Id())
+	require.Equal(t, 2000, resourceData.Get(ApdexConfigFieldThreshold))
+	require.Empty(t, resourceData.Get(ApdexConfigFieldTagFilter))
+	require.Equal(t, []interface{}{map[string]interface{}{
+		ApdexConfigFieldWebsiteID:         apdexConfigWebsiteID,
+		ApdexConfigFieldWebsiteBeaconType: beaconType,
+	}}, resourceData.Get(ApdexConfigFieldWebsite))
+	require.Empty(t, resourceData.Get(ApdexConfigFieldApplication))
+}
+
+func (ut *apdexConfigUnitTest) shouldMapStateToDataModelForApplication(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+	resourceHandle := NewApdexConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("apdex-id")
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldName, "name")
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldThreshold, 500)
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldTagFilter, apdexConfigTagFilter)
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldApplication, []interface{}{map[string]interface{}{
+		ApdexConfigFieldApplicationID:               apdexConfigApplicationID,
+		ApdexConfigFieldApplicationBoundaryScope:    string(restapi.BoundaryScopeInbound),
+		ApdexConfigFieldApplicationIncludeInternal:  false,
+		ApdexConfigFieldApplicationIncludeSynthetic: true,
+	}})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	boundaryScope := restapi.BoundaryScopeInbound
+	includeInternal := false
+	includeSynthetic := true
+	require.NoError(t, err)
+	require.Equal(t, &restapi.ApdexConfig{
+		ID:   "apdex-id",
+		Name: "name",
+		Entity: restapi.ApdexEntity{
+			Type:                restapi.ApdexEntityTypeApplication,
+			EntityID:            apdexConfigApplicationID,
+			TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "call.http.path", restapi.EqualsOperator, "/checkout"),
+			Threshold:           500,
+			BoundaryScope:       &boundaryScope,
+			IncludeInternal:     &includeInternal,
+			IncludeSynthetic:    &includeSynthetic,
+		},
+	}, result)
+}
+
+func (ut *apdexConfigUnitTest) shouldMapStateToDataModelForWebsiteWithoutTagFilter(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+	resourceHandle := NewApdexConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldName, "name")
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldThreshold, 2000)
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldWebsite, []interface{}{map[string]interface{}{
+		ApdexConfigFieldWebsiteID:         apdexConfigWebsiteID,
+		ApdexConfigFieldWebsiteBeaconType: "pageLoad",
+	}})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	beaconType := "pageLoad"
+	require.NoError(t, err)
+	require.Equal(t, &restapi.ApdexConfig{
+		Name: "name",
+		Entity: restapi.ApdexEntity{
+			Type:                restapi.ApdexEntityTypeWebsite,
+			EntityID:            apdexConfigWebsiteID,
+			TagFilterExpression: restapi.NewLogicalAndTagFilter([]*restapi.TagFilter{}),
+			Threshold:           2000,
+			BeaconType:          &beaconType,
+		},
+	}, result)
+}
+
+func (ut *apdexConfigUnitTest) shouldFailToMapStateToDataModelWhenTagFilterIsNotValid(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+	resourceHandle := NewApdexConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldName, "name")
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldThreshold, 500)
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldTagFilter, "invalid invalid")
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Error(t, err)
+}
@@ -0,0 +1,111 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceInstanaGlobalCustomPayloadConfiguration the name of the terraform-provider-instana resource to manage the global custom payload configuration
+const ResourceInstanaGlobalCustomPayloadConfiguration = "instana_global_custom_payload_configuration"
+
+// NewGlobalCustomPayloadConfigurationResourceHandle creates the resource handle for the global custom payload configuration
+func NewGlobalCustomPayloadConfigurationResourceHandle() ResourceHandle[*restapi.GlobalCustomPayloadConfiguration] {
+	customPayloadFields := buildCustomPayloadFields()
+	//the fields are hashed by all attributes instead of the key only so that changed values result in an update
+	customPayloadFields.Set = nil
+	return &globalCustomPayloadConfigurationResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaGlobalCustomPayloadConfiguration,
+			Schema: map[string]*schema.Schema{
+				DefaultCustomPayloadFieldsName: customPayloadFields,
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			CustomizeDiff:    validateDynamicCustomPayloadFieldTagNames,
+		},
+	}
+}
+
+type globalCustomPayloadConfigurationResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *globalCustomPayloadConfigurationResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *globalCustomPayloadConfigurationResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *globalCustomPayloadConfigurationResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.GlobalCustomPayloadConfiguration] {
+	return api.GlobalCustomPayloadConfiguration()
+}
+
+func (r *globalCustomPayloadConfigurationResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *globalCustomPayloadConfigurationResource) UpdateState(d *schema.ResourceData, config *restapi.GlobalCustomPayloadConfiguration) error {
+	d.SetId(restapi.GlobalCustomPayloadConfigurationID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		DefaultCustomPayloadFieldsName: mapCustomPayloadFieldsToSchema(config),
+	})
+}
+
+func (r *globalCustomPayloadConfigurationResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.GlobalCustomPayloadConfiguration, error) {
+	customPayloadFields, err := mapDefaultCustomPayloadFieldsFromSchema(d)
+	if err != nil {
+		return nil, err
+	}
+	return &restapi.GlobalCustomPayloadConfiguration{
+		Fields: customPayloadFields,
+	}, nil
+}
+
+// validateDynamicCustomPayloadFieldTagNames verifies at plan time that the tags of all dynamic custom payload fields
+// exist in the tag catalog of the Instana API. The catalog is only requested when the custom payload fields are changed
+// and at least one dynamic custom payload field is configured. The tag names are read from the raw configuration as
+// nested blocks of sets are not available in the resource diff.
+func validateDynamicCustomPayloadFieldTagNames(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok || providerMeta.InstanaAPI == nil || !d.HasChange(DefaultCustomPayloadFieldsName) {
+		return nil
+	}
+
+	fields := d.GetRawConfig().GetAttr(DefaultCustomPayloadFieldsName)
+	if !fields.IsKnown() || fields.IsNull() {
+		return nil
+	}
+	var catalog *restapi.CustomPayloadTagCatalog
+	for it := fields.ElementIterator(); it.Next(); {
+		_, field := it.Element()
+		key := field.GetAttr(CustomPayloadFieldsFieldKey)
+		dynamicValues := field.GetAttr(CustomPayloadFieldsFieldDynamicValue)
+		if !key.IsKnown() || key.IsNull() || !dynamicValues.IsKnown() || dynamicValues.IsNull() {
+			continue
+		}
+		for dynamicValueIt := dynamicValues.ElementIterator(); dynamicValueIt.Next(); {
+			_, dynamicValue := dynamicValueIt.Element()
+			tagName := dynamicValue.GetAttr(CustomPayloadFieldsFieldDynamicTagName)
+			//tag names which are not known at plan time cannot be validated
+			if !tagName.IsKnown() || tagName.IsNull() {
+				continue
+			}
+			if catalog == nil {
+				var err error
+				if catalog, err = providerMeta.InstanaAPI.CustomPayloadTagCatalog(ctx); err != nil {
+					return fmt.Errorf("failed to retrieve the custom payload tag catalog; %w", err)
+				}
+			}
+			if !catalog.ContainsTag(tagName.AsString()) {
+				return fmt.Errorf("tag %s of dynamic custom payload field %s does not exist in the custom payload tag catalog", tagName.AsString(), key.AsString())
+			}
+		}
+	}
+	return nil
+}
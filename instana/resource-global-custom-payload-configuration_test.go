@@ -0,0 +1,257 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestGlobalCustomPayloadConfigurationResource(t *testing.T) {
+	ut := &globalCustomPayloadConfigurationUnitTest{}
+	t.Run("CRUD integration test", globalCustomPayloadConfigurationIntegrationTest)
+	t.Run("should fail plan when tag of dynamic custom payload field does not exist", globalCustomPayloadConfigurationUnknownTagIntegrationTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should update resource state", ut.shouldUpdateResourceState)
+	t.Run("should map state to data model", ut.shouldMapStateToDataModel)
+	t.Run("should fail to map state to data model when static and dynamic value are defined", ut.shouldFailToMapStateToDataModelWhenStaticAndDynamicValueAreDefined)
+}
+
+const (
+	globalCustomPayloadConfigurationDefinition = "instana_global_custom_payload_configuration.example"
+	customPayloadTagCatalogResponse            = `{"tagTree":[],"tags":[{"name":"aws.tag","label":"AWS Tag","type":"KEY_VALUE_PAIR"}]}`
+)
+
+const resourceGlobalCustomPayloadConfigurationDefinitionTemplate = `
+resource "instana_global_custom_payload_configuration" "example" {
+  custom_payload_field {
+    key   = "team"
+    value = "team-%d"
+  }
+
+  custom_payload_field {
+    key = "stage"
+    dynamic_value {
+      key      = "stage"
+      tag_name = "%s"
+    }
+  }
+}
+`
+
+type globalCustomPayloadConfigurationTestServer struct {
+	httpServer     testutils.TestHTTPServer
+	mutex          sync.Mutex
+	serializedData []byte
+}
+
+func newGlobalCustomPayloadConfigurationTestServer() *globalCustomPayloadConfigurationTestServer {
+	server := &globalCustomPayloadConfigurationTestServer{
+		httpServer:     testutils.NewTestHTTPServer(),
+		serializedData: []byte(`{"fields":[]}`),
+	}
+	server.httpServer.AddRoute(http.MethodGet, restapi.GlobalCustomPayloadConfigurationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		server.httpServer.WriteJSONResponse(w, server.serializedData)
+	})
+	server.httpServer.AddRoute(http.MethodPut, restapi.GlobalCustomPayloadConfigurationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		data := make(map[string]interface{})
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			server.httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		serializedData, err := json.Marshal(data)
+		if err != nil {
+			server.httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		server.serializedData = serializedData
+		server.httpServer.WriteJSONResponse(w, append(append([]byte("["), serializedData...), ']'))
+	})
+	server.httpServer.AddRoute(http.MethodDelete, restapi.GlobalCustomPayloadConfigurationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		server.serializedData = []byte(`{"fields":[]}`)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server.httpServer.AddRoute(http.MethodGet, restapi.CustomPayloadTagCatalogResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		server.httpServer.WriteJSONResponse(w, []byte(customPayloadTagCatalogResponse))
+	})
+	return server
+}
+
+func (s *globalCustomPayloadConfigurationTestServer) verifyFieldsRemoved() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if string(s.serializedData) != `{"fields":[]}` {
+		return fmt.Errorf("expected global custom payload fields to be removed but got %s", string(s.serializedData))
+	}
+	return nil
+}
+
+func globalCustomPayloadConfigurationIntegrationTest(t *testing.T) {
+	server := newGlobalCustomPayloadConfigurationTestServer()
+	server.httpServer.Start()
+	defer server.httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createGlobalCustomPayloadConfigurationTestStep(server.httpServer.GetPort(), 0),
+			testStepImportWithCustomID(globalCustomPayloadConfigurationDefinition, restapi.GlobalCustomPayloadConfigurationID),
+			createGlobalCustomPayloadConfigurationTestStep(server.httpServer.GetPort(), 1),
+			testStepImportWithCustomID(globalCustomPayloadConfigurationDefinition, restapi.GlobalCustomPayloadConfigurationID),
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			return server.verifyFieldsRemoved()
+		},
+	})
+}
+
+func globalCustomPayloadConfigurationUnknownTagIntegrationTest(t *testing.T) {
+	server := newGlobalCustomPayloadConfigurationTestServer()
+	server.httpServer.Start()
+	defer server.httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      appendProviderConfig(fmt.Sprintf(resourceGlobalCustomPayloadConfigurationDefinitionTemplate, 0, "unknown.tag"), server.httpServer.GetPort()),
+				ExpectError: regexp.MustCompile("tag unknown\\.tag of dynamic custom payload field stage does not exist in the custom payload tag catalog"),
+			},
+		},
+	})
+}
+
+func createGlobalCustomPayloadConfigurationTestStep(httpPort int, iteration int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(resourceGlobalCustomPayloadConfigurationDefinitionTemplate, iteration, "aws.tag"), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(globalCustomPayloadConfigurationDefinition, "id", restapi.GlobalCustomPayloadConfigurationID),
+			resource.TestCheckResourceAttr(globalCustomPayloadConfigurationDefinition, DefaultCustomPayloadFieldsName+".#", "2"),
+			resource.TestCheckTypeSetElemNestedAttrs(globalCustomPayloadConfigurationDefinition, DefaultCustomPayloadFieldsName+".*", map[string]string{
+				CustomPayloadFieldsFieldKey:               "team",
+				CustomPayloadFieldsFieldStaticStringValue: fmt.Sprintf("team-%d", iteration),
+			}),
+			resource.TestCheckTypeSetElemNestedAttrs(globalCustomPayloadConfigurationDefinition, DefaultCustomPayloadFieldsName+".*", map[string]string{
+				CustomPayloadFieldsFieldKey: "stage",
+				CustomPayloadFieldsFieldDynamicValue + ".0." + CustomPayloadFieldsFieldDynamicKey:     "stage",
+				CustomPayloadFieldsFieldDynamicValue + ".0." + CustomPayloadFieldsFieldDynamicTagName: "aws.tag",
+			}),
+		),
+	}
+}
+
+type globalCustomPayloadConfigurationUnitTest struct{}
+
+func (ut *globalCustomPayloadConfigurationUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	metaData := NewGlobalCustomPayloadConfigurationResourceHandle().MetaData()
+
+	require.Len(t, metaData.Schema, 1)
+	require.Equal(t, schema.TypeSet, metaData.Schema[DefaultCustomPayloadFieldsName].Type)
+	require.True(t, metaData.Schema[DefaultCustomPayloadFieldsName].Optional)
+	require.True(t, metaData.SkipIDGeneration)
+	require.NotNil(t, metaData.CustomizeDiff)
+}
+
+func (ut *globalCustomPayloadConfigurationUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_global_custom_payload_configuration", NewGlobalCustomPayloadConfigurationResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *globalCustomPayloadConfigurationUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewGlobalCustomPayloadConfigurationResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *globalCustomPayloadConfigurationUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewGlobalCustomPayloadConfigurationResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *globalCustomPayloadConfigurationUnitTest) shouldUpdateResourceState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GlobalCustomPayloadConfiguration](t)
+	resourceHandle := NewGlobalCustomPayloadConfigurationResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	dynamicKey := "stage"
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.GlobalCustomPayloadConfiguration{
+		Fields: []restapi.CustomPayloadField[any]{
+			{Type: restapi.StaticStringCustomPayloadType, Key: "team", Value: restapi.StaticStringCustomPayloadFieldValue("platform")},
+			{Type: restapi.DynamicCustomPayloadType, Key: "stage", Value: restapi.DynamicCustomPayloadFieldValue{Key: &dynamicKey, TagName: "aws.tag"}},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, restapi.GlobalCustomPayloadConfigurationID, resourceData.Id())
+	require.ElementsMatch(t, []interface{}{
+		map[string]interface{}{
+			CustomPayloadFieldsFieldKey:               "team",
+			CustomPayloadFieldsFieldStaticStringValue: "platform",
+			CustomPayloadFieldsFieldDynamicValue:      []interface{}{},
+		},
+		map[string]interface{}{
+			CustomPayloadFieldsFieldKey:               "stage",
+			CustomPayloadFieldsFieldStaticStringValue: "",
+			CustomPayloadFieldsFieldDynamicValue: []interface{}{map[string]interface{}{
+				CustomPayloadFieldsFieldDynamicKey:     "stage",
+				CustomPayloadFieldsFieldDynamicTagName: "aws.tag",
+			}},
+		},
+	}, resourceData.Get(DefaultCustomPayloadFieldsName).(*schema.Set).List())
+}
+
+func (ut *globalCustomPayloadConfigurationUnitTest) shouldMapStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GlobalCustomPayloadConfiguration](t)
+	resourceHandle := NewGlobalCustomPayloadConfigurationResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, DefaultCustomPayloadFieldsName, []interface{}{
+		map[string]interface{}{
+			CustomPayloadFieldsFieldKey:               "team",
+			CustomPayloadFieldsFieldStaticStringValue: "platform",
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.GlobalCustomPayloadConfiguration{
+		Fields: []restapi.CustomPayloadField[any]{
+			{Type: restapi.StaticStringCustomPayloadType, Key: "team", Value: restapi.StaticStringCustomPayloadFieldValue("platform")},
+		},
+	}, result)
+}
+
+func (ut *globalCustomPayloadConfigurationUnitTest) shouldFailToMapStateToDataModelWhenStaticAndDynamicValueAreDefined(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GlobalCustomPayloadConfiguration](t)
+	resourceHandle := NewGlobalCustomPayloadConfigurationResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, DefaultCustomPayloadFieldsName, []interface{}{
+		map[string]interface{}{
+			CustomPayloadFieldsFieldKey:               "stage",
+			CustomPayloadFieldsFieldStaticStringValue: "invalid",
+			CustomPayloadFieldsFieldDynamicValue: []interface{}{map[string]interface{}{
+				CustomPayloadFieldsFieldDynamicTagName: "aws.tag",
+			}},
+		},
+	})
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Error(t, err)
+}
@@ -0,0 +1,364 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaGlobalSyntheticAlertConfig the name of the terraform-provider-instana resource to manage global synthetic alert configs
+const ResourceInstanaGlobalSyntheticAlertConfig = "instana_global_synthetic_alert_config"
+
+const (
+	//SyntheticAlertConfigFieldAlertChannelIDs constant value for field alert_channel_ids of resource instana_global_synthetic_alert_config
+	SyntheticAlertConfigFieldAlertChannelIDs = "alert_channel_ids"
+	//SyntheticAlertConfigFieldDescription constant value for field description of resource instana_global_synthetic_alert_config
+	SyntheticAlertConfigFieldDescription = "description"
+	//SyntheticAlertConfigFieldName constant value for field name of resource instana_global_synthetic_alert_config
+	SyntheticAlertConfigFieldName = "name"
+
+	//SyntheticAlertConfigFieldRule constant value for field rule of resource instana_global_synthetic_alert_config
+	SyntheticAlertConfigFieldRule = "rule"
+	//SyntheticAlertConfigFieldRuleFailure constant value for field rule.failure of resource instana_global_synthetic_alert_config
+	SyntheticAlertConfigFieldRuleFailure = "failure"
+	//SyntheticAlertConfigFieldRuleMetricName constant value for field rule.failure.metric_name of resource instana_global_synthetic_alert_config
+	SyntheticAlertConfigFieldRuleMetricName = "metric_name"
+	//SyntheticAlertConfigFieldRuleAggregation constant value for field rule.failure.aggregation of resource instana_global_synthetic_alert_config
+	SyntheticAlertConfigFieldRuleAggregation = "aggregation"
+
+	//SyntheticAlertConfigFieldSeverity constant value for field severity of resource instana_global_synthetic_alert_config
+	SyntheticAlertConfigFieldSeverity = "severity"
+	//SyntheticAlertConfigFieldSyntheticTestIDs constant value for field synthetic_test_ids of resource instana_global_synthetic_alert_config
+	SyntheticAlertConfigFieldSyntheticTestIDs = "synthetic_test_ids"
+	//SyntheticAlertConfigFieldTagFilter constant value for field tag_filter of resource instana_global_synthetic_alert_config
+	SyntheticAlertConfigFieldTagFilter = "tag_filter"
+
+	//SyntheticAlertConfigFieldTimeThreshold constant value for field time_threshold of resource instana_global_synthetic_alert_config
+	SyntheticAlertConfigFieldTimeThreshold = "time_threshold"
+	//SyntheticAlertConfigFieldTimeThresholdViolationsInSequence constant value for field time_threshold.violations_in_sequence of resource instana_global_synthetic_alert_config
+	SyntheticAlertConfigFieldTimeThresholdViolationsInSequence = "violations_in_sequence"
+	//SyntheticAlertConfigFieldTimeThresholdViolationsCount constant value for field time_threshold.violations_in_sequence.violations_count of resource instana_global_synthetic_alert_config
+	SyntheticAlertConfigFieldTimeThresholdViolationsCount = "violations_count"
+)
+
+const (
+	syntheticAlertRuleTypeFailure                  = "failure"
+	syntheticTimeThresholdTypeViolationsInSequence = "violationsInSequence"
+)
+
+var globalSyntheticAlertConfigResourceSchema = map[string]*schema.Schema{
+	SyntheticAlertConfigFieldAlertChannelIDs: {
+		Type:     schema.TypeSet,
+		MinItems: 0,
+		MaxItems: 1024,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		Description: "List of IDs of alert channels defined in Instana.",
+	},
+	DefaultCustomPayloadFieldsName: buildCustomPayloadFields(),
+	SyntheticAlertConfigFieldDescription: {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The description text of the synthetic alert config",
+		ValidateFunc: validation.StringLenBetween(0, 65536),
+	},
+	SyntheticAlertConfigFieldName: {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "Name for the synthetic alert configuration",
+		ValidateFunc: validation.StringLenBetween(0, 256),
+	},
+	SyntheticAlertConfigFieldRule: {
+		Type:        schema.TypeList,
+		MinItems:    1,
+		MaxItems:    1,
+		Required:    true,
+		Description: "Indicates the type of rule this alert configuration is about.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				SyntheticAlertConfigFieldRuleFailure: {
+					Type:        schema.TypeList,
+					MinItems:    1,
+					MaxItems:    1,
+					Required:    true,
+					Description: "Rule based on the failures of the referenced synthetic tests",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SyntheticAlertConfigFieldRuleMetricName: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The metric name of the synthetic alert rule",
+							},
+							SyntheticAlertConfigFieldRuleAggregation: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(restapi.SupportedAggregations.ToStringSlice(), true),
+								Description:  "The aggregation function of the synthetic alert rule",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	SyntheticAlertConfigFieldSeverity: {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(restapi.SupportedSeverities.TerraformRepresentations(), false),
+		Description:  "The severity of the alert when triggered",
+	},
+	SyntheticAlertConfigFieldSyntheticTestIDs: {
+		Type:     schema.TypeSet,
+		MinItems: 0,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		Description: "List of IDs of the synthetic tests the alert configuration is applied to.",
+	},
+	SyntheticAlertConfigFieldTagFilter: OptionalTagFilterExpressionSchema,
+	SyntheticAlertConfigFieldTimeThreshold: {
+		Type:        schema.TypeList,
+		MinItems:    1,
+		MaxItems:    1,
+		Required:    true,
+		Description: "Indicates the type of violation of the defined threshold.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				SyntheticAlertConfigFieldTimeThresholdViolationsInSequence: {
+					Type:        schema.TypeList,
+					MinItems:    1,
+					MaxItems:    1,
+					Required:    true,
+					Description: "Time threshold base on violations in sequence",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SyntheticAlertConfigFieldTimeThresholdViolationsCount: {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(1, 12),
+								Description:  "The number of consecutive failed test runs which are required to trigger the alert",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// NewGlobalSyntheticAlertConfigResourceHandle creates the resource handle for Global Synthetic Alert Configs
+func NewGlobalSyntheticAlertConfigResourceHandle() ResourceHandle[*restapi.SyntheticAlertConfig] {
+	return &globalSyntheticAlertConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName:     ResourceInstanaGlobalSyntheticAlertConfig,
+			Schema:           globalSyntheticAlertConfigResourceSchema,
+			SkipIDGeneration: true,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type globalSyntheticAlertConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *globalSyntheticAlertConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *globalSyntheticAlertConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *globalSyntheticAlertConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.SyntheticAlertConfig] {
+	return api.GlobalSyntheticAlertConfigs()
+}
+
+func (r *globalSyntheticAlertConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *globalSyntheticAlertConfigResource) UpdateState(d *schema.ResourceData, config *restapi.SyntheticAlertConfig) error {
+	var severity *string
+	var err error
+	if config.Severity != nil {
+		severityStr, err := ConvertSeverityFromInstanaAPIToTerraformRepresentation(*config.Severity)
+		if err != nil {
+			return err
+		}
+		severity = &severityStr
+	}
+	var normalizedTagFilterString *string
+	if config.TagFilterExpression != nil {
+		normalizedTagFilterString, err = tagfilter.MapTagFilterToNormalizedString(config.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		SyntheticAlertConfigFieldAlertChannelIDs:  config.AlertChannelIDs,
+		DefaultCustomPayloadFieldsName:            mapCustomPayloadFieldsToSchema(config),
+		SyntheticAlertConfigFieldDescription:      config.Description,
+		SyntheticAlertConfigFieldName:             config.Name,
+		SyntheticAlertConfigFieldRule:             r.mapRuleToSchema(config),
+		SyntheticAlertConfigFieldSeverity:         severity,
+		SyntheticAlertConfigFieldSyntheticTestIDs: config.SyntheticTestIDs,
+		SyntheticAlertConfigFieldTagFilter:        normalizedTagFilterString,
+		SyntheticAlertConfigFieldTimeThreshold:    r.mapTimeThresholdToSchema(config),
+	})
+}
+
+func (r *globalSyntheticAlertConfigResource) mapRuleToSchema(config *restapi.SyntheticAlertConfig) []map[string]interface{} {
+	ruleAttribute := make(map[string]interface{})
+	ruleAttribute[SyntheticAlertConfigFieldRuleMetricName] = config.Rule.MetricName
+	if config.Rule.Aggregation != nil {
+		ruleAttribute[SyntheticAlertConfigFieldRuleAggregation] = string(*config.Rule.Aggregation)
+	}
+
+	rule := make(map[string]interface{})
+	rule[r.mapAlertTypeToSchema(config.Rule.AlertType)] = []interface{}{ruleAttribute}
+	result := make([]map[string]interface{}, 1)
+	result[0] = rule
+	return result
+}
+
+func (r *globalSyntheticAlertConfigResource) mapAlertTypeToSchema(alertType string) string {
+	if alertType == syntheticAlertRuleTypeFailure {
+		return SyntheticAlertConfigFieldRuleFailure
+	}
+	return alertType
+}
+
+func (r *globalSyntheticAlertConfigResource) mapTimeThresholdToSchema(config *restapi.SyntheticAlertConfig) []map[string]interface{} {
+	timeThresholdConfig := make(map[string]interface{})
+	if config.TimeThreshold.ViolationsCount != nil {
+		timeThresholdConfig[SyntheticAlertConfigFieldTimeThresholdViolationsCount] = int(*config.TimeThreshold.ViolationsCount)
+	}
+
+	timeThreshold := make(map[string]interface{})
+	timeThreshold[r.mapTimeThresholdTypeToSchema(config.TimeThreshold.Type)] = []interface{}{timeThresholdConfig}
+	result := make([]map[string]interface{}, 1)
+	result[0] = timeThreshold
+	return result
+}
+
+func (r *globalSyntheticAlertConfigResource) mapTimeThresholdTypeToSchema(input string) string {
+	if input == syntheticTimeThresholdTypeViolationsInSequence {
+		return SyntheticAlertConfigFieldTimeThresholdViolationsInSequence
+	}
+	return input
+}
+
+func (r *globalSyntheticAlertConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.SyntheticAlertConfig, error) {
+	var severityPtr *int
+	if severityStr, ok := d.GetOk(SyntheticAlertConfigFieldSeverity); ok {
+		severity, err := ConvertSeverityFromTerraformToInstanaAPIRepresentation(severityStr.(string))
+		if err != nil {
+			return nil, err
+		}
+		severityPtr = &severity
+	}
+
+	var tagFilter *restapi.TagFilter
+	var err error
+	tagFilterStr, ok := d.GetOk(SyntheticAlertConfigFieldTagFilter)
+	if ok {
+		tagFilter, err = r.mapTagFilterExpressionFromSchema(tagFilterStr.(string))
+		if err != nil {
+			return &restapi.SyntheticAlertConfig{}, err
+		}
+	}
+	customPayloadFields, err := mapDefaultCustomPayloadFieldsFromSchema(d)
+	if err != nil {
+		return &restapi.SyntheticAlertConfig{}, err
+	}
+
+	return &restapi.SyntheticAlertConfig{
+		ID:                    d.Id(),
+		AlertChannelIDs:       ReadStringSetParameterFromResource(d, SyntheticAlertConfigFieldAlertChannelIDs),
+		CustomerPayloadFields: customPayloadFields,
+		Description:           d.Get(SyntheticAlertConfigFieldDescription).(string),
+		Name:                  d.Get(SyntheticAlertConfigFieldName).(string),
+		Rule:                  *r.mapRuleFromSchema(d),
+		Severity:              severityPtr,
+		SyntheticTestIDs:      ReadStringSetParameterFromResource(d, SyntheticAlertConfigFieldSyntheticTestIDs),
+		TagFilterExpression:   tagFilter,
+		TimeThreshold:         *r.mapTimeThresholdFromSchema(d),
+	}, nil
+}
+
+func (r *globalSyntheticAlertConfigResource) mapTagFilterExpressionFromSchema(input string) (*restapi.TagFilter, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return mapper.ToAPIModel(expr), nil
+}
+
+func (r *globalSyntheticAlertConfigResource) mapRuleFromSchema(d *schema.ResourceData) *restapi.SyntheticAlertRule {
+	ruleSlice := d.Get(SyntheticAlertConfigFieldRule).([]interface{})
+	rule := ruleSlice[0].(map[string]interface{})
+	for alertType, v := range rule {
+		configSlice := v.([]interface{})
+		if len(configSlice) == 1 {
+			config := configSlice[0].(map[string]interface{})
+			var aggregationPtr *restapi.Aggregation
+			if aggregationStr, ok := config[SyntheticAlertConfigFieldRuleAggregation]; ok && len(aggregationStr.(string)) > 0 {
+				aggregation := restapi.Aggregation(aggregationStr.(string))
+				aggregationPtr = &aggregation
+			}
+			return &restapi.SyntheticAlertRule{
+				AlertType:   r.mapAlertTypeFromSchema(alertType),
+				MetricName:  config[SyntheticAlertConfigFieldRuleMetricName].(string),
+				Aggregation: aggregationPtr,
+			}
+		}
+	}
+	return &restapi.SyntheticAlertRule{}
+}
+
+func (r *globalSyntheticAlertConfigResource) mapAlertTypeFromSchema(alertType string) string {
+	if alertType == SyntheticAlertConfigFieldRuleFailure {
+		return syntheticAlertRuleTypeFailure
+	}
+	return alertType
+}
+
+func (r *globalSyntheticAlertConfigResource) mapTimeThresholdFromSchema(d *schema.ResourceData) *restapi.SyntheticTimeThreshold {
+	timeThresholdSlice := d.Get(SyntheticAlertConfigFieldTimeThreshold).([]interface{})
+	timeThreshold := timeThresholdSlice[0].(map[string]interface{})
+	for timeThresholdType, v := range timeThreshold {
+		configSlice := v.([]interface{})
+		if len(configSlice) == 1 {
+			var violationsCountPtr *int32
+			if config, ok := configSlice[0].(map[string]interface{}); ok {
+				if v, ok := config[SyntheticAlertConfigFieldTimeThresholdViolationsCount]; ok && v.(int) > 0 {
+					violationsCount := int32(v.(int))
+					violationsCountPtr = &violationsCount
+				}
+			}
+			return &restapi.SyntheticTimeThreshold{
+				Type:            r.mapTimeThresholdTypeFromSchema(timeThresholdType),
+				ViolationsCount: violationsCountPtr,
+			}
+		}
+	}
+	return &restapi.SyntheticTimeThreshold{}
+}
+
+func (r *globalSyntheticAlertConfigResource) mapTimeThresholdTypeFromSchema(input string) string {
+	if input == SyntheticAlertConfigFieldTimeThresholdViolationsInSequence {
+		return syntheticTimeThresholdTypeViolationsInSequence
+	}
+	return input
+}
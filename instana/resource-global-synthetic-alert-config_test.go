@@ -0,0 +1,417 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestGlobalSyntheticAlertConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaGlobalSyntheticAlertConfig + ".example"
+	inst := &globalSyntheticAlertConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewGlobalSyntheticAlertConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type globalSyntheticAlertConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.SyntheticAlertConfig]
+}
+
+var globalSyntheticAlertConfigTerraformTemplate = `
+resource "instana_global_synthetic_alert_config" "example" {
+	name               = "name %d"
+	description        = "test-alert-description"
+	severity           = "warning"
+	synthetic_test_ids = [ "synthetic-test-id" ]
+	alert_channel_ids  = [ "alert-channel-id" ]
+	tag_filter         = "synthetic.locationLabel@na EQUALS 'test'"
+
+	rule {
+		failure {
+			metric_name = "status"
+			aggregation = "SUM"
+		}
+	}
+
+	time_threshold {
+		violations_in_sequence {
+			violations_count = 2
+		}
+	}
+
+	custom_payload_field {
+		key    = "test1"
+		value  = "test123"
+	}
+}
+`
+
+var globalSyntheticAlertConfigServerResponseTemplate = `
+{
+	"id": "%s",
+	"name": "name %d",
+	"description": "test-alert-description",
+	"severity": 5,
+	"syntheticTestIds": [ "synthetic-test-id" ],
+	"tagFilterExpression": {
+		"type": "TAG_FILTER",
+		"name": "synthetic.locationLabel",
+		"stringValue": "test",
+		"value": "test",
+		"operator": "EQUALS",
+		"entity": "NOT_APPLICABLE"
+	},
+	"rule": {
+		"alertType": "failure",
+		"aggregation": "SUM",
+		"metricName": "status"
+	},
+	"alertChannelIds": [ "alert-channel-id" ],
+	"timeThreshold": {
+		"type": "violationsInSequence",
+		"violationsCount": 2
+	},
+	"customPayloadFields": [
+		{
+			"type": "staticString",
+			"key": "test1",
+			"value": "test123"
+		}
+	]
+}
+`
+
+func (test *globalSyntheticAlertConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaGlobalSyntheticAlertConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should have valid schema", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestResourceShouldHaveValidSchema())
+	t.Run(fmt.Sprintf("Should update terraform state of %s from REST response", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("Should update terraform state of %s from REST response without severity and violations count", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestShouldUpdateTerraformResourceStateFromModelWithoutOptionalValues())
+	t.Run(fmt.Sprintf("%s should fail to update state from model when severity is invalid", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestCaseShouldFailToUpdateTerraformResourceStateFromModelWhenSeverityIsNotValid())
+	t.Run(fmt.Sprintf("Should map terraform state of %s to REST model", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("Should map terraform state of %s to REST model without severity and violations count", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestShouldMapTerraformResourceStateToModelWithoutOptionalValues())
+	t.Run(fmt.Sprintf("%s should fail to map state to model when tag filter expression is invalid", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestCaseShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid())
+}
+
+func (test *globalSyntheticAlertConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		id := RandomID()
+		resourceRestAPIPath := restapi.GlobalSyntheticAlertConfigResourcePath
+		resourceInstanceRestAPIPath := resourceRestAPIPath + "/{internal-id}"
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, resourceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			config := &restapi.SyntheticAlertConfig{}
+			err := json.NewDecoder(r.Body).Decode(config)
+			if err != nil {
+				httpServer.WriteInternalServerError(w, err)
+				return
+			}
+			config.ID = id
+			data, err := json.Marshal(config)
+			if err != nil {
+				httpServer.WriteInternalServerError(w, err)
+				return
+			}
+			httpServer.WriteJSONResponse(w, data)
+		})
+		httpServer.AddRoute(http.MethodPost, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			modCount := httpServer.GetCallCount(http.MethodPost, resourceRestAPIPath+"/"+id)
+			httpServer.WriteJSONResponse(w, []byte(fmt.Sprintf(globalSyntheticAlertConfigServerResponseTemplate, id, modCount)))
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+			},
+		})
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createIntegrationTestStep(httpPort int, iteration int, id string) resource.TestStep {
+	ruleFailureMetricName := fmt.Sprintf("%s.%d.%s.%d.%s", SyntheticAlertConfigFieldRule, 0, SyntheticAlertConfigFieldRuleFailure, 0, SyntheticAlertConfigFieldRuleMetricName)
+	ruleFailureAggregation := fmt.Sprintf("%s.%d.%s.%d.%s", SyntheticAlertConfigFieldRule, 0, SyntheticAlertConfigFieldRuleFailure, 0, SyntheticAlertConfigFieldRuleAggregation)
+	timeThresholdViolationsCount := fmt.Sprintf("%s.%d.%s.%d.%s", SyntheticAlertConfigFieldTimeThreshold, 0, SyntheticAlertConfigFieldTimeThresholdViolationsInSequence, 0, SyntheticAlertConfigFieldTimeThresholdViolationsCount)
+	customPayloadFieldStaticKey := fmt.Sprintf("%s.0.%s", DefaultCustomPayloadFieldsName, CustomPayloadFieldsFieldKey)
+	customPayloadFieldStaticValue := fmt.Sprintf("%s.0.%s", DefaultCustomPayloadFieldsName, CustomPayloadFieldsFieldStaticStringValue)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(globalSyntheticAlertConfigTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", id),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, SyntheticAlertConfigFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, SyntheticAlertConfigFieldDescription, "test-alert-description"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, SyntheticAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation()),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, SyntheticAlertConfigFieldSyntheticTestIDs+".0", "synthetic-test-id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, SyntheticAlertConfigFieldAlertChannelIDs+".0", "alert-channel-id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, SyntheticAlertConfigFieldTagFilter, "synthetic.locationLabel@na EQUALS 'test'"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleFailureMetricName, "status"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleFailureAggregation, "SUM"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, timeThresholdViolationsCount, "2"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, customPayloadFieldStaticKey, "test1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, customPayloadFieldStaticValue, "test123"),
+		),
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Len(t, test.resourceHandle.StateUpgraders(), 0)
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, test.resourceHandle.MetaData().ResourceName, "instana_global_synthetic_alert_config")
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestResourceShouldHaveValidSchema() func(t *testing.T) {
+	return func(t *testing.T) {
+		schemaMap := test.resourceHandle.MetaData().Schema
+
+		schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+		require.Len(t, schemaMap, 9)
+		schemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(SyntheticAlertConfigFieldAlertChannelIDs)
+		schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticAlertConfigFieldDescription)
+		schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticAlertConfigFieldName)
+		schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SyntheticAlertConfigFieldSeverity)
+		schemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(SyntheticAlertConfigFieldSyntheticTestIDs)
+		schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SyntheticAlertConfigFieldTagFilter)
+		schemaAssert.AssertSchemaIsRequiredAndOfTypeListOfResource(SyntheticAlertConfigFieldRule)
+		schemaAssert.AssertSchemaIsRequiredAndOfTypeListOfResource(SyntheticAlertConfigFieldTimeThreshold)
+
+		ruleSchemaAssert := testutils.NewTerraformSchemaAssert(schemaMap[SyntheticAlertConfigFieldRule].Elem.(*schema.Resource).Schema, t)
+		ruleSchemaAssert.AssertSchemaIsRequiredAndOfTypeListOfResource(SyntheticAlertConfigFieldRuleFailure)
+
+		timeThresholdSchemaAssert := testutils.NewTerraformSchemaAssert(schemaMap[SyntheticAlertConfigFieldTimeThreshold].Elem.(*schema.Resource).Schema, t)
+		timeThresholdSchemaAssert.AssertSchemaIsRequiredAndOfTypeListOfResource(SyntheticAlertConfigFieldTimeThresholdViolationsInSequence)
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		config := test.createTestSyntheticAlertConfig()
+
+		testHelper := NewTestHelper[*restapi.SyntheticAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, "synthetic-alert-config-id", resourceData.Id())
+		require.Equal(t, []interface{}{"channel-1"}, (resourceData.Get(SyntheticAlertConfigFieldAlertChannelIDs).(*schema.Set)).List())
+		require.Equal(t, []interface{}{"synthetic-test-id"}, (resourceData.Get(SyntheticAlertConfigFieldSyntheticTestIDs).(*schema.Set)).List())
+		require.Equal(t, "synthetic-alert-config-description", resourceData.Get(SyntheticAlertConfigFieldDescription))
+		require.Equal(t, "synthetic-alert-config-name", resourceData.Get(SyntheticAlertConfigFieldName))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "static-key",
+				CustomPayloadFieldsFieldDynamicValue:      []interface{}{},
+				CustomPayloadFieldsFieldStaticStringValue: "static-value",
+			},
+		}, resourceData.Get(DefaultCustomPayloadFieldsName).(*schema.Set).List())
+		require.Equal(t, test.createExpectedRuleState(string(restapi.SumAggregation)), resourceData.Get(SyntheticAlertConfigFieldRule))
+		require.Equal(t, restapi.SeverityCritical.GetTerraformRepresentation(), resourceData.Get(SyntheticAlertConfigFieldSeverity))
+		require.Equal(t, "synthetic.locationLabel@na EQUALS 'test'", resourceData.Get(SyntheticAlertConfigFieldTagFilter))
+		require.Equal(t, test.createExpectedTimeThresholdState(3), resourceData.Get(SyntheticAlertConfigFieldTimeThreshold))
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestShouldUpdateTerraformResourceStateFromModelWithoutOptionalValues() func(t *testing.T) {
+	return func(t *testing.T) {
+		config := test.createTestSyntheticAlertConfig()
+		config.Severity = nil
+		config.Rule.Aggregation = nil
+		config.TimeThreshold.ViolationsCount = nil
+
+		testHelper := NewTestHelper[*restapi.SyntheticAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, "", resourceData.Get(SyntheticAlertConfigFieldSeverity))
+		require.Equal(t, test.createExpectedRuleState(""), resourceData.Get(SyntheticAlertConfigFieldRule))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				SyntheticAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{nil},
+			},
+		}, resourceData.Get(SyntheticAlertConfigFieldTimeThreshold))
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestCaseShouldFailToUpdateTerraformResourceStateFromModelWhenSeverityIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		severity := -1
+		config := restapi.SyntheticAlertConfig{
+			Name:     "test",
+			Severity: &severity,
+		}
+
+		testHelper := NewTestHelper[*restapi.SyntheticAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &config)
+
+		require.Error(t, err)
+		require.Equal(t, "-1 is not a valid severity", err.Error())
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		expectedConfig := test.createTestSyntheticAlertConfig()
+
+		testHelper := NewTestHelper[*restapi.SyntheticAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		test.setDefaultValuesOnResourceData(t, resourceData)
+		setValueOnResourceData(t, resourceData, SyntheticAlertConfigFieldSeverity, restapi.SeverityCritical.GetTerraformRepresentation())
+		setValueOnResourceData(t, resourceData, SyntheticAlertConfigFieldRule, test.createExpectedRuleState(string(restapi.SumAggregation)))
+		setValueOnResourceData(t, resourceData, SyntheticAlertConfigFieldTimeThreshold, test.createExpectedTimeThresholdState(3))
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, expectedConfig, result)
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestShouldMapTerraformResourceStateToModelWithoutOptionalValues() func(t *testing.T) {
+	return func(t *testing.T) {
+		expectedConfig := test.createTestSyntheticAlertConfig()
+		expectedConfig.Severity = nil
+		expectedConfig.Rule.Aggregation = nil
+		expectedConfig.TimeThreshold.ViolationsCount = nil
+
+		testHelper := NewTestHelper[*restapi.SyntheticAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		test.setDefaultValuesOnResourceData(t, resourceData)
+		setValueOnResourceData(t, resourceData, SyntheticAlertConfigFieldRule, test.createExpectedRuleState(""))
+		setValueOnResourceData(t, resourceData, SyntheticAlertConfigFieldTimeThreshold, test.createExpectedTimeThresholdState(0))
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, expectedConfig, result)
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestCaseShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.SyntheticAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, SyntheticAlertConfigFieldName, "synthetic-alert-config-name")
+		setValueOnResourceData(t, resourceData, SyntheticAlertConfigFieldTagFilter, "invalid invalid invalid")
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unexpected token")
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) setDefaultValuesOnResourceData(t *testing.T, resourceData *schema.ResourceData) {
+	setValueOnResourceData(t, resourceData, SyntheticAlertConfigFieldAlertChannelIDs, []interface{}{"channel-1"})
+	setValueOnResourceData(t, resourceData, SyntheticAlertConfigFieldSyntheticTestIDs, []interface{}{"synthetic-test-id"})
+	setValueOnResourceData(t, resourceData, DefaultCustomPayloadFieldsName, []interface{}{
+		map[string]interface{}{
+			CustomPayloadFieldsFieldKey:               "static-key",
+			CustomPayloadFieldsFieldStaticStringValue: "static-value",
+			CustomPayloadFieldsFieldDynamicValue:      []interface{}{},
+		},
+	})
+	setValueOnResourceData(t, resourceData, SyntheticAlertConfigFieldDescription, "synthetic-alert-config-description")
+	setValueOnResourceData(t, resourceData, SyntheticAlertConfigFieldName, "synthetic-alert-config-name")
+	setValueOnResourceData(t, resourceData, SyntheticAlertConfigFieldTagFilter, "synthetic.locationLabel@na EQUALS 'test'")
+	resourceData.SetId("synthetic-alert-config-id")
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestSyntheticAlertConfig() *restapi.SyntheticAlertConfig {
+	severity := restapi.SeverityCritical.GetAPIRepresentation()
+	aggregation := restapi.SumAggregation
+	violationsCount := int32(3)
+	return &restapi.SyntheticAlertConfig{
+		ID:              "synthetic-alert-config-id",
+		AlertChannelIDs: []string{"channel-1"},
+		CustomerPayloadFields: []restapi.CustomPayloadField[any]{
+			{
+				Type:  restapi.StaticStringCustomPayloadType,
+				Key:   "static-key",
+				Value: restapi.StaticStringCustomPayloadFieldValue("static-value"),
+			},
+		},
+		Description: "synthetic-alert-config-description",
+		Name:        "synthetic-alert-config-name",
+		Rule: restapi.SyntheticAlertRule{
+			AlertType:   "failure",
+			MetricName:  "status",
+			Aggregation: &aggregation,
+		},
+		Severity:            &severity,
+		SyntheticTestIDs:    []string{"synthetic-test-id"},
+		TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "synthetic.locationLabel", restapi.EqualsOperator, "test"),
+		TimeThreshold: restapi.SyntheticTimeThreshold{
+			Type:            "violationsInSequence",
+			ViolationsCount: &violationsCount,
+		},
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createExpectedRuleState(aggregation string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			SyntheticAlertConfigFieldRuleFailure: []interface{}{
+				map[string]interface{}{
+					SyntheticAlertConfigFieldRuleMetricName:  "status",
+					SyntheticAlertConfigFieldRuleAggregation: aggregation,
+				},
+			},
+		},
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createExpectedTimeThresholdState(violationsCount int) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			SyntheticAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{
+				map[string]interface{}{
+					SyntheticAlertConfigFieldTimeThresholdViolationsCount: violationsCount,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceInstanaGroupMappingSettings the name of the terraform-provider-instana resource to manage the settings of IdP group mappings
+const ResourceInstanaGroupMappingSettings = "instana_rbac_group_mapping_settings"
+
+// GroupMappingSettingsFieldRestrictEmptyIdpGroups constant value for the schema field restrict_empty_idp_groups
+const GroupMappingSettingsFieldRestrictEmptyIdpGroups = "restrict_empty_idp_groups"
+
+// NewGroupMappingSettingsResourceHandle creates the resource handle for the settings of IdP group mappings
+func NewGroupMappingSettingsResourceHandle() ResourceHandle[*restapi.GroupMappingSettings] {
+	return &groupMappingSettingsResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaGroupMappingSettings,
+			Schema: map[string]*schema.Schema{
+				GroupMappingSettingsFieldRestrictEmptyIdpGroups: {
+					Type:        schema.TypeBool,
+					Required:    true,
+					Description: "Flag to indicate whether users without any IdP group are denied to login",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type groupMappingSettingsResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *groupMappingSettingsResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *groupMappingSettingsResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *groupMappingSettingsResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.GroupMappingSettings] {
+	return api.GroupMappingSettings()
+}
+
+func (r *groupMappingSettingsResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *groupMappingSettingsResource) UpdateState(d *schema.ResourceData, settings *restapi.GroupMappingSettings) error {
+	d.SetId(restapi.GroupMappingSettingsID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		GroupMappingSettingsFieldRestrictEmptyIdpGroups: settings.RestrictEmptyIdpGroups,
+	})
+}
+
+func (r *groupMappingSettingsResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.GroupMappingSettings, error) {
+	return &restapi.GroupMappingSettings{
+		RestrictEmptyIdpGroups: d.Get(GroupMappingSettingsFieldRestrictEmptyIdpGroups).(bool),
+	}, nil
+}
@@ -0,0 +1,140 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestGroupMappingSettingsResource(t *testing.T) {
+	ut := &groupMappingSettingsUnitTest{}
+	t.Run("CRUD integration test", groupMappingSettingsIntegrationTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should update resource state", ut.shouldUpdateResourceState)
+	t.Run("should map state to data model", ut.shouldMapStateToDataModel)
+}
+
+const groupMappingSettingsDefinition = "instana_rbac_group_mapping_settings.example"
+
+const resourceGroupMappingSettingsDefinitionTemplate = `
+resource "instana_rbac_group_mapping_settings" "example" {
+  restrict_empty_idp_groups = %t
+}
+`
+
+func groupMappingSettingsIntegrationTest(t *testing.T) {
+	var mutex sync.Mutex
+	settings := &restapi.GroupMappingSettings{}
+	httpServer := testutils.NewTestHTTPServer()
+	writeSettings := func(w http.ResponseWriter) {
+		response, err := json.Marshal(settings)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		httpServer.WriteJSONResponse(w, response)
+	}
+	httpServer.AddRoute(http.MethodGet, restapi.GroupMappingSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		writeSettings(w)
+	})
+	httpServer.AddRoute(http.MethodPut, restapi.GroupMappingSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		settings = &restapi.GroupMappingSettings{}
+		if err := json.NewDecoder(r.Body).Decode(settings); err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		writeSettings(w)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createGroupMappingSettingsTestStep(httpServer.GetPort(), true),
+			testStepImportWithCustomID(groupMappingSettingsDefinition, restapi.GroupMappingSettingsID),
+			createGroupMappingSettingsTestStep(httpServer.GetPort(), false),
+			createGroupMappingSettingsTestStep(httpServer.GetPort(), true),
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if settings.RestrictEmptyIdpGroups {
+				return fmt.Errorf("expected group mapping settings to be reset")
+			}
+			return nil
+		},
+	})
+}
+
+func createGroupMappingSettingsTestStep(httpPort int, restrictEmptyIdpGroups bool) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(resourceGroupMappingSettingsDefinitionTemplate, restrictEmptyIdpGroups), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(groupMappingSettingsDefinition, "id", restapi.GroupMappingSettingsID),
+			resource.TestCheckResourceAttr(groupMappingSettingsDefinition, GroupMappingSettingsFieldRestrictEmptyIdpGroups, fmt.Sprintf("%t", restrictEmptyIdpGroups)),
+		),
+	}
+}
+
+type groupMappingSettingsUnitTest struct{}
+
+func (ut *groupMappingSettingsUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	schemaMap := NewGroupMappingSettingsResourceHandle().MetaData().Schema
+
+	require.Len(t, schemaMap, 1)
+	require.True(t, schemaMap[GroupMappingSettingsFieldRestrictEmptyIdpGroups].Required)
+}
+
+func (ut *groupMappingSettingsUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_rbac_group_mapping_settings", NewGroupMappingSettingsResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *groupMappingSettingsUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewGroupMappingSettingsResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *groupMappingSettingsUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewGroupMappingSettingsResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *groupMappingSettingsUnitTest) shouldUpdateResourceState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupMappingSettings](t)
+	resourceHandle := NewGroupMappingSettingsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.GroupMappingSettings{RestrictEmptyIdpGroups: true})
+
+	require.NoError(t, err)
+	require.Equal(t, restapi.GroupMappingSettingsID, resourceData.Id())
+	require.Equal(t, true, resourceData.Get(GroupMappingSettingsFieldRestrictEmptyIdpGroups))
+}
+
+func (ut *groupMappingSettingsUnitTest) shouldMapStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupMappingSettings](t)
+	resourceHandle := NewGroupMappingSettingsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, GroupMappingSettingsFieldRestrictEmptyIdpGroups, true)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.GroupMappingSettings{RestrictEmptyIdpGroups: true}, result)
+}
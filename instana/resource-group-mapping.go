@@ -0,0 +1,89 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaGroupMapping the name of the terraform-provider-instana resource to manage IdP group mappings for role based access control
+const ResourceInstanaGroupMapping = "instana_rbac_group_mapping"
+
+const (
+	//GroupMappingFieldGroupID constant value for the schema field group_id
+	GroupMappingFieldGroupID = "group_id"
+	//GroupMappingFieldKey constant value for the schema field key
+	GroupMappingFieldKey = "key"
+	//GroupMappingFieldValue constant value for the schema field value
+	GroupMappingFieldValue = "value"
+)
+
+// NewGroupMappingResourceHandle creates the resource handle for IdP group mappings
+func NewGroupMappingResourceHandle() ResourceHandle[*restapi.GroupMapping] {
+	return &groupMappingResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaGroupMapping,
+			Schema: map[string]*schema.Schema{
+				GroupMappingFieldGroupID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The ID of the Instana group the users are added to",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				GroupMappingFieldKey: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The key of the attribute provided by the identity provider, e.g. memberOf",
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+				GroupMappingFieldValue: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The value of the attribute provided by the identity provider, e.g. the name of the group of the identity provider",
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type groupMappingResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *groupMappingResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *groupMappingResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *groupMappingResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.GroupMapping] {
+	return api.GroupMappings()
+}
+
+func (r *groupMappingResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *groupMappingResource) UpdateState(d *schema.ResourceData, mapping *restapi.GroupMapping) error {
+	d.SetId(mapping.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		GroupMappingFieldGroupID: mapping.GroupID,
+		GroupMappingFieldKey:     mapping.Key,
+		GroupMappingFieldValue:   mapping.Value,
+	})
+}
+
+func (r *groupMappingResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.GroupMapping, error) {
+	return &restapi.GroupMapping{
+		ID:      d.Id(),
+		GroupID: d.Get(GroupMappingFieldGroupID).(string),
+		Key:     d.Get(GroupMappingFieldKey).(string),
+		Value:   d.Get(GroupMappingFieldValue).(string),
+	}, nil
+}
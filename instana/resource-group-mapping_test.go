@@ -0,0 +1,197 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestGroupMappingResource(t *testing.T) {
+	ut := &groupMappingUnitTest{}
+	t.Run("CRUD integration test", groupMappingIntegrationTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should update resource state", ut.shouldUpdateResourceState)
+	t.Run("should map state to data model", ut.shouldMapStateToDataModel)
+}
+
+const (
+	groupMappingDefinition = "instana_rbac_group_mapping.example"
+	groupMappingID         = "group-mapping-id"
+	groupMappingGroupID    = "group-id"
+)
+
+const resourceGroupMappingDefinitionTemplate = `
+resource "instana_rbac_group" "example" {
+  name = "name"
+}
+
+resource "instana_rbac_group_mapping" "example" {
+  group_id = instana_rbac_group.example.id
+  key      = "memberOf"
+  value    = "idp-group-%d"
+}
+`
+
+const groupMappingTestGroupResponse = `
+{
+	"id" : "group-id",
+	"name" : "name",
+	"members": [],
+	"permissionSet": {
+		"permissions": [],
+		"applicationIds": [],
+		"kubernetesClusterUUIDs": [],
+		"kubernetesNamespaceUIDs": [],
+		"websiteIds": [],
+		"mobileAppIds": [],
+		"infraDfqFilter": {
+			"scopeId": "",
+			"scopeRoleId": "-600"
+		}
+	}
+}
+`
+
+func groupMappingIntegrationTest(t *testing.T) {
+	var mutex sync.Mutex
+	mappings := make(map[string]*restapi.GroupMapping)
+	resourceInstancePath := restapi.GroupMappingsResourcePath + "/{id}"
+	httpServer := testutils.NewTestHTTPServer()
+	writeJSON := func(w http.ResponseWriter, data interface{}) {
+		response, err := json.Marshal(data)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		httpServer.WriteJSONResponse(w, response)
+	}
+	store := func(w http.ResponseWriter, r *http.Request, id string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		mapping := &restapi.GroupMapping{}
+		if err := json.NewDecoder(r.Body).Decode(mapping); err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		mapping.ID = id
+		mappings[id] = mapping
+		writeJSON(w, mapping)
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.GroupMappingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		store(w, r, groupMappingID)
+	})
+	httpServer.AddRoute(http.MethodPut, resourceInstancePath, func(w http.ResponseWriter, r *http.Request) {
+		store(w, r, mux.Vars(r)["id"])
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.GroupMappingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		result := make([]*restapi.GroupMapping, 0)
+		for _, mapping := range mappings {
+			result = append(result, mapping)
+		}
+		writeJSON(w, result)
+	})
+	httpServer.AddRoute(http.MethodDelete, resourceInstancePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		delete(mappings, mux.Vars(r)["id"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+	groupHandler := func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, []byte(groupMappingTestGroupResponse))
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.GroupsResourcePath, groupHandler)
+	httpServer.AddRoute(http.MethodGet, restapi.GroupsResourcePath+"/{id}", groupHandler)
+	httpServer.AddRoute(http.MethodDelete, restapi.GroupsResourcePath+"/{id}", testutils.EchoHandlerFunc)
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createGroupMappingTestStep(httpServer.GetPort(), 0),
+			testStepImportWithCustomID(groupMappingDefinition, groupMappingID),
+			createGroupMappingTestStep(httpServer.GetPort(), 1),
+			testStepImportWithCustomID(groupMappingDefinition, groupMappingID),
+		},
+	})
+}
+
+func createGroupMappingTestStep(httpPort int, iteration int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(resourceGroupMappingDefinitionTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(groupMappingDefinition, "id", groupMappingID),
+			resource.TestCheckResourceAttr(groupMappingDefinition, GroupMappingFieldGroupID, groupMappingGroupID),
+			resource.TestCheckResourceAttr(groupMappingDefinition, GroupMappingFieldKey, "memberOf"),
+			resource.TestCheckResourceAttr(groupMappingDefinition, GroupMappingFieldValue, fmt.Sprintf("idp-group-%d", iteration)),
+		),
+	}
+}
+
+type groupMappingUnitTest struct{}
+
+func (ut *groupMappingUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	schemaMap := NewGroupMappingResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 3)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupMappingFieldGroupID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupMappingFieldKey)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupMappingFieldValue)
+}
+
+func (ut *groupMappingUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_rbac_group_mapping", NewGroupMappingResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *groupMappingUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewGroupMappingResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *groupMappingUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewGroupMappingResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *groupMappingUnitTest) shouldUpdateResourceState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupMapping](t)
+	resourceHandle := NewGroupMappingResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.GroupMapping{ID: groupMappingID, GroupID: groupMappingGroupID, Key: "memberOf", Value: "idp-group"})
+
+	require.NoError(t, err)
+	require.Equal(t, groupMappingID, resourceData.Id())
+	require.Equal(t, groupMappingGroupID, resourceData.Get(GroupMappingFieldGroupID))
+	require.Equal(t, "memberOf", resourceData.Get(GroupMappingFieldKey))
+	require.Equal(t, "idp-group", resourceData.Get(GroupMappingFieldValue))
+}
+
+func (ut *groupMappingUnitTest) shouldMapStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupMapping](t)
+	resourceHandle := NewGroupMappingResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(groupMappingID)
+	setValueOnResourceData(t, resourceData, GroupMappingFieldGroupID, groupMappingGroupID)
+	setValueOnResourceData(t, resourceData, GroupMappingFieldKey, "memberOf")
+	setValueOnResourceData(t, resourceData, GroupMappingFieldValue, "idp-group")
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.GroupMapping{ID: groupMappingID, GroupID: groupMappingGroupID, Key: "memberOf", Value: "idp-group"}, result)
+}
@@ -0,0 +1,130 @@
+package instana
+
+import (
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaGroupMembership the name of the terraform-provider-instana resource to manage the members of groups for role based access control
+const ResourceInstanaGroupMembership = "instana_rbac_group_membership"
+
+const (
+	//GroupMembershipFieldGroupID constant value for the schema field group_id
+	GroupMembershipFieldGroupID = "group_id"
+	//GroupMembershipFieldMembers constant value for the schema field members
+	GroupMembershipFieldMembers = "members"
+)
+
+// NewGroupMembershipResourceHandle creates the resource handle for the members of RBAC groups
+func NewGroupMembershipResourceHandle() ResourceHandle[*restapi.GroupMembership] {
+	return &groupMembershipResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaGroupMembership,
+			Schema: map[string]*schema.Schema{
+				GroupMembershipFieldGroupID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "The ID of the Instana group",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				GroupMembershipFieldMembers: {
+					Type:     schema.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The members of the group identified either by their user id or by their email address",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type groupMembershipResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *groupMembershipResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *groupMembershipResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *groupMembershipResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.GroupMembership] {
+	return api.GroupMemberships()
+}
+
+func (r *groupMembershipResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+// UpdateState updates the members of the state. Only the members which are managed by the resource, i.e. which are
+// contained in the current state, are kept. They are represented by the identifier of the state so that members
+// referenced by email address are kept as email address. On import, all members of the group are taken over by their
+// user id.
+func (r *groupMembershipResource) UpdateState(d *schema.ResourceData, membership *restapi.GroupMembership) error {
+	managed := ReadStringSetParameterFromResource(d, GroupMembershipFieldMembers)
+	members := make([]string, 0, len(membership.Members))
+	if len(d.Get(GroupMembershipFieldGroupID).(string)) == 0 {
+		for _, member := range membership.Members {
+			members = append(members, member.UserID)
+		}
+	}
+	for _, value := range managed {
+		for _, member := range membership.Members {
+			if r.toAPIMember(value).Matches(member) {
+				members = append(members, value)
+				break
+			}
+		}
+	}
+
+	d.SetId(membership.GroupID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		GroupMembershipFieldGroupID: membership.GroupID,
+		GroupMembershipFieldMembers: members,
+	})
+}
+
+func (r *groupMembershipResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.GroupMembership, error) {
+	previousMembers, _ := d.GetChange(GroupMembershipFieldMembers)
+	groupID := d.Get(GroupMembershipFieldGroupID).(string)
+	if len(groupID) == 0 {
+		groupID = d.Id()
+	}
+	return &restapi.GroupMembership{
+		GroupID:         groupID,
+		Members:         r.toAPIMembers(d.Get(GroupMembershipFieldMembers).(*schema.Set)),
+		PreviousMembers: r.toAPIMembers(previousMembers.(*schema.Set)),
+	}, nil
+}
+
+func (r *groupMembershipResource) toAPIMembers(values *schema.Set) []restapi.APIMember {
+	members := make([]restapi.APIMember, values.Len())
+	for i, value := range values.List() {
+		members[i] = r.toAPIMember(value.(string))
+	}
+	return members
+}
+
+func (r *groupMembershipResource) toAPIMember(value string) restapi.APIMember {
+	if r.isEmail(value) {
+		return restapi.APIMember{Email: &value}
+	}
+	return restapi.APIMember{UserID: value}
+}
+
+func (r *groupMembershipResource) isEmail(value string) bool {
+	return strings.Contains(value, "@")
+}
@@ -0,0 +1,261 @@
+package instana_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestGroupMembershipResource(t *testing.T) {
+	ut := &groupMembershipUnitTest{}
+	t.Run("CRUD integration test", groupMembershipIntegrationTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should update resource state with user ids on import", ut.shouldUpdateResourceStateWithUserIDsOnImport)
+	t.Run("should update resource state with emails when emails are used in state", ut.shouldUpdateResourceStateWithEmailsWhenEmailsAreUsedInState)
+	t.Run("should only keep managed members in resource state", ut.shouldOnlyKeepManagedMembersInResourceState)
+	t.Run("should map state to data model", ut.shouldMapStateToDataModel)
+	t.Run("should map previous members to data model", ut.shouldMapPreviousMembersToDataModel)
+	t.Run("should use resource id as group id when group id is not set", ut.shouldUseResourceIDAsGroupIDWhenGroupIDIsNotSet)
+}
+
+const (
+	groupMembershipDefinition = "instana_rbac_group_membership.example"
+	groupMembershipGroupID    = "group-id"
+)
+
+const resourceGroupMembershipDefinitionTemplate = `
+resource "instana_rbac_group_membership" "example" {
+  group_id = "group-id"
+  members  = [ %s ]
+}
+`
+
+func groupMembershipIntegrationTest(t *testing.T) {
+	var mutex sync.Mutex
+	users := map[string]string{"user-1": "user1@example.com", "user-2": "user2@example.com", "user-3": "user3@example.com"}
+	members := make(map[string]bool)
+	groupPath := restapi.GroupsResourcePath + "/" + groupMembershipGroupID
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, groupPath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		group := restapi.Group{ID: groupMembershipGroupID, Name: "name", Members: []restapi.APIMember{}}
+		for userID := range members {
+			group.Members = append(group.Members, restapi.APIMember{UserID: userID})
+		}
+		response, err := json.Marshal(group)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		httpServer.WriteJSONResponse(w, response)
+	})
+	httpServer.AddRoute(http.MethodPut, groupPath+"/users", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		userIDs := make([]string, 0)
+		if err := json.NewDecoder(r.Body).Decode(&userIDs); err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		for _, userID := range userIDs {
+			if _, ok := users[userID]; !ok {
+				httpServer.WriteInternalServerError(w, fmt.Errorf("user %s does not exist", userID))
+				return
+			}
+			members[userID] = true
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.UsersResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		result := make([]*restapi.User, 0)
+		for userID, email := range users {
+			result = append(result, &restapi.User{ID: userID, Email: email})
+		}
+		response, err := json.Marshal(result)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		httpServer.WriteJSONResponse(w, response)
+	})
+	httpServer.AddRoute(http.MethodDelete, groupPath+"/user/{userId}", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		delete(members, mux.Vars(r)["userId"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createGroupMembershipTestStep(httpServer.GetPort(), `"user-1", "user-2"`, "user-1", "user-2"),
+			testStepImportWithCustomID(groupMembershipDefinition, groupMembershipGroupID),
+			createGroupMembershipTestStep(httpServer.GetPort(), `"user-2", "user3@example.com"`, "user-2", "user3@example.com"),
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if len(members) > 0 {
+				return fmt.Errorf("expected all members to be removed from group but got %v", members)
+			}
+			return nil
+		},
+	})
+}
+
+func createGroupMembershipTestStep(httpPort int, membersConfig string, expectedMembers ...string) resource.TestStep {
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(groupMembershipDefinition, "id", groupMembershipGroupID),
+		resource.TestCheckResourceAttr(groupMembershipDefinition, GroupMembershipFieldGroupID, groupMembershipGroupID),
+		resource.TestCheckResourceAttr(groupMembershipDefinition, GroupMembershipFieldMembers+".#", fmt.Sprintf("%d", len(expectedMembers))),
+	}
+	for _, member := range expectedMembers {
+		checks = append(checks, resource.TestCheckTypeSetElemAttr(groupMembershipDefinition, GroupMembershipFieldMembers+".*", member))
+	}
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(resourceGroupMembershipDefinitionTemplate, membersConfig), httpPort),
+		Check:  resource.ComposeTestCheckFunc(checks...),
+	}
+}
+
+type groupMembershipUnitTest struct{}
+
+func (ut *groupMembershipUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	schemaMap := NewGroupMembershipResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 2)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupMembershipFieldGroupID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeSetOfStrings(GroupMembershipFieldMembers)
+	require.True(t, schemaMap[GroupMembershipFieldGroupID].ForceNew)
+}
+
+func (ut *groupMembershipUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_rbac_group_membership", NewGroupMembershipResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *groupMembershipUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewGroupMembershipResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *groupMembershipUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewGroupMembershipResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *groupMembershipUnitTest) shouldUpdateResourceStateWithUserIDsOnImport(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupMembership](t)
+	resourceHandle := NewGroupMembershipResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	email := "user1@example.com"
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.GroupMembership{GroupID: groupMembershipGroupID, Members: []restapi.APIMember{{UserID: "user-1", Email: &email}, {UserID: "user-2"}}})
+
+	require.NoError(t, err)
+	require.Equal(t, groupMembershipGroupID, resourceData.Id())
+	require.Equal(t, groupMembershipGroupID, resourceData.Get(GroupMembershipFieldGroupID))
+	require.ElementsMatch(t, []interface{}{"user-1", "user-2"}, resourceData.Get(GroupMembershipFieldMembers).(*schema.Set).List())
+}
+
+func (ut *groupMembershipUnitTest) shouldUpdateResourceStateWithEmailsWhenEmailsAreUsedInState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupMembership](t)
+	resourceHandle := NewGroupMembershipResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, GroupMembershipFieldGroupID, groupMembershipGroupID)
+	setValueOnResourceData(t, resourceData, GroupMembershipFieldMembers, []interface{}{"User1@example.com", "user-2"})
+	email1 := "user1@example.com"
+	email2 := "user2@example.com"
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.GroupMembership{GroupID: groupMembershipGroupID, Members: []restapi.APIMember{{UserID: "user-1", Email: &email1}, {UserID: "user-2", Email: &email2}}})
+
+	require.NoError(t, err)
+	require.ElementsMatch(t, []interface{}{"User1@example.com", "user-2"}, resourceData.Get(GroupMembershipFieldMembers).(*schema.Set).List())
+}
+
+func (ut *groupMembershipUnitTest) shouldOnlyKeepManagedMembersInResourceState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupMembership](t)
+	resourceHandle := NewGroupMembershipResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, GroupMembershipFieldGroupID, groupMembershipGroupID)
+	setValueOnResourceData(t, resourceData, GroupMembershipFieldMembers, []interface{}{"user-1", "user2@example.com", "user-4"})
+	email2 := "user2@example.com"
+	email3 := "user3@example.com"
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.GroupMembership{GroupID: groupMembershipGroupID, Members: []restapi.APIMember{{UserID: "user-1"}, {UserID: "user-2", Email: &email2}, {UserID: "user-3", Email: &email3}}})
+
+	require.NoError(t, err)
+	require.ElementsMatch(t, []interface{}{"user-1", "user2@example.com"}, resourceData.Get(GroupMembershipFieldMembers).(*schema.Set).List())
+}
+
+func (ut *groupMembershipUnitTest) shouldMapStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupMembership](t)
+	resourceHandle := NewGroupMembershipResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(groupMembershipGroupID)
+	setValueOnResourceData(t, resourceData, GroupMembershipFieldGroupID, groupMembershipGroupID)
+	setValueOnResourceData(t, resourceData, GroupMembershipFieldMembers, []interface{}{"user1@example.com", "user-2"})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, groupMembershipGroupID, result.GroupID)
+	email := "user1@example.com"
+	require.ElementsMatch(t, []restapi.APIMember{{Email: &email}, {UserID: "user-2"}}, result.Members)
+	require.Empty(t, result.PreviousMembers)
+}
+
+func (ut *groupMembershipUnitTest) shouldMapPreviousMembersToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupMembership](t)
+	resourceHandle := NewGroupMembershipResourceHandle()
+	state := testHelper.CreateResourceDataForResourceHandle(resourceHandle, map[string]interface{}{
+		GroupMembershipFieldGroupID: groupMembershipGroupID,
+		GroupMembershipFieldMembers: []interface{}{"user-1", "user-2"},
+	}).State()
+	schemaMap := schema.InternalMap(resourceHandle.MetaData().Schema)
+	diff, err := schemaMap.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		GroupMembershipFieldGroupID: groupMembershipGroupID,
+		GroupMembershipFieldMembers: []interface{}{"user-2", "user3@example.com"},
+	}), nil, nil, true)
+	require.NoError(t, err)
+	resourceData, err := schemaMap.Data(state, diff)
+	require.NoError(t, err)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	email := "user3@example.com"
+	require.ElementsMatch(t, []restapi.APIMember{{UserID: "user-2"}, {Email: &email}}, result.Members)
+	require.ElementsMatch(t, []restapi.APIMember{{UserID: "user-1"}, {UserID: "user-2"}}, result.PreviousMembers)
+}
+
+func (ut *groupMembershipUnitTest) shouldUseResourceIDAsGroupIDWhenGroupIDIsNotSet(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupMembership](t)
+	resourceHandle := NewGroupMembershipResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(groupMembershipGroupID)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.GroupMembership{GroupID: groupMembershipGroupID, Members: []restapi.APIMember{}, PreviousMembers: []restapi.APIMember{}}, result)
+}
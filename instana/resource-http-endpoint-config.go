@@ -0,0 +1,228 @@
+package instana
+
+import (
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaHTTPEndpointConfig the name of the terraform-provider-instana resource to manage HTTP endpoint configurations of services
+const ResourceInstanaHTTPEndpointConfig = "instana_http_endpoint_config"
+
+const (
+	//HTTPEndpointConfigFieldServiceID constant value for the schema field service_id
+	HTTPEndpointConfigFieldServiceID = "service_id"
+	//HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled constant value for the schema field endpoint_name_by_first_path_segment_rule_enabled
+	HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled = "endpoint_name_by_first_path_segment_rule_enabled"
+	//HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled constant value for the schema field endpoint_name_by_collected_path_template_rule_enabled
+	HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled = "endpoint_name_by_collected_path_template_rule_enabled"
+	//HTTPEndpointConfigFieldRule constant value for the schema field rule
+	HTTPEndpointConfigFieldRule = "rule"
+	//HTTPEndpointConfigFieldRuleEnabled constant value for the schema field rule.enabled
+	HTTPEndpointConfigFieldRuleEnabled = "enabled"
+	//HTTPEndpointConfigFieldRulePathSegment constant value for the schema field rule.path_segment
+	HTTPEndpointConfigFieldRulePathSegment = "path_segment"
+	//HTTPEndpointConfigFieldRulePathSegmentType constant value for the schema field rule.path_segment.type
+	HTTPEndpointConfigFieldRulePathSegmentType = "type"
+	//HTTPEndpointConfigFieldRulePathSegmentName constant value for the schema field rule.path_segment.name
+	HTTPEndpointConfigFieldRulePathSegmentName = "name"
+	//HTTPEndpointConfigFieldRuleTestCases constant value for the schema field rule.test_cases
+	HTTPEndpointConfigFieldRuleTestCases = "test_cases"
+)
+
+// NewHTTPEndpointConfigResourceHandle creates the resource handle for HTTP endpoint configurations of services
+func NewHTTPEndpointConfigResourceHandle() ResourceHandle[*restapi.HTTPEndpointConfig] {
+	return &httpEndpointConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaHTTPEndpointConfig,
+			Schema: map[string]*schema.Schema{
+				HTTPEndpointConfigFieldServiceID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "The ID of the service the HTTP endpoint configuration belongs to",
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Flag to indicate whether endpoints are named by the first path segment when no rule matches",
+				},
+				HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Flag to indicate whether endpoints are named by the path template collected by the tracer, e.g. from the framework, when no rule matches",
+				},
+				HTTPEndpointConfigFieldRule: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MinItems:    0,
+					MaxItems:    500,
+					Description: "The rules which are used to name HTTP endpoints of the service. The rules are evaluated in the given order",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							HTTPEndpointConfigFieldRuleEnabled: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     true,
+								Description: "Flag to indicate whether the rule is enabled",
+							},
+							HTTPEndpointConfigFieldRulePathSegment: {
+								Type:        schema.TypeList,
+								Required:    true,
+								MinItems:    1,
+								MaxItems:    16,
+								Description: "The path segments which are matched by the rule",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										HTTPEndpointConfigFieldRulePathSegmentType: {
+											Type:         schema.TypeString,
+											Required:     true,
+											Description:  "The type of the path segment matching rule",
+											ValidateFunc: validation.StringInSlice(restapi.SupportedHTTPPathSegmentMatchingTypes.ToStringSlice(), false),
+										},
+										HTTPEndpointConfigFieldRulePathSegmentName: {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "The name of the path segment for FIXED path segments or the name of the parameter for PARAMETER path segments. Not supported for MATCH_ALL path segments",
+										},
+									},
+								},
+							},
+							HTTPEndpointConfigFieldRuleTestCases: {
+								Type:        schema.TypeList,
+								Optional:    true,
+								MinItems:    0,
+								MaxItems:    32,
+								Description: "Example paths which are expected to match the rule",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type httpEndpointConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *httpEndpointConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *httpEndpointConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *httpEndpointConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.HTTPEndpointConfig] {
+	return api.HTTPEndpointConfigs()
+}
+
+func (r *httpEndpointConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *httpEndpointConfigResource) UpdateState(d *schema.ResourceData, config *restapi.HTTPEndpointConfig) error {
+	d.SetId(config.ServiceID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		HTTPEndpointConfigFieldServiceID:                                      config.ServiceID,
+		HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled:      config.EndpointNameByFirstPathSegmentRuleEnabled,
+		HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled: config.EndpointNameByCollectedPathTemplateRuleEnabled,
+		HTTPEndpointConfigFieldRule:                                           r.mapRulesToState(config.Rules),
+	})
+}
+
+func (r *httpEndpointConfigResource) mapRulesToState(rules []restapi.HTTPEndpointRule) []interface{} {
+	result := make([]interface{}, len(rules))
+	for i, rule := range rules {
+		pathSegments := make([]interface{}, len(rule.PathSegments))
+		for j, pathSegment := range rule.PathSegments {
+			name := ""
+			if pathSegment.Name != nil {
+				name = *pathSegment.Name
+			}
+			pathSegments[j] = map[string]interface{}{
+				HTTPEndpointConfigFieldRulePathSegmentType: string(pathSegment.Type),
+				HTTPEndpointConfigFieldRulePathSegmentName: name,
+			}
+		}
+		testCases := make([]interface{}, len(rule.TestCases))
+		for j, testCase := range rule.TestCases {
+			testCases[j] = testCase
+		}
+		result[i] = map[string]interface{}{
+			HTTPEndpointConfigFieldRuleEnabled:     rule.Enabled,
+			HTTPEndpointConfigFieldRulePathSegment: pathSegments,
+			HTTPEndpointConfigFieldRuleTestCases:   testCases,
+		}
+	}
+	return result
+}
+
+func (r *httpEndpointConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.HTTPEndpointConfig, error) {
+	rules, err := r.mapRulesFromState(d.Get(HTTPEndpointConfigFieldRule).([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return &restapi.HTTPEndpointConfig{
+		ServiceID: d.Get(HTTPEndpointConfigFieldServiceID).(string),
+		EndpointNameByFirstPathSegmentRuleEnabled:      d.Get(HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled).(bool),
+		EndpointNameByCollectedPathTemplateRuleEnabled: d.Get(HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled).(bool),
+		Rules: rules,
+	}, nil
+}
+
+func (r *httpEndpointConfigResource) mapRulesFromState(data []interface{}) ([]restapi.HTTPEndpointRule, error) {
+	result := make([]restapi.HTTPEndpointRule, len(data))
+	for i, v := range data {
+		rule := v.(map[string]interface{})
+		pathSegments, err := r.mapPathSegmentsFromState(rule[HTTPEndpointConfigFieldRulePathSegment].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		testCasesData := rule[HTTPEndpointConfigFieldRuleTestCases].([]interface{})
+		testCases := make([]string, len(testCasesData))
+		for j, testCase := range testCasesData {
+			testCases[j] = testCase.(string)
+		}
+		result[i] = restapi.HTTPEndpointRule{
+			Enabled:      rule[HTTPEndpointConfigFieldRuleEnabled].(bool),
+			PathSegments: pathSegments,
+			TestCases:    testCases,
+		}
+	}
+	return result, nil
+}
+
+func (r *httpEndpointConfigResource) mapPathSegmentsFromState(data []interface{}) ([]restapi.HTTPPathSegmentMatchingRule, error) {
+	result := make([]restapi.HTTPPathSegmentMatchingRule, len(data))
+	for i, v := range data {
+		pathSegment := v.(map[string]interface{})
+		segmentType := restapi.HTTPPathSegmentMatchingType(pathSegment[HTTPEndpointConfigFieldRulePathSegmentType].(string))
+		name := pathSegment[HTTPEndpointConfigFieldRulePathSegmentName].(string)
+		if segmentType == restapi.HTTPPathSegmentMatchingTypeMatchAll {
+			if name != "" {
+				return nil, fmt.Errorf("name is not supported for path segments of type %s", segmentType)
+			}
+			result[i] = restapi.HTTPPathSegmentMatchingRule{Type: segmentType}
+			continue
+		}
+		if name == "" {
+			return nil, fmt.Errorf("name is required for path segments of type %s", segmentType)
+		}
+		result[i] = restapi.HTTPPathSegmentMatchingRule{Type: segmentType, Name: &name}
+	}
+	return result, nil
+}
@@ -0,0 +1,257 @@
+package instana_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestHTTPEndpointConfigResource(t *testing.T) {
+	ut := &httpEndpointConfigUnitTest{}
+	t.Run("CRUD integration test", httpEndpointConfigIntegrationTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should update resource state", ut.shouldUpdateResourceState)
+	t.Run("should map state to data model", ut.shouldMapStateToDataModel)
+	t.Run("should fail to map state to data model when name of fixed path segment is missing", ut.shouldFailToMapStateToDataModelWhenNameOfFixedPathSegmentIsMissing)
+	t.Run("should fail to map state to data model when name of match all path segment is provided", ut.shouldFailToMapStateToDataModelWhenNameOfMatchAllPathSegmentIsProvided)
+}
+
+const (
+	httpEndpointConfigDefinition = "instana_http_endpoint_config.example"
+	httpEndpointConfigServiceID  = "service-id"
+)
+
+const resourceHTTPEndpointConfigDefinitionTemplate = `
+resource "instana_http_endpoint_config" "example" {
+  service_id = "service-id"
+  endpoint_name_by_first_path_segment_rule_enabled = true
+
+  rule {
+    path_segment {
+      type = "FIXED"
+      name = "api"
+    }
+    path_segment {
+      type = "PARAMETER"
+      name = "version"
+    }
+    path_segment {
+      type = "MATCH_ALL"
+    }
+    test_cases = ["/api/v%d/users"]
+  }
+}
+`
+
+const httpEndpointConfigServerResponseTemplate = `
+{
+	"serviceId": "%s",
+	"endpointNameByFirstPathSegmentRuleEnabled": true,
+	"endpointNameByCollectedPathTemplateRuleEnabled": false,
+	"rules": [
+		{
+			"enabled": true,
+			"pathSegments": [
+				{ "type": "FIXED", "name": "api" },
+				{ "type": "PARAMETER", "name": "version" },
+				{ "type": "MATCH_ALL" }
+			],
+			"testCases": [ "/api/v%d/users" ]
+		}
+	]
+}
+`
+
+func httpEndpointConfigIntegrationTest(t *testing.T) {
+	resourceInstancePath := restapi.HTTPEndpointConfigResourcePath + "/" + httpEndpointConfigServiceID
+	httpServer := testutils.NewTestHTTPServer()
+	responseHandler := func(w http.ResponseWriter, r *http.Request) {
+		iteration := httpServer.GetCallCount(http.MethodPut, resourceInstancePath)
+		httpServer.WriteJSONResponse(w, []byte(formatResponseTemplate(httpEndpointConfigServerResponseTemplate, httpEndpointConfigServiceID, iteration)))
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.HTTPEndpointConfigResourcePath, responseHandler)
+	httpServer.AddRoute(http.MethodPut, resourceInstancePath, responseHandler)
+	httpServer.AddRoute(http.MethodGet, resourceInstancePath, responseHandler)
+	httpServer.AddRoute(http.MethodDelete, resourceInstancePath, testutils.EchoHandlerFunc)
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createHTTPEndpointConfigTestStep(httpServer.GetPort(), 0),
+			testStepImportWithCustomID(httpEndpointConfigDefinition, httpEndpointConfigServiceID),
+			createHTTPEndpointConfigTestStep(httpServer.GetPort(), 1),
+			testStepImportWithCustomID(httpEndpointConfigDefinition, httpEndpointConfigServiceID),
+		},
+	})
+}
+
+func createHTTPEndpointConfigTestStep(httpPort int, iteration int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(resourceHTTPEndpointConfigDefinitionTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, "id", httpEndpointConfigServiceID),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, HTTPEndpointConfigFieldServiceID, httpEndpointConfigServiceID),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, "true"),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled, "false"),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, "rule.0.enabled", "true"),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, "rule.0.path_segment.#", "3"),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, "rule.0.path_segment.0.type", "FIXED"),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, "rule.0.path_segment.0.name", "api"),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, "rule.0.path_segment.1.type", "PARAMETER"),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, "rule.0.path_segment.1.name", "version"),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, "rule.0.path_segment.2.type", "MATCH_ALL"),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, "rule.0.path_segment.2.name", ""),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, "rule.0.test_cases.0", fmt.Sprintf("/api/v%d/users", iteration)),
+		),
+	}
+}
+
+type httpEndpointConfigUnitTest struct{}
+
+func (ut *httpEndpointConfigUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	schemaMap := NewHTTPEndpointConfigResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 4)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(HTTPEndpointConfigFieldServiceID)
+	require.True(t, schemaMap[HTTPEndpointConfigFieldServiceID].ForceNew)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, false)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled, false)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(HTTPEndpointConfigFieldRule)
+
+	ruleSchemaMap := schemaMap[HTTPEndpointConfigFieldRule].Elem.(*schema.Resource).Schema
+	ruleSchemaAssert := testutils.NewTerraformSchemaAssert(ruleSchemaMap, t)
+	require.Len(t, ruleSchemaMap, 3)
+	ruleSchemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(HTTPEndpointConfigFieldRuleEnabled, true)
+	ruleSchemaAssert.AssertSchemaIsRequiredAndOfTypeListOfResource(HTTPEndpointConfigFieldRulePathSegment)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeListOfStrings(HTTPEndpointConfigFieldRuleTestCases)
+
+	pathSegmentSchemaAssert := testutils.NewTerraformSchemaAssert(ruleSchemaMap[HTTPEndpointConfigFieldRulePathSegment].Elem.(*schema.Resource).Schema, t)
+	pathSegmentSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(HTTPEndpointConfigFieldRulePathSegmentType)
+	pathSegmentSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(HTTPEndpointConfigFieldRulePathSegmentName)
+}
+
+func (ut *httpEndpointConfigUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_http_endpoint_config", NewHTTPEndpointConfigResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *httpEndpointConfigUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewHTTPEndpointConfigResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *httpEndpointConfigUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewHTTPEndpointConfigResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *httpEndpointConfigUnitTest) createTestHTTPEndpointConfig() *restapi.HTTPEndpointConfig {
+	api := "api"
+	version := "version"
+	return &restapi.HTTPEndpointConfig{
+		ServiceID: httpEndpointConfigServiceID,
+		EndpointNameByFirstPathSegmentRuleEnabled:      true,
+		EndpointNameByCollectedPathTemplateRuleEnabled: false,
+		Rules: []restapi.HTTPEndpointRule{
+			{
+				Enabled: true,
+				PathSegments: []restapi.HTTPPathSegmentMatchingRule{
+					{Type: restapi.HTTPPathSegmentMatchingTypeFixed, Name: &api},
+					{Type: restapi.HTTPPathSegmentMatchingTypeParameter, Name: &version},
+					{Type: restapi.HTTPPathSegmentMatchingTypeMatchAll},
+				},
+				TestCases: []string{"/api/v1/users"},
+			},
+		},
+	}
+}
+
+func (ut *httpEndpointConfigUnitTest) createTestRulesState() []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			HTTPEndpointConfigFieldRuleEnabled: true,
+			HTTPEndpointConfigFieldRulePathSegment: []interface{}{
+				map[string]interface{}{HTTPEndpointConfigFieldRulePathSegmentType: "FIXED", HTTPEndpointConfigFieldRulePathSegmentName: "api"},
+				map[string]interface{}{HTTPEndpointConfigFieldRulePathSegmentType: "PARAMETER", HTTPEndpointConfigFieldRulePathSegmentName: "version"},
+				map[string]interface{}{HTTPEndpointConfigFieldRulePathSegmentType: "MATCH_ALL", HTTPEndpointConfigFieldRulePathSegmentName: ""},
+			},
+			HTTPEndpointConfigFieldRuleTestCases: []interface{}{"/api/v1/users"},
+		},
+	}
+}
+
+func (ut *httpEndpointConfigUnitTest) shouldUpdateResourceState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.HTTPEndpointConfig](t)
+	resourceHandle := NewHTTPEndpointConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	err := resourceHandle.UpdateState(resourceData, ut.createTestHTTPEndpointConfig())
+
+	require.NoError(t, err)
+	require.Equal(t, httpEndpointConfigServiceID, resourceData.Id())
+	require.Equal(t, httpEndpointConfigServiceID, resourceData.Get(HTTPEndpointConfigFieldServiceID))
+	require.True(t, resourceData.Get(HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled).(bool))
+	require.False(t, resourceData.Get(HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled).(bool))
+	require.Equal(t, ut.createTestRulesState(), resourceData.Get(HTTPEndpointConfigFieldRule))
+}
+
+func (ut *httpEndpointConfigUnitTest) shouldMapStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.HTTPEndpointConfig](t)
+	resourceHandle := NewHTTPEndpointConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, HTTPEndpointConfigFieldServiceID, httpEndpointConfigServiceID)
+	setValueOnResourceData(t, resourceData, HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, true)
+	setValueOnResourceData(t, resourceData, HTTPEndpointConfigFieldRule, ut.createTestRulesState())
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, ut.createTestHTTPEndpointConfig(), result)
+}
+
+func (ut *httpEndpointConfigUnitTest) shouldFailToMapStateToDataModelWhenNameOfFixedPathSegmentIsMissing(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.HTTPEndpointConfig](t)
+	resourceHandle := NewHTTPEndpointConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, HTTPEndpointConfigFieldServiceID, httpEndpointConfigServiceID)
+	setValueOnResourceData(t, resourceData, HTTPEndpointConfigFieldRule, []interface{}{
+		map[string]interface{}{
+			HTTPEndpointConfigFieldRulePathSegment: []interface{}{
+				map[string]interface{}{HTTPEndpointConfigFieldRulePathSegmentType: "FIXED"},
+			},
+		},
+	})
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.ErrorContains(t, err, "name is required for path segments of type FIXED")
+}
+
+func (ut *httpEndpointConfigUnitTest) shouldFailToMapStateToDataModelWhenNameOfMatchAllPathSegmentIsProvided(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.HTTPEndpointConfig](t)
+	resourceHandle := NewHTTPEndpointConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, HTTPEndpointConfigFieldServiceID, httpEndpointConfigServiceID)
+	setValueOnResourceData(t, resourceData, HTTPEndpointConfigFieldRule, []interface{}{
+		map[string]interface{}{
+			HTTPEndpointConfigFieldRulePathSegment: []interface{}{
+				map[string]interface{}{HTTPEndpointConfigFieldRulePathSegmentType: "MATCH_ALL", HTTPEndpointConfigFieldRulePathSegmentName: "api"},
+			},
+		},
+	})
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.ErrorContains(t, err, "name is not supported for path segments of type MATCH_ALL")
+}
@@ -0,0 +1,273 @@
+package instana
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaMaintenanceWindow the name of the terraform-provider-instana resource to manage maintenance windows
+const ResourceInstanaMaintenanceWindow = "instana_maintenance_window"
+
+const (
+	//MaintenanceWindowFieldName constant value for the schema field name
+	MaintenanceWindowFieldName = "name"
+	//MaintenanceWindowFieldQuery constant value for the schema field query
+	MaintenanceWindowFieldQuery = "query"
+	//MaintenanceWindowFieldOneTime constant value for the schema field one_time
+	MaintenanceWindowFieldOneTime = "one_time"
+	//MaintenanceWindowFieldRecurrent constant value for the schema field recurrent
+	MaintenanceWindowFieldRecurrent = "recurrent"
+	//MaintenanceWindowFieldPaused constant value for the schema field paused
+	MaintenanceWindowFieldPaused = "paused"
+	//MaintenanceWindowFieldState constant value for the computed schema field state
+	MaintenanceWindowFieldState = "state"
+
+	//MaintenanceWindowFieldSchedulingStart constant value for the schema field one_time.start and recurrent.start
+	MaintenanceWindowFieldSchedulingStart = "start"
+	//MaintenanceWindowFieldSchedulingDuration constant value for the schema field one_time.duration and recurrent.duration
+	MaintenanceWindowFieldSchedulingDuration = "duration"
+	//MaintenanceWindowFieldSchedulingDurationUnit constant value for the schema field one_time.duration_unit and recurrent.duration_unit
+	MaintenanceWindowFieldSchedulingDurationUnit = "duration_unit"
+	//MaintenanceWindowFieldSchedulingRRule constant value for the schema field recurrent.rrule
+	MaintenanceWindowFieldSchedulingRRule = "rrule"
+	//MaintenanceWindowFieldSchedulingTimezoneID constant value for the schema field recurrent.timezone_id
+	MaintenanceWindowFieldSchedulingTimezoneID = "timezone_id"
+)
+
+var maintenanceWindowSchedulingOptions = []string{
+	MaintenanceWindowFieldOneTime,
+	MaintenanceWindowFieldRecurrent,
+}
+
+var (
+	maintenanceWindowSchemaSchedulingStart = &schema.Schema{
+		Type:         schema.TypeInt,
+		Required:     true,
+		Description:  "The start of the maintenance window as unix timestamp in milliseconds",
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+	maintenanceWindowSchemaSchedulingDuration = &schema.Schema{
+		Type:         schema.TypeInt,
+		Required:     true,
+		Description:  "The duration of the maintenance window in the given duration unit",
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+	maintenanceWindowSchemaSchedulingDurationUnit = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The unit of the duration of the maintenance window",
+		ValidateFunc: validation.StringInSlice(restapi.SupportedMaintenanceWindowDurationUnits.ToStringSlice(), false),
+	}
+)
+
+// NewMaintenanceWindowResourceHandle creates the resource handle for maintenance windows
+func NewMaintenanceWindowResourceHandle() ResourceHandle[*restapi.MaintenanceWindowConfig] {
+	return &maintenanceWindowResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaMaintenanceWindow,
+			Schema: map[string]*schema.Schema{
+				MaintenanceWindowFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The name of the maintenance window",
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+				MaintenanceWindowFieldQuery: RequiredTagFilterExpressionSchema,
+				MaintenanceWindowFieldOneTime: {
+					Type:         schema.TypeList,
+					MinItems:     0,
+					MaxItems:     1,
+					Optional:     true,
+					Description:  "The scheduling of a maintenance window which takes place only once",
+					ExactlyOneOf: maintenanceWindowSchedulingOptions,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							MaintenanceWindowFieldSchedulingStart:        maintenanceWindowSchemaSchedulingStart,
+							MaintenanceWindowFieldSchedulingDuration:     maintenanceWindowSchemaSchedulingDuration,
+							MaintenanceWindowFieldSchedulingDurationUnit: maintenanceWindowSchemaSchedulingDurationUnit,
+						},
+					},
+				},
+				MaintenanceWindowFieldRecurrent: {
+					Type:         schema.TypeList,
+					MinItems:     0,
+					MaxItems:     1,
+					Optional:     true,
+					Description:  "The scheduling of a maintenance window which is repeated according to a recurrence rule",
+					ExactlyOneOf: maintenanceWindowSchedulingOptions,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							MaintenanceWindowFieldSchedulingStart:        maintenanceWindowSchemaSchedulingStart,
+							MaintenanceWindowFieldSchedulingDuration:     maintenanceWindowSchemaSchedulingDuration,
+							MaintenanceWindowFieldSchedulingDurationUnit: maintenanceWindowSchemaSchedulingDurationUnit,
+							MaintenanceWindowFieldSchedulingRRule: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The recurrence rule of the maintenance window according to RFC 5545 (e.g. FREQ=WEEKLY;INTERVAL=2;BYDAY=SA)",
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+							},
+							MaintenanceWindowFieldSchedulingTimezoneID: {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Computed:    true,
+								Description: "The time zone used to evaluate the recurrence rule (e.g. America/New_York)",
+							},
+						},
+					},
+				},
+				MaintenanceWindowFieldPaused: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Flag to pause the maintenance window. Alerts are sent for the scope of a paused maintenance window. Only recurrent maintenance windows can be paused",
+				},
+				MaintenanceWindowFieldState: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The current state of the maintenance window",
+				},
+			},
+			SchemaVersion: 0,
+			CustomizeDiff: validateMaintenanceWindowPausedFlag,
+		},
+	}
+}
+
+// validateMaintenanceWindowPausedFlag rejects paused one time maintenance windows at plan time as only recurrent
+// maintenance windows can be paused
+func validateMaintenanceWindowPausedFlag(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get(MaintenanceWindowFieldPaused).(bool) && len(d.Get(MaintenanceWindowFieldOneTime).([]interface{})) > 0 {
+		return errors.New("only recurrent maintenance windows can be paused")
+	}
+	return nil
+}
+
+type maintenanceWindowResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *maintenanceWindowResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *maintenanceWindowResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *maintenanceWindowResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.MaintenanceWindowConfig] {
+	return api.MaintenanceWindowConfigs()
+}
+
+func (r *maintenanceWindowResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *maintenanceWindowResource) UpdateState(d *schema.ResourceData, config *restapi.MaintenanceWindowConfig) error {
+	query := config.Query
+	if config.TagFilterExpression != nil {
+		normalizedTagFilterString, err := tagfilter.MapTagFilterToNormalizedString(config.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+		if normalizedTagFilterString != nil {
+			query = *normalizedTagFilterString
+		}
+	}
+	data := map[string]interface{}{
+		MaintenanceWindowFieldName:      config.Name,
+		MaintenanceWindowFieldQuery:     query,
+		MaintenanceWindowFieldPaused:    config.Paused,
+		MaintenanceWindowFieldState:     config.State,
+		MaintenanceWindowFieldOneTime:   r.mapOneTimeSchedulingToState(&config.Scheduling),
+		MaintenanceWindowFieldRecurrent: r.mapRecurrentSchedulingToState(&config.Scheduling),
+	}
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *maintenanceWindowResource) mapOneTimeSchedulingToState(scheduling *restapi.MaintenanceWindowScheduling) []interface{} {
+	if scheduling.Type == restapi.MaintenanceWindowSchedulingTypeOneTime {
+		return []interface{}{r.mapCommonSchedulingToState(scheduling)}
+	}
+	return []interface{}{}
+}
+
+func (r *maintenanceWindowResource) mapRecurrentSchedulingToState(scheduling *restapi.MaintenanceWindowScheduling) []interface{} {
+	if scheduling.Type == restapi.MaintenanceWindowSchedulingTypeRecurrent {
+		result := r.mapCommonSchedulingToState(scheduling)
+		result[MaintenanceWindowFieldSchedulingRRule] = scheduling.RRule
+		result[MaintenanceWindowFieldSchedulingTimezoneID] = scheduling.TimezoneID
+		return []interface{}{result}
+	}
+	return []interface{}{}
+}
+
+func (r *maintenanceWindowResource) mapCommonSchedulingToState(scheduling *restapi.MaintenanceWindowScheduling) map[string]interface{} {
+	return map[string]interface{}{
+		MaintenanceWindowFieldSchedulingStart:        scheduling.Start,
+		MaintenanceWindowFieldSchedulingDuration:     scheduling.Duration.Amount,
+		MaintenanceWindowFieldSchedulingDurationUnit: string(scheduling.Duration.Unit),
+	}
+}
+
+func (r *maintenanceWindowResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.MaintenanceWindowConfig, error) {
+	tagFilter, err := r.mapTagFilterStringToAPIModel(d.Get(MaintenanceWindowFieldQuery).(string))
+	if err != nil {
+		return nil, err
+	}
+	scheduling, err := r.mapSchedulingFromState(d)
+	if err != nil {
+		return nil, err
+	}
+	return &restapi.MaintenanceWindowConfig{
+		ID:                         d.Id(),
+		Name:                       d.Get(MaintenanceWindowFieldName).(string),
+		Query:                      "",
+		TagFilterExpressionEnabled: true,
+		TagFilterExpression:        tagFilter,
+		Scheduling:                 scheduling,
+		Paused:                     d.Get(MaintenanceWindowFieldPaused).(bool),
+	}, nil
+}
+
+func (r *maintenanceWindowResource) mapSchedulingFromState(d *schema.ResourceData) (restapi.MaintenanceWindowScheduling, error) {
+	if val, ok := d.GetOk(MaintenanceWindowFieldOneTime); ok && len(val.([]interface{})) == 1 {
+		return r.mapCommonSchedulingFromState(restapi.MaintenanceWindowSchedulingTypeOneTime, val.([]interface{})[0].(map[string]interface{})), nil
+	}
+	if val, ok := d.GetOk(MaintenanceWindowFieldRecurrent); ok && len(val.([]interface{})) == 1 {
+		data := val.([]interface{})[0].(map[string]interface{})
+		scheduling := r.mapCommonSchedulingFromState(restapi.MaintenanceWindowSchedulingTypeRecurrent, data)
+		scheduling.RRule = GetPointerFromMap[string](data, MaintenanceWindowFieldSchedulingRRule)
+		scheduling.TimezoneID = GetPointerFromMap[string](data, MaintenanceWindowFieldSchedulingTimezoneID)
+		return scheduling, nil
+	}
+	return restapi.MaintenanceWindowScheduling{}, errors.New("no supported scheduling of the maintenance window provided")
+}
+
+func (r *maintenanceWindowResource) mapCommonSchedulingFromState(schedulingType restapi.MaintenanceWindowSchedulingType, data map[string]interface{}) restapi.MaintenanceWindowScheduling {
+	return restapi.MaintenanceWindowScheduling{
+		Type:  schedulingType,
+		Start: int64(data[MaintenanceWindowFieldSchedulingStart].(int)),
+		Duration: restapi.MaintenanceWindowDuration{
+			Amount: int64(data[MaintenanceWindowFieldSchedulingDuration].(int)),
+			Unit:   restapi.MaintenanceWindowDurationUnit(data[MaintenanceWindowFieldSchedulingDurationUnit].(string)),
+		},
+	}
+}
+
+func (r *maintenanceWindowResource) mapTagFilterStringToAPIModel(input string) (*restapi.TagFilter, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return mapper.ToAPIModel(expr), nil
+}
@@ -0,0 +1,435 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestMaintenanceWindowResource(t *testing.T) {
+	ut := &maintenanceWindowUnitTest{}
+	t.Run("CRUD integration test with recurrent scheduling and pause state changes", maintenanceWindowIntegrationTest)
+	t.Run("should reject paused one time maintenance window", maintenanceWindowPausedOneTimeSchedulingTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should update resource state for one time scheduling", ut.shouldUpdateResourceStateForOneTimeScheduling)
+	t.Run("should update resource state for recurrent scheduling", ut.shouldUpdateResourceStateForRecurrentScheduling)
+	t.Run("should update query of resource state from raw query when tag filter expression is not provided", ut.shouldUpdateQueryOfResourceStateFromRawQueryWhenTagFilterExpressionIsNotProvided)
+	t.Run("should fail to update resource state when tag filter is not valid", ut.shouldFailToUpdateResourceStateWhenTagFilterIsNotValid)
+	t.Run("should map state to data model with one time scheduling", ut.shouldMapStateToDataModelWithOneTimeScheduling)
+	t.Run("should map state to data model with recurrent scheduling", ut.shouldMapStateToDataModelWithRecurrentScheduling)
+	t.Run("should fail to map state to data model when query is not valid", ut.shouldFailToMapStateToDataModelWhenQueryIsNotValid)
+	t.Run("should fail to map state to data model when no scheduling is provided", ut.shouldFailToMapStateToDataModelWhenNoSchedulingIsProvided)
+}
+
+const (
+	maintenanceWindowDefinition      = "instana_maintenance_window.example"
+	maintenanceWindowID              = "maintenance-window-id"
+	maintenanceWindowName            = "name"
+	maintenanceWindowQuery           = "entity.type@na EQUALS 'host' AND agent.tag:stage@na EQUALS 'test'"
+	maintenanceWindowNormalizedQuery = "(entity.type@na EQUALS 'host' AND agent.tag:'stage'@na EQUALS 'test')"
+	maintenanceWindowStart           = int64(1683864000000)
+	maintenanceWindowRRule           = "FREQ=WEEKLY;INTERVAL=2;BYDAY=SA;COUNT=10"
+	maintenanceWindowTimezoneID      = "America/New_York"
+	maintenanceWindowSchedulingField = "%s.0.%s"
+)
+
+const resourceMaintenanceWindowDefinitionTemplate = `
+resource "instana_maintenance_window" "example" {
+  name   = "name %d"
+  query  = "entity.type@na EQUALS 'host' AND agent.tag:stage@na EQUALS 'test'"
+  paused = %t
+
+  recurrent {
+    start         = 1683864000000
+    duration      = 2
+    duration_unit = "HOURS"
+    rrule         = "FREQ=WEEKLY;INTERVAL=2;BYDAY=SA;COUNT=10"
+    timezone_id   = "America/New_York"
+  }
+}
+`
+
+const resourcePausedOneTimeMaintenanceWindowDefinition = `
+resource "instana_maintenance_window" "example" {
+  name   = "name"
+  query  = "entity.type@na EQUALS 'host'"
+  paused = true
+
+  one_time {
+    start         = 1683864000000
+    duration      = 2
+    duration_unit = "HOURS"
+  }
+}
+`
+
+var maintenanceWindowTagFilterModel = restapi.NewLogicalAndTagFilter([]*restapi.TagFilter{
+	restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "entity.type", restapi.EqualsOperator, "host"),
+	restapi.NewTagTagFilter(restapi.TagFilterEntityNotApplicable, "agent.tag", restapi.EqualsOperator, "stage", "test"),
+})
+
+func maintenanceWindowIntegrationTest(t *testing.T) {
+	httpServer := createMockHttpServerForMaintenanceWindow()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createMaintenanceWindowTestStep(httpServer.GetPort(), 0, false),
+			testStepImport(maintenanceWindowDefinition),
+			createMaintenanceWindowTestStep(httpServer.GetPort(), 1, true),
+			testStepImport(maintenanceWindowDefinition),
+			createMaintenanceWindowTestStep(httpServer.GetPort(), 2, false),
+		},
+	})
+}
+
+func maintenanceWindowPausedOneTimeSchedulingTest(t *testing.T) {
+	httpServer := createMockHttpServerForMaintenanceWindow()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      appendProviderConfig(resourcePausedOneTimeMaintenanceWindowDefinition, httpServer.GetPort()),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("only recurrent maintenance windows can be paused"),
+			},
+		},
+	})
+}
+
+func createMaintenanceWindowTestStep(httpPort int, iteration int, paused bool) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(resourceMaintenanceWindowDefinitionTemplate, iteration, paused), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(maintenanceWindowDefinition, "id"),
+			resource.TestCheckResourceAttr(maintenanceWindowDefinition, MaintenanceWindowFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(maintenanceWindowDefinition, MaintenanceWindowFieldQuery, maintenanceWindowNormalizedQuery),
+			resource.TestCheckResourceAttr(maintenanceWindowDefinition, MaintenanceWindowFieldPaused, fmt.Sprintf("%t", paused)),
+			resource.TestCheckResourceAttrSet(maintenanceWindowDefinition, MaintenanceWindowFieldState),
+			resource.TestCheckResourceAttr(maintenanceWindowDefinition, fmt.Sprintf(maintenanceWindowSchedulingField, MaintenanceWindowFieldRecurrent, MaintenanceWindowFieldSchedulingStart), fmt.Sprintf("%d", maintenanceWindowStart)),
+			resource.TestCheckResourceAttr(maintenanceWindowDefinition, fmt.Sprintf(maintenanceWindowSchedulingField, MaintenanceWindowFieldRecurrent, MaintenanceWindowFieldSchedulingDuration), "2"),
+			resource.TestCheckResourceAttr(maintenanceWindowDefinition, fmt.Sprintf(maintenanceWindowSchedulingField, MaintenanceWindowFieldRecurrent, MaintenanceWindowFieldSchedulingDurationUnit), "HOURS"),
+			resource.TestCheckResourceAttr(maintenanceWindowDefinition, fmt.Sprintf(maintenanceWindowSchedulingField, MaintenanceWindowFieldRecurrent, MaintenanceWindowFieldSchedulingRRule), maintenanceWindowRRule),
+			resource.TestCheckResourceAttr(maintenanceWindowDefinition, fmt.Sprintf(maintenanceWindowSchedulingField, MaintenanceWindowFieldRecurrent, MaintenanceWindowFieldSchedulingTimezoneID), maintenanceWindowTimezoneID),
+			resource.TestCheckResourceAttr(maintenanceWindowDefinition, fmt.Sprintf("%s.#", MaintenanceWindowFieldOneTime), "0"),
+		),
+	}
+}
+
+// createMockHttpServerForMaintenanceWindow creates a test server which stores the maintenance windows in memory. Like
+// the Instana API, the paused flag of the PUT request is ignored and only changed by the pause and resume endpoints
+// which do not return the maintenance window.
+func createMockHttpServerForMaintenanceWindow() testutils.TestHTTPServer {
+	resourcePath := restapi.MaintenanceWindowConfigResourcePath
+	pathTemplate := resourcePath + "/{id}"
+	httpServer := testutils.NewTestHTTPServer()
+	var mutex sync.Mutex
+	configs := make(map[string]*restapi.MaintenanceWindowConfig)
+
+	writeConfig := func(w http.ResponseWriter, config *restapi.MaintenanceWindowConfig) {
+		config.State = "ACTIVE"
+		if config.Paused {
+			config.State = "PAUSED"
+		}
+		data, err := json.Marshal(config)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		httpServer.WriteJSONResponse(w, data)
+	}
+	pauseStateHandler := func(paused bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			config, ok := configs[mux.Vars(r)["id"]]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			config.Paused = paused
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+
+	httpServer.AddRoute(http.MethodPut, pathTemplate, func(w http.ResponseWriter, r *http.Request) {
+		config := &restapi.MaintenanceWindowConfig{}
+		if err := json.NewDecoder(r.Body).Decode(config); err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		config.Paused = false
+		if existing, ok := configs[config.ID]; ok {
+			config.Paused = existing.Paused
+		}
+		configs[config.ID] = config
+		writeConfig(w, config)
+	})
+	httpServer.AddRoute(http.MethodGet, pathTemplate, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		config, ok := configs[mux.Vars(r)["id"]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeConfig(w, config)
+	})
+	httpServer.AddRoute(http.MethodDelete, pathTemplate, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		delete(configs, mux.Vars(r)["id"])
+		w.WriteHeader(http.StatusOK)
+	})
+	httpServer.AddRoute(http.MethodPut, pathTemplate+"/pause", pauseStateHandler(true))
+	httpServer.AddRoute(http.MethodPut, pathTemplate+"/resume", pauseStateHandler(false))
+	return httpServer
+}
+
+type maintenanceWindowUnitTest struct{}
+
+func (ut *maintenanceWindowUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	metaData := NewMaintenanceWindowResourceHandle().MetaData()
+	schemaMap := metaData.Schema
+
+	require.NotNil(t, metaData.CustomizeDiff)
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 6)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MaintenanceWindowFieldName)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MaintenanceWindowFieldQuery)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(MaintenanceWindowFieldOneTime)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(MaintenanceWindowFieldRecurrent)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(MaintenanceWindowFieldPaused, false)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(MaintenanceWindowFieldState)
+
+	oneTimeSchema := schemaMap[MaintenanceWindowFieldOneTime].Elem.(*schema.Resource).Schema
+	require.Len(t, oneTimeSchema, 3)
+	ut.verifyCommonSchedulingSchema(testutils.NewTerraformSchemaAssert(oneTimeSchema, t))
+
+	recurrentSchema := schemaMap[MaintenanceWindowFieldRecurrent].Elem.(*schema.Resource).Schema
+	require.Len(t, recurrentSchema, 5)
+	recurrentSchemaAssert := testutils.NewTerraformSchemaAssert(recurrentSchema, t)
+	ut.verifyCommonSchedulingSchema(recurrentSchemaAssert)
+	recurrentSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(MaintenanceWindowFieldSchedulingRRule)
+	recurrentSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(MaintenanceWindowFieldSchedulingTimezoneID)
+}
+
+func (ut *maintenanceWindowUnitTest) verifyCommonSchedulingSchema(schemaAssert testutils.TerraformSchemaAssert) {
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeInt(MaintenanceWindowFieldSchedulingStart)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeInt(MaintenanceWindowFieldSchedulingDuration)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MaintenanceWindowFieldSchedulingDurationUnit)
+}
+
+func (ut *maintenanceWindowUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_maintenance_window", NewMaintenanceWindowResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *maintenanceWindowUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewMaintenanceWindowResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *maintenanceWindowUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewMaintenanceWindowResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *maintenanceWindowUnitTest) shouldUpdateResourceStateForOneTimeScheduling(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.MaintenanceWindowConfig](t)
+	resourceHandle := NewMaintenanceWindowResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	data := ut.createTestMaintenanceWindowConfig(restapi.MaintenanceWindowScheduling{
+		Type:     restapi.MaintenanceWindowSchedulingTypeOneTime,
+		Start:    maintenanceWindowStart,
+		Duration: restapi.MaintenanceWindowDuration{Amount: 30, Unit: restapi.MaintenanceWindowDurationUnitMinutes},
+	})
+
+	err := resourceHandle.UpdateState(resourceData, data)
+
+	require.NoError(t, err)
+	require.Equal(t, maintenanceWindowID, resourceData.Id())
+	require.Equal(t, maintenanceWindowName, resourceData.Get(MaintenanceWindowFieldName))
+	require.Equal(t, maintenanceWindowNormalizedQuery, resourceData.Get(MaintenanceWindowFieldQuery))
+	require.Equal(t, true, resourceData.Get(MaintenanceWindowFieldPaused))
+	require.Equal(t, "PAUSED", resourceData.Get(MaintenanceWindowFieldState))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			MaintenanceWindowFieldSchedulingStart:        int(maintenanceWindowStart),
+			MaintenanceWindowFieldSchedulingDuration:     30,
+			MaintenanceWindowFieldSchedulingDurationUnit: "MINUTES",
+		},
+	}, resourceData.Get(MaintenanceWindowFieldOneTime))
+	require.Empty(t, resourceData.Get(MaintenanceWindowFieldRecurrent))
+}
+
+func (ut *maintenanceWindowUnitTest) shouldUpdateResourceStateForRecurrentScheduling(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.MaintenanceWindowConfig](t)
+	resourceHandle := NewMaintenanceWindowResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	rrule := maintenanceWindowRRule
+	timezoneID := maintenanceWindowTimezoneID
+	data := ut.createTestMaintenanceWindowConfig(restapi.MaintenanceWindowScheduling{
+		Type:       restapi.MaintenanceWindowSchedulingTypeRecurrent,
+		Start:      maintenanceWindowStart,
+		Duration:   restapi.MaintenanceWindowDuration{Amount: 2, Unit: restapi.MaintenanceWindowDurationUnitDays},
+		RRule:      &rrule,
+		TimezoneID: &timezoneID,
+	})
+
+	err := resourceHandle.UpdateState(resourceData, data)
+
+	require.NoError(t, err)
+	require.Empty(t, resourceData.Get(MaintenanceWindowFieldOneTime))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			MaintenanceWindowFieldSchedulingStart:        int(maintenanceWindowStart),
+			MaintenanceWindowFieldSchedulingDuration:     2,
+			MaintenanceWindowFieldSchedulingDurationUnit: "DAYS",
+			MaintenanceWindowFieldSchedulingRRule:        maintenanceWindowRRule,
+			MaintenanceWindowFieldSchedulingTimezoneID:   maintenanceWindowTimezoneID,
+		},
+	}, resourceData.Get(MaintenanceWindowFieldRecurrent))
+}
+
+func (ut *maintenanceWindowUnitTest) shouldUpdateQueryOfResourceStateFromRawQueryWhenTagFilterExpressionIsNotProvided(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.MaintenanceWindowConfig](t)
+	resourceHandle := NewMaintenanceWindowResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, MaintenanceWindowFieldQuery, "entity.type EQUALS 'service'")
+	data := ut.createTestMaintenanceWindowConfig(restapi.MaintenanceWindowScheduling{Type: restapi.MaintenanceWindowSchedulingTypeOneTime})
+	data.TagFilterExpressionEnabled = false
+	data.TagFilterExpression = nil
+	data.Query = maintenanceWindowQuery
+
+	err := resourceHandle.UpdateState(resourceData, data)
+
+	require.NoError(t, err)
+	require.Equal(t, maintenanceWindowQuery, resourceData.Get(MaintenanceWindowFieldQuery))
+}
+
+func (ut *maintenanceWindowUnitTest) shouldFailToUpdateResourceStateWhenTagFilterIsNotValid(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.MaintenanceWindowConfig](t)
+	resourceHandle := NewMaintenanceWindowResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	data := ut.createTestMaintenanceWindowConfig(restapi.MaintenanceWindowScheduling{Type: restapi.MaintenanceWindowSchedulingTypeOneTime})
+	data.TagFilterExpression = restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "entity.type", "INVALID", "host")
+
+	err := resourceHandle.UpdateState(resourceData, data)
+
+	require.Error(t, err)
+}
+
+func (ut *maintenanceWindowUnitTest) createTestMaintenanceWindowConfig(scheduling restapi.MaintenanceWindowScheduling) *restapi.MaintenanceWindowConfig {
+	return &restapi.MaintenanceWindowConfig{
+		ID:                         maintenanceWindowID,
+		Name:                       maintenanceWindowName,
+		TagFilterExpressionEnabled: true,
+		TagFilterExpression:        maintenanceWindowTagFilterModel,
+		Scheduling:                 scheduling,
+		Paused:                     true,
+		State:                      "PAUSED",
+	}
+}
+
+func (ut *maintenanceWindowUnitTest) shouldMapStateToDataModelWithOneTimeScheduling(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.MaintenanceWindowConfig](t)
+	resourceHandle := NewMaintenanceWindowResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(maintenanceWindowID)
+	setValueOnResourceData(t, resourceData, MaintenanceWindowFieldName, maintenanceWindowName)
+	setValueOnResourceData(t, resourceData, MaintenanceWindowFieldQuery, maintenanceWindowQuery)
+	setValueOnResourceData(t, resourceData, MaintenanceWindowFieldPaused, true)
+	setValueOnResourceData(t, resourceData, MaintenanceWindowFieldOneTime, []interface{}{
+		map[string]interface{}{
+			MaintenanceWindowFieldSchedulingStart:        int(maintenanceWindowStart),
+			MaintenanceWindowFieldSchedulingDuration:     2,
+			MaintenanceWindowFieldSchedulingDurationUnit: "HOURS",
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.MaintenanceWindowConfig{
+		ID:                         maintenanceWindowID,
+		Name:                       maintenanceWindowName,
+		TagFilterExpressionEnabled: true,
+		TagFilterExpression:        maintenanceWindowTagFilterModel,
+		Scheduling: restapi.MaintenanceWindowScheduling{
+			Type:     restapi.MaintenanceWindowSchedulingTypeOneTime,
+			Start:    maintenanceWindowStart,
+			Duration: restapi.MaintenanceWindowDuration{Amount: 2, Unit: restapi.MaintenanceWindowDurationUnitHours},
+		},
+		Paused: true,
+	}, result)
+}
+
+func (ut *maintenanceWindowUnitTest) shouldMapStateToDataModelWithRecurrentScheduling(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.MaintenanceWindowConfig](t)
+	resourceHandle := NewMaintenanceWindowResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(maintenanceWindowID)
+	setValueOnResourceData(t, resourceData, MaintenanceWindowFieldName, maintenanceWindowName)
+	setValueOnResourceData(t, resourceData, MaintenanceWindowFieldQuery, maintenanceWindowQuery)
+	setValueOnResourceData(t, resourceData, MaintenanceWindowFieldRecurrent, []interface{}{
+		map[string]interface{}{
+			MaintenanceWindowFieldSchedulingStart:        int(maintenanceWindowStart),
+			MaintenanceWindowFieldSchedulingDuration:     2,
+			MaintenanceWindowFieldSchedulingDurationUnit: "HOURS",
+			MaintenanceWindowFieldSchedulingRRule:        maintenanceWindowRRule,
+			MaintenanceWindowFieldSchedulingTimezoneID:   maintenanceWindowTimezoneID,
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.False(t, result.Paused)
+	require.Equal(t, restapi.MaintenanceWindowSchedulingTypeRecurrent, result.Scheduling.Type)
+	require.Equal(t, maintenanceWindowStart, result.Scheduling.Start)
+	require.Equal(t, restapi.MaintenanceWindowDuration{Amount: 2, Unit: restapi.MaintenanceWindowDurationUnitHours}, result.Scheduling.Duration)
+	require.Equal(t, maintenanceWindowRRule, *result.Scheduling.RRule)
+	require.Equal(t, maintenanceWindowTimezoneID, *result.Scheduling.TimezoneID)
+}
+
+func (ut *maintenanceWindowUnitTest) shouldFailToMapStateToDataModelWhenQueryIsNotValid(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.MaintenanceWindowConfig](t)
+	resourceHandle := NewMaintenanceWindowResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, MaintenanceWindowFieldQuery, "INVALID")
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Error(t, err)
+}
+
+func (ut *maintenanceWindowUnitTest) shouldFailToMapStateToDataModelWhenNoSchedulingIsProvided(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.MaintenanceWindowConfig](t)
+	resourceHandle := NewMaintenanceWindowResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, MaintenanceWindowFieldQuery, maintenanceWindowQuery)
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.ErrorContains(t, err, "no supported scheduling")
+}
@@ -0,0 +1,153 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaManualService the name of the terraform-provider-instana resource to manage manual service configurations
+const ResourceInstanaManualService = "instana_manual_service"
+
+const (
+	//ManualServiceFieldTagFilter constant value for the schema field tag_filter
+	ManualServiceFieldTagFilter = "tag_filter"
+	//ManualServiceFieldDescription constant value for the schema field description
+	ManualServiceFieldDescription = "description"
+	//ManualServiceFieldEnabled constant value for the schema field enabled
+	ManualServiceFieldEnabled = "enabled"
+	//ManualServiceFieldExistingServiceID constant value for the schema field existing_service_id
+	ManualServiceFieldExistingServiceID = "existing_service_id"
+	//ManualServiceFieldUnmonitoredServiceName constant value for the schema field unmonitored_service_name
+	ManualServiceFieldUnmonitoredServiceName = "unmonitored_service_name"
+)
+
+var manualServiceTargetFields = []string{ManualServiceFieldExistingServiceID, ManualServiceFieldUnmonitoredServiceName}
+
+// NewManualServiceResourceHandle creates the resource handle for manual service configurations
+func NewManualServiceResourceHandle() ResourceHandle[*restapi.ManualServiceConfig] {
+	return &manualServiceResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaManualService,
+			Schema: map[string]*schema.Schema{
+				ManualServiceFieldTagFilter: {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "The tag filter expression of the calls which are mapped to the service",
+					DiffSuppressFunc: tagFilterDiffSuppressFunc,
+					StateFunc:        tagFilterStateFunc,
+					ValidateFunc:     tagFilterValidateFunc,
+				},
+				ManualServiceFieldDescription: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The description of the manual service configuration",
+				},
+				ManualServiceFieldEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Flag to indicate whether the manual service configuration is enabled",
+				},
+				ManualServiceFieldExistingServiceID: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The ID of the existing service the calls are mapped to",
+					ExactlyOneOf: manualServiceTargetFields,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				ManualServiceFieldUnmonitoredServiceName: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The name of the service which is created for the calls to an unmonitored component",
+					ExactlyOneOf: manualServiceTargetFields,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type manualServiceResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *manualServiceResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *manualServiceResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *manualServiceResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.ManualServiceConfig] {
+	return api.ManualServiceConfigs()
+}
+
+func (r *manualServiceResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *manualServiceResource) UpdateState(d *schema.ResourceData, config *restapi.ManualServiceConfig) error {
+	data := map[string]interface{}{
+		ManualServiceFieldDescription:            config.Description,
+		ManualServiceFieldEnabled:                config.Enabled,
+		ManualServiceFieldExistingServiceID:      r.stringValue(config.ExistingServiceID),
+		ManualServiceFieldUnmonitoredServiceName: r.stringValue(config.UnmonitoredServiceName),
+	}
+	if config.TagFilterExpression != nil {
+		normalizedTagFilterString, err := tagfilter.MapTagFilterToNormalizedString(config.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+		data[ManualServiceFieldTagFilter] = normalizedTagFilterString
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *manualServiceResource) stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func (r *manualServiceResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.ManualServiceConfig, error) {
+	tagFilter, err := r.mapTagFilterStringToAPIModel(d.Get(ManualServiceFieldTagFilter).(string))
+	if err != nil {
+		return nil, err
+	}
+	return &restapi.ManualServiceConfig{
+		ID:                     d.Id(),
+		Description:            d.Get(ManualServiceFieldDescription).(string),
+		Enabled:                d.Get(ManualServiceFieldEnabled).(bool),
+		ExistingServiceID:      r.optionalStringFromState(d, ManualServiceFieldExistingServiceID),
+		UnmonitoredServiceName: r.optionalStringFromState(d, ManualServiceFieldUnmonitoredServiceName),
+		TagFilterExpression:    tagFilter,
+	}, nil
+}
+
+func (r *manualServiceResource) optionalStringFromState(d *schema.ResourceData, key string) *string {
+	if value, ok := d.GetOk(key); ok {
+		result := value.(string)
+		return &result
+	}
+	return nil
+}
+
+func (r *manualServiceResource) mapTagFilterStringToAPIModel(input string) (*restapi.TagFilter, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return mapper.ToAPIModel(expr), nil
+}
@@ -0,0 +1,221 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestManualServiceResource(t *testing.T) {
+	ut := &manualServiceUnitTest{}
+	t.Run("CRUD integration test", manualServiceIntegrationTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should update resource state", ut.shouldUpdateResourceState)
+	t.Run("should map state to data model for existing service", ut.shouldMapStateToDataModelForExistingService)
+	t.Run("should map state to data model for unmonitored service", ut.shouldMapStateToDataModelForUnmonitoredService)
+	t.Run("should fail to map state to data model when tag filter is not valid", ut.shouldFailToMapStateToDataModelWhenTagFilterIsNotValid)
+}
+
+const (
+	manualServiceDefinition = "instana_manual_service.example"
+	manualServiceID         = "manual-service-id"
+	manualServiceTagFilter  = "call.database.connection@dest EQUALS 'redis:6379'"
+)
+
+const resourceManualServiceDefinitionTemplate = `
+resource "instana_manual_service" "example" {
+  tag_filter               = "call.database.connection EQUALS 'redis:6379'"
+  description              = "description %d"
+  unmonitored_service_name = "redis"
+}
+`
+
+func manualServiceIntegrationTest(t *testing.T) {
+	var mutex sync.Mutex
+	configs := make(map[string]*restapi.ManualServiceConfig)
+	resourceInstancePath := restapi.ManualServiceConfigResourcePath + "/{id}"
+	httpServer := testutils.NewTestHTTPServer()
+	writeJSON := func(w http.ResponseWriter, data interface{}) {
+		response, err := json.Marshal(data)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		httpServer.WriteJSONResponse(w, response)
+	}
+	store := func(w http.ResponseWriter, r *http.Request, id string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		config := &restapi.ManualServiceConfig{}
+		if err := json.NewDecoder(r.Body).Decode(config); err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		config.ID = id
+		configs[id] = config
+		writeJSON(w, config)
+	}
+	httpServer.AddRoute(http.MethodGet, restapi.ManualServiceConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		result := make([]*restapi.ManualServiceConfig, 0, len(configs))
+		for _, config := range configs {
+			result = append(result, config)
+		}
+		writeJSON(w, result)
+	})
+	httpServer.AddRoute(http.MethodPost, restapi.ManualServiceConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		store(w, r, manualServiceID)
+	})
+	httpServer.AddRoute(http.MethodPut, resourceInstancePath, func(w http.ResponseWriter, r *http.Request) {
+		store(w, r, mux.Vars(r)["id"])
+	})
+	httpServer.AddRoute(http.MethodDelete, resourceInstancePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		delete(configs, mux.Vars(r)["id"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createManualServiceTestStep(httpServer.GetPort(), 0),
+			testStepImportWithCustomID(manualServiceDefinition, manualServiceID),
+			createManualServiceTestStep(httpServer.GetPort(), 1),
+			testStepImportWithCustomID(manualServiceDefinition, manualServiceID),
+		},
+	})
+}
+
+func createManualServiceTestStep(httpPort int, iteration int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(resourceManualServiceDefinitionTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(manualServiceDefinition, "id", manualServiceID),
+			resource.TestCheckResourceAttr(manualServiceDefinition, ManualServiceFieldTagFilter, manualServiceTagFilter),
+			resource.TestCheckResourceAttr(manualServiceDefinition, ManualServiceFieldDescription, fmt.Sprintf("description %d", iteration)),
+			resource.TestCheckResourceAttr(manualServiceDefinition, ManualServiceFieldEnabled, "true"),
+			resource.TestCheckResourceAttr(manualServiceDefinition, ManualServiceFieldExistingServiceID, ""),
+			resource.TestCheckResourceAttr(manualServiceDefinition, ManualServiceFieldUnmonitoredServiceName, "redis"),
+		),
+	}
+}
+
+type manualServiceUnitTest struct{}
+
+func (ut *manualServiceUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	schemaMap := NewManualServiceResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 5)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ManualServiceFieldTagFilter)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ManualServiceFieldDescription)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(ManualServiceFieldEnabled, true)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ManualServiceFieldExistingServiceID)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ManualServiceFieldUnmonitoredServiceName)
+	require.Equal(t, []string{ManualServiceFieldExistingServiceID, ManualServiceFieldUnmonitoredServiceName}, schemaMap[ManualServiceFieldExistingServiceID].ExactlyOneOf)
+	require.Equal(t, []string{ManualServiceFieldExistingServiceID, ManualServiceFieldUnmonitoredServiceName}, schemaMap[ManualServiceFieldUnmonitoredServiceName].ExactlyOneOf)
+}
+
+func (ut *manualServiceUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_manual_service", NewManualServiceResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *manualServiceUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewManualServiceResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *manualServiceUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewManualServiceResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *manualServiceUnitTest) shouldUpdateResourceState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ManualServiceConfig](t)
+	resourceHandle := NewManualServiceResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	existingServiceID := "service-id"
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.ManualServiceConfig{
+		ID:                  manualServiceID,
+		Description:         "description",
+		Enabled:             false,
+		ExistingServiceID:   &existingServiceID,
+		TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "call.database.connection", restapi.EqualsOperator, "redis:6379"),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, manualServiceID, resourceData.Id())
+	require.Equal(t, manualServiceTagFilter, resourceData.Get(ManualServiceFieldTagFilter))
+	require.Equal(t, "description", resourceData.Get(ManualServiceFieldDescription))
+	require.False(t, resourceData.Get(ManualServiceFieldEnabled).(bool))
+	require.Equal(t, existingServiceID, resourceData.Get(ManualServiceFieldExistingServiceID))
+	require.Equal(t, "", resourceData.Get(ManualServiceFieldUnmonitoredServiceName))
+}
+
+func (ut *manualServiceUnitTest) shouldMapStateToDataModelForExistingService(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ManualServiceConfig](t)
+	resourceHandle := NewManualServiceResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(manualServiceID)
+	setValueOnResourceData(t, resourceData, ManualServiceFieldTagFilter, manualServiceTagFilter)
+	setValueOnResourceData(t, resourceData, ManualServiceFieldExistingServiceID, "service-id")
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	existingServiceID := "service-id"
+	require.Equal(t, &restapi.ManualServiceConfig{
+		ID:                  manualServiceID,
+		Enabled:             true,
+		ExistingServiceID:   &existingServiceID,
+		TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "call.database.connection", restapi.EqualsOperator, "redis:6379"),
+	}, result)
+}
+
+func (ut *manualServiceUnitTest) shouldMapStateToDataModelForUnmonitoredService(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ManualServiceConfig](t)
+	resourceHandle := NewManualServiceResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, ManualServiceFieldTagFilter, manualServiceTagFilter)
+	setValueOnResourceData(t, resourceData, ManualServiceFieldDescription, "description")
+	setValueOnResourceData(t, resourceData, ManualServiceFieldEnabled, false)
+	setValueOnResourceData(t, resourceData, ManualServiceFieldUnmonitoredServiceName, "redis")
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	unmonitoredServiceName := "redis"
+	require.Equal(t, &restapi.ManualServiceConfig{
+		Description:            "description",
+		Enabled:                false,
+		UnmonitoredServiceName: &unmonitoredServiceName,
+		TagFilterExpression:    restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "call.database.connection", restapi.EqualsOperator, "redis:6379"),
+	}, result)
+}
+
+func (ut *manualServiceUnitTest) shouldFailToMapStateToDataModelWhenTagFilterIsNotValid(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ManualServiceConfig](t)
+	resourceHandle := NewManualServiceResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, ManualServiceFieldTagFilter, "invalid tag filter")
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Error(t, err)
+}
@@ -0,0 +1,304 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaMobileAppAlertConfig the name of the terraform-provider-instana resource to manage mobile app alert configs
+const ResourceInstanaMobileAppAlertConfig = "instana_mobile_app_alert_config"
+
+const (
+	//MobileAppAlertConfigFieldAlertChannelIDs constant value for field alert_channel_ids of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldAlertChannelIDs = "alert_channel_ids"
+	//MobileAppAlertConfigFieldMobileAppID constant value for field mobile_app_id of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldMobileAppID = "mobile_app_id"
+	//MobileAppAlertConfigFieldDescription constant value for field description of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldDescription = "description"
+	//MobileAppAlertConfigFieldGranularity constant value for field granularity of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldGranularity = "granularity"
+	//MobileAppAlertConfigFieldName constant value for field name of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldName = "name"
+
+	//MobileAppAlertConfigFieldRule constant value for field rule of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRule = "rule"
+	//MobileAppAlertConfigFieldRuleCustomEventName constant value for field rule.custom_event.custom_event_name of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRuleCustomEventName = "custom_event_name"
+	//MobileAppAlertConfigFieldRuleCustomEvent constant value for field rule.custom_event of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRuleCustomEvent = "custom_event"
+	//MobileAppAlertConfigFieldRuleStatusCode constant value for field rule.status_code of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRuleStatusCode = "status_code"
+	//MobileAppAlertConfigFieldRuleThroughput constant value for field rule.throughput of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldRuleThroughput = "throughput"
+
+	//MobileAppAlertConfigFieldSeverity constant value for field severity of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldSeverity = "severity"
+	//MobileAppAlertConfigFieldTagFilter constant value for field tag_filter of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldTagFilter = "tag_filter"
+
+	//MobileAppAlertConfigFieldTimeThreshold constant value for field time_threshold of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldTimeThreshold = "time_threshold"
+	//MobileAppAlertConfigFieldTriggering constant value for field triggering of resource instana_mobile_app_alert_config
+	MobileAppAlertConfigFieldTriggering = "triggering"
+)
+
+var (
+	mobileAppAlertConfigRuleTypeKeys = []string{
+		"rule.0.custom_event",
+		"rule.0.status_code",
+		"rule.0.throughput",
+	}
+	mobileAppAlertConfigSchemaRuleMetricName = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The metric name of the mobile app alert rule",
+	}
+	mobileAppAlertConfigSchemaOptionalRuleAggregation = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(restapi.SupportedAggregations.ToStringSlice(), true),
+		Description:  "The aggregation function of the mobile app alert rule",
+	}
+)
+
+var mobileAppAlertConfigResourceSchema = map[string]*schema.Schema{
+	MobileAppAlertConfigFieldAlertChannelIDs: websiteAlertConfigSchemaAlertChannelIDs,
+	DefaultCustomPayloadFieldsName:           buildCustomPayloadFields(),
+	MobileAppAlertConfigFieldDescription: {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The description text of the mobile app alert config",
+		ValidateFunc: validation.StringLenBetween(0, 65536),
+	},
+	MobileAppAlertConfigFieldGranularity: websiteAlertConfigSchemaGranularity,
+	MobileAppAlertConfigFieldMobileAppID: {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "Unique ID of the mobile app",
+		ValidateFunc: validation.StringLenBetween(0, 64),
+	},
+	MobileAppAlertConfigFieldName: {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "Name for the mobile app alert configuration",
+		ValidateFunc: validation.StringLenBetween(0, 256),
+	},
+	MobileAppAlertConfigFieldRule: {
+		Type:        schema.TypeList,
+		MinItems:    1,
+		MaxItems:    1,
+		Required:    true,
+		Description: "Indicates the type of rule this alert configuration is about.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				MobileAppAlertConfigFieldRuleCustomEvent: {
+					Type:        schema.TypeList,
+					MinItems:    0,
+					MaxItems:    1,
+					Optional:    true,
+					Description: "Rule based on a custom event reported by the configured mobile app",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							WebsiteAlertConfigFieldRuleMetricName:  mobileAppAlertConfigSchemaRuleMetricName,
+							WebsiteAlertConfigFieldRuleAggregation: mobileAppAlertConfigSchemaOptionalRuleAggregation,
+							MobileAppAlertConfigFieldRuleCustomEventName: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The name of the custom event",
+							},
+						},
+					},
+					ExactlyOneOf: mobileAppAlertConfigRuleTypeKeys,
+				},
+				MobileAppAlertConfigFieldRuleStatusCode: {
+					Type:        schema.TypeList,
+					MinItems:    0,
+					MaxItems:    1,
+					Optional:    true,
+					Description: "Rule based on the HTTP status code of the requests of the configured mobile app",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							WebsiteAlertConfigFieldRuleMetricName:  mobileAppAlertConfigSchemaRuleMetricName,
+							WebsiteAlertConfigFieldRuleAggregation: mobileAppAlertConfigSchemaOptionalRuleAggregation,
+							WebsiteAlertConfigFieldRuleOperator:    websiteAlertConfigSchemaRuleOperator,
+							WebsiteAlertConfigFieldRuleValue: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The value identify the specific http status code",
+							},
+						},
+					},
+					ExactlyOneOf: mobileAppAlertConfigRuleTypeKeys,
+				},
+				MobileAppAlertConfigFieldRuleThroughput: {
+					Type:        schema.TypeList,
+					MinItems:    0,
+					MaxItems:    1,
+					Optional:    true,
+					Description: "Rule based on the throughput of the configured mobile app",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							WebsiteAlertConfigFieldRuleMetricName:  mobileAppAlertConfigSchemaRuleMetricName,
+							WebsiteAlertConfigFieldRuleAggregation: mobileAppAlertConfigSchemaOptionalRuleAggregation,
+						},
+					},
+					ExactlyOneOf: mobileAppAlertConfigRuleTypeKeys,
+				},
+			},
+		},
+	},
+	MobileAppAlertConfigFieldSeverity:      websiteAlertConfigSchemaSeverity,
+	MobileAppAlertConfigFieldTagFilter:     OptionalTagFilterExpressionSchema,
+	ResourceFieldThreshold:                 thresholdSchema,
+	MobileAppAlertConfigFieldTimeThreshold: websiteAlertConfigSchemaTimeThreshold,
+	MobileAppAlertConfigFieldTriggering:    websiteAlertConfigSchemaTriggering,
+}
+
+var mobileAppAlertConfigRuleMapper = newAlertRuleMapper(MobileAppAlertConfigFieldRule, map[string]string{
+	MobileAppAlertConfigFieldRuleCustomEvent: "customEvent",
+	MobileAppAlertConfigFieldRuleStatusCode:  "statusCode",
+})
+
+// NewMobileAppAlertConfigResourceHandle creates the resource handle for Mobile App Alert Configs
+func NewMobileAppAlertConfigResourceHandle() ResourceHandle[*restapi.MobileAppAlertConfig] {
+	return &mobileAppAlertConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName:     ResourceInstanaMobileAppAlertConfig,
+			Schema:           mobileAppAlertConfigResourceSchema,
+			SkipIDGeneration: true,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type mobileAppAlertConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *mobileAppAlertConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *mobileAppAlertConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *mobileAppAlertConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.MobileAppAlertConfig] {
+	return api.MobileAppAlertConfigs()
+}
+
+func (r *mobileAppAlertConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *mobileAppAlertConfigResource) UpdateState(d *schema.ResourceData, config *restapi.MobileAppAlertConfig) error {
+	severity, err := ConvertSeverityFromInstanaAPIToTerraformRepresentation(config.Severity)
+	if err != nil {
+		return err
+	}
+	var normalizedTagFilterString *string
+	if config.TagFilterExpression != nil {
+		normalizedTagFilterString, err = tagfilter.MapTagFilterToNormalizedString(config.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		MobileAppAlertConfigFieldAlertChannelIDs: config.AlertChannelIDs,
+		DefaultCustomPayloadFieldsName:           mapCustomPayloadFieldsToSchema(config),
+		MobileAppAlertConfigFieldDescription:     config.Description,
+		MobileAppAlertConfigFieldGranularity:     config.Granularity,
+		MobileAppAlertConfigFieldMobileAppID:     config.MobileAppID,
+		MobileAppAlertConfigFieldName:            config.Name,
+		MobileAppAlertConfigFieldRule:            r.mapRuleToSchema(config),
+		MobileAppAlertConfigFieldSeverity:        severity,
+		MobileAppAlertConfigFieldTagFilter:       normalizedTagFilterString,
+		ResourceFieldThreshold:                   newThresholdMapper().toState(&config.Threshold),
+		MobileAppAlertConfigFieldTimeThreshold:   mapWebsiteTimeThresholdToState(&config.TimeThreshold),
+		MobileAppAlertConfigFieldTriggering:      config.Triggering,
+	})
+}
+
+func (r *mobileAppAlertConfigResource) mapRuleToSchema(config *restapi.MobileAppAlertConfig) []map[string]interface{} {
+	attributes := alertRuleAttributes{
+		MetricName:  config.Rule.MetricName,
+		Aggregation: config.Rule.Aggregation,
+		Operator:    config.Rule.Operator,
+		Value:       config.Rule.Value,
+	}.toState()
+	if config.Rule.CustomEventName != nil {
+		attributes[MobileAppAlertConfigFieldRuleCustomEventName] = *config.Rule.CustomEventName
+	}
+	return mobileAppAlertConfigRuleMapper.toState(config.Rule.AlertType, attributes)
+}
+
+func (r *mobileAppAlertConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.MobileAppAlertConfig, error) {
+	severity, err := ConvertSeverityFromTerraformToInstanaAPIRepresentation(d.Get(MobileAppAlertConfigFieldSeverity).(string))
+	if err != nil {
+		return nil, err
+	}
+
+	var tagFilter *restapi.TagFilter
+	tagFilterStr, ok := d.GetOk(MobileAppAlertConfigFieldTagFilter)
+	if ok {
+		tagFilter, err = r.mapTagFilterExpressionFromSchema(tagFilterStr.(string))
+		if err != nil {
+			return &restapi.MobileAppAlertConfig{}, err
+		}
+	}
+	customPayloadFields, err := mapDefaultCustomPayloadFieldsFromSchema(d)
+	if err != nil {
+		return &restapi.MobileAppAlertConfig{}, err
+	}
+
+	threshold := newThresholdMapper().fromState(d)
+
+	return &restapi.MobileAppAlertConfig{
+		ID:                    d.Id(),
+		AlertChannelIDs:       ReadStringSetParameterFromResource(d, MobileAppAlertConfigFieldAlertChannelIDs),
+		CustomerPayloadFields: customPayloadFields,
+		Description:           d.Get(MobileAppAlertConfigFieldDescription).(string),
+		Granularity:           restapi.Granularity(d.Get(MobileAppAlertConfigFieldGranularity).(int)),
+		MobileAppID:           d.Get(MobileAppAlertConfigFieldMobileAppID).(string),
+		Name:                  d.Get(MobileAppAlertConfigFieldName).(string),
+		Rule:                  *r.mapRuleFromSchema(d),
+		Severity:              severity,
+		TagFilterExpression:   tagFilter,
+		Threshold:             *threshold,
+		TimeThreshold:         *mapWebsiteTimeThresholdFromState(d, MobileAppAlertConfigFieldTimeThreshold),
+		Triggering:            d.Get(MobileAppAlertConfigFieldTriggering).(bool),
+	}, nil
+}
+
+func (r *mobileAppAlertConfigResource) mapTagFilterExpressionFromSchema(input string) (*restapi.TagFilter, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return mapper.ToAPIModel(expr), nil
+}
+
+func (r *mobileAppAlertConfigResource) mapRuleFromSchema(d *schema.ResourceData) *restapi.MobileAppAlertRule {
+	alertType, config, ok := mobileAppAlertConfigRuleMapper.fromState(d)
+	if !ok {
+		return &restapi.MobileAppAlertRule{}
+	}
+	attributes := mapAlertRuleAttributesFromState(config)
+	return &restapi.MobileAppAlertRule{
+		AlertType:       alertType,
+		MetricName:      attributes.MetricName,
+		Aggregation:     attributes.Aggregation,
+		Operator:        attributes.Operator,
+		Value:           attributes.Value,
+		CustomEventName: mapOptionalAlertRuleAttributeFromState(config, MobileAppAlertConfigFieldRuleCustomEventName),
+	}
+}
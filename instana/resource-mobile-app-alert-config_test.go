@@ -0,0 +1,594 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestMobileAppAlertConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaMobileAppAlertConfig + ".example"
+	inst := &mobileAppAlertConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewMobileAppAlertConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type mobileAppAlertConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.MobileAppAlertConfig]
+}
+
+var mobileAppAlertConfigTerraformTemplate = `
+resource "instana_mobile_app_alert_config" "example" {
+	name              = "name %d"
+	description       = "test-alert-description"
+	severity          = "warning"
+	triggering        = false
+	alert_channel_ids = [ "alert-channel-id-1", "alert-channel-id-2" ]
+	granularity       = 600000
+	tag_filter        = "mobileBeacon.platform@na EQUALS 'iOS'"
+	mobile_app_id     = "mobile-app-id"
+
+	rule {
+		custom_event {
+			metric_name       = "customEvents"
+			aggregation       = "SUM"
+			custom_event_name = "checkout-failed"
+		}
+	}
+
+	threshold {
+		static {
+			operator = ">="
+			value    = 5.0
+		}
+	}
+
+	time_threshold {
+		user_impact_of_violations_in_sequence {
+			time_window               = 600000
+			impact_measurement_method = "AGGREGATED"
+			users                     = 10
+		}
+	}
+
+	custom_payload_field {
+		key    = "test1"
+		value  = "test123"
+	}
+}
+`
+
+var mobileAppAlertConfigServerResponseTemplate = `
+{
+	"id": "%s",
+	"name": "name %d",
+	"description": "test-alert-description",
+	"mobileAppId": "mobile-app-id",
+	"severity": 5,
+	"triggering": false,
+	"tagFilterExpression": {
+		"type": "TAG_FILTER",
+		"name": "mobileBeacon.platform",
+		"stringValue": "iOS",
+		"value": "iOS",
+		"operator": "EQUALS",
+		"entity": "NOT_APPLICABLE"
+	},
+	"rule": {
+		"alertType": "customEvent",
+		"aggregation": "SUM",
+		"metricName": "customEvents",
+		"customEventName": "checkout-failed"
+	},
+	"threshold": {
+		"type": "staticThreshold",
+		"operator": ">=",
+		"value": 5.0,
+		"lastUpdated": 0
+	},
+	"alertChannelIds": [ "alert-channel-id-1", "alert-channel-id-2" ],
+	"granularity": 600000,
+	"timeThreshold": {
+		"type": "userImpactOfViolationsInSequence",
+		"timeWindow": 600000,
+		"impactMeasurementMethod": "AGGREGATED",
+		"users": 10
+	},
+	"customPayloadFields": [
+		{
+			"type": "staticString",
+			"key": "test1",
+			"value": "test123"
+		}
+	],
+	"created": 1647679325301,
+	"readOnly": false,
+	"enabled": true
+}
+`
+
+func (test *mobileAppAlertConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaMobileAppAlertConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaMobileAppAlertConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaMobileAppAlertConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaMobileAppAlertConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should have valid schema", ResourceInstanaMobileAppAlertConfig), test.createTestResourceShouldHaveValidSchema())
+	test.createTestCasesForUpdatesOfTerraformResourceStateFromModel(t)
+	t.Run(fmt.Sprintf("%s should fail to update state from model when severity is invalid", ResourceInstanaMobileAppAlertConfig), test.createTestCasesShouldFailToUpdateTerraformResourceStateFromModeWhenSeverityIsNotValid())
+	test.createTestCasesForMappingOfTerraformResourceStateToModel(t)
+	t.Run(fmt.Sprintf("%s should fail to map state to model when severity is invalid", ResourceInstanaMobileAppAlertConfig), test.createTestCaseShouldFailToMapTerraformResourceStateToModelWhenSeverityIsNotValid())
+	t.Run(fmt.Sprintf("%s should fail to map state to model when tag filter expression is invalid", ResourceInstanaMobileAppAlertConfig), test.createTestCaseShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid())
+	t.Run(fmt.Sprintf("%s should not map unset aggregation of rule to model", ResourceInstanaMobileAppAlertConfig), test.createTestCaseShouldNotMapUnsetAggregationOfRuleToModel())
+}
+
+func (test *mobileAppAlertConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		id := RandomID()
+		resourceRestAPIPath := restapi.MobileAppAlertConfigResourcePath
+		resourceInstanceRestAPIPath := resourceRestAPIPath + "/{internal-id}"
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, resourceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			config := &restapi.MobileAppAlertConfig{}
+			err := json.NewDecoder(r.Body).Decode(config)
+			if err != nil {
+				httpServer.WriteInternalServerError(w, err)
+				return
+			}
+			config.ID = id
+			data, err := json.Marshal(config)
+			if err != nil {
+				httpServer.WriteInternalServerError(w, err)
+				return
+			}
+			httpServer.WriteJSONResponse(w, data)
+		})
+		httpServer.AddRoute(http.MethodPost, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			modCount := httpServer.GetCallCount(http.MethodPost, resourceRestAPIPath+"/"+id)
+			httpServer.WriteJSONResponse(w, []byte(fmt.Sprintf(mobileAppAlertConfigServerResponseTemplate, id, modCount)))
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+			},
+		})
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createIntegrationTestStep(httpPort int, iteration int, id string) resource.TestStep {
+	ruleCustomEventMetricName := fmt.Sprintf("%s.%d.%s.%d.%s", MobileAppAlertConfigFieldRule, 0, MobileAppAlertConfigFieldRuleCustomEvent, 0, WebsiteAlertConfigFieldRuleMetricName)
+	ruleCustomEventAggregation := fmt.Sprintf("%s.%d.%s.%d.%s", MobileAppAlertConfigFieldRule, 0, MobileAppAlertConfigFieldRuleCustomEvent, 0, WebsiteAlertConfigFieldRuleAggregation)
+	ruleCustomEventName := fmt.Sprintf("%s.%d.%s.%d.%s", MobileAppAlertConfigFieldRule, 0, MobileAppAlertConfigFieldRuleCustomEvent, 0, MobileAppAlertConfigFieldRuleCustomEventName)
+	thresholdStaticOperator := fmt.Sprintf("%s.%d.%s.%d.%s", ResourceFieldThreshold, 0, ResourceFieldThresholdStatic, 0, ResourceFieldThresholdOperator)
+	thresholdStaticValue := fmt.Sprintf("%s.%d.%s.%d.%s", ResourceFieldThreshold, 0, ResourceFieldThresholdStatic, 0, ResourceFieldThresholdStaticValue)
+	timeThresholdUserImpactTimeWindow := fmt.Sprintf("%s.%d.%s.%d.%s", MobileAppAlertConfigFieldTimeThreshold, 0, WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence, 0, WebsiteAlertConfigFieldTimeThresholdTimeWindow)
+	timeThresholdUserImpactMethod := fmt.Sprintf("%s.%d.%s.%d.%s", MobileAppAlertConfigFieldTimeThreshold, 0, WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence, 0, WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceImpactMeasurementMethod)
+	timeThresholdUserImpactUsers := fmt.Sprintf("%s.%d.%s.%d.%s", MobileAppAlertConfigFieldTimeThreshold, 0, WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence, 0, WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUsers)
+	customPayloadFieldStaticKey := fmt.Sprintf("%s.0.%s", DefaultCustomPayloadFieldsName, CustomPayloadFieldsFieldKey)
+	customPayloadFieldStaticValue := fmt.Sprintf("%s.0.%s", DefaultCustomPayloadFieldsName, CustomPayloadFieldsFieldStaticStringValue)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(mobileAppAlertConfigTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", id),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldDescription, "test-alert-description"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation()),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldTriggering, falseAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldAlertChannelIDs+".0", "alert-channel-id-1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldAlertChannelIDs+".1", "alert-channel-id-2"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldGranularity, "600000"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldTagFilter, "mobileBeacon.platform@na EQUALS 'iOS'"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldMobileAppID, "mobile-app-id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleCustomEventMetricName, "customEvents"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleCustomEventAggregation, "SUM"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleCustomEventName, "checkout-failed"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, thresholdStaticOperator, ">="),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, thresholdStaticValue, "5"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, timeThresholdUserImpactTimeWindow, "600000"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, timeThresholdUserImpactMethod, "AGGREGATED"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, timeThresholdUserImpactUsers, "10"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, customPayloadFieldStaticKey, "test1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, customPayloadFieldStaticValue, "test123"),
+		),
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Len(t, test.resourceHandle.StateUpgraders(), 0)
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, test.resourceHandle.MetaData().ResourceName, "instana_mobile_app_alert_config")
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestResourceShouldHaveValidSchema() func(t *testing.T) {
+	return func(t *testing.T) {
+		schemaMap := test.resourceHandle.MetaData().Schema
+
+		schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+		require.Len(t, schemaMap, 12)
+		schemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(MobileAppAlertConfigFieldAlertChannelIDs)
+		schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MobileAppAlertConfigFieldDescription)
+		schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MobileAppAlertConfigFieldMobileAppID)
+		schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MobileAppAlertConfigFieldName)
+		schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MobileAppAlertConfigFieldSeverity)
+		schemaAssert.AssertSchemaIsOptionalAndOfTypeString(MobileAppAlertConfigFieldTagFilter)
+		schemaAssert.AssertSchemaIsRequiredAndOfTypeListOfResource(MobileAppAlertConfigFieldRule)
+		schemaAssert.AssertSchemaIsRequiredAndOfTypeListOfResource(ResourceFieldThreshold)
+		schemaAssert.AssertSchemaIsRequiredAndOfTypeListOfResource(MobileAppAlertConfigFieldTimeThreshold)
+		schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(MobileAppAlertConfigFieldTriggering, false)
+
+		ruleSchemaAssert := testutils.NewTerraformSchemaAssert(schemaMap[MobileAppAlertConfigFieldRule].Elem.(*schema.Resource).Schema, t)
+		ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(MobileAppAlertConfigFieldRuleCustomEvent)
+		ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(MobileAppAlertConfigFieldRuleStatusCode)
+		ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(MobileAppAlertConfigFieldRuleThroughput)
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestCasesForUpdatesOfTerraformResourceStateFromModel(t *testing.T) {
+	rules, timeThresholds := test.createRuleAndTimeThresholdTestData()
+	for _, rule := range rules {
+		for _, timeThreshold := range timeThresholds {
+			t.Run(fmt.Sprintf("Should update terraform state of %s from REST response with %s and %s", ResourceInstanaMobileAppAlertConfig, rule.name, timeThreshold.name), test.createTestShouldUpdateTerraformResourceStateFromModelCase(rule, timeThreshold))
+		}
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldUpdateTerraformResourceStateFromModelCase(ruleTestPair testPair[restapi.MobileAppAlertRule, []interface{}], timeThresholdTestPair testPair[restapi.WebsiteTimeThreshold, []interface{}]) func(t *testing.T) {
+	return func(t *testing.T) {
+		config := test.createTestMobileAppAlertConfig(ruleTestPair.input, timeThresholdTestPair.input)
+
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, "mobile-app-alert-config-id", resourceData.Id())
+		require.Equal(t, []interface{}{"channel-2", "channel-1"}, (resourceData.Get(MobileAppAlertConfigFieldAlertChannelIDs).(*schema.Set)).List())
+		require.Equal(t, "mobile-app-id", resourceData.Get(MobileAppAlertConfigFieldMobileAppID))
+		require.Equal(t, "mobile-app-alert-config-description", resourceData.Get(MobileAppAlertConfigFieldDescription))
+		require.Equal(t, "mobile-app-alert-config-name", resourceData.Get(MobileAppAlertConfigFieldName))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "static-key",
+				CustomPayloadFieldsFieldDynamicValue:      []interface{}{},
+				CustomPayloadFieldsFieldStaticStringValue: "static-value",
+			},
+		}, resourceData.Get(DefaultCustomPayloadFieldsName).(*schema.Set).List())
+		require.Equal(t, ruleTestPair.expected, resourceData.Get(MobileAppAlertConfigFieldRule))
+		require.Equal(t, restapi.SeverityCritical.GetTerraformRepresentation(), resourceData.Get(MobileAppAlertConfigFieldSeverity))
+		require.Equal(t, "service.name@src EQUALS 'test'", resourceData.Get(MobileAppAlertConfigFieldTagFilter))
+		require.Equal(t, test.createExpectedStaticThresholdState(), resourceData.Get(ResourceFieldThreshold))
+		require.Equal(t, timeThresholdTestPair.expected, resourceData.Get(MobileAppAlertConfigFieldTimeThreshold))
+		require.True(t, resourceData.Get(MobileAppAlertConfigFieldTriggering).(bool))
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestCasesShouldFailToUpdateTerraformResourceStateFromModeWhenSeverityIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		config := restapi.MobileAppAlertConfig{
+			Name:     "test",
+			Severity: -1,
+		}
+
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &config)
+
+		require.Error(t, err)
+		require.Equal(t, "-1 is not a valid severity", err.Error())
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestCasesForMappingOfTerraformResourceStateToModel(t *testing.T) {
+	rules, timeThresholds := test.createRuleAndTimeThresholdTestData()
+	for _, rule := range rules {
+		for _, timeThreshold := range timeThresholds {
+			t.Run(fmt.Sprintf("Should map terraform state of %s to REST model with %s and %s", ResourceInstanaMobileAppAlertConfig, rule.name, timeThreshold.name), test.createTestShouldMapTerraformResourceStateToModelCase(rule, timeThreshold))
+		}
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldMapTerraformResourceStateToModelCase(ruleTestPair testPair[restapi.MobileAppAlertRule, []interface{}], timeThresholdTestPair testPair[restapi.WebsiteTimeThreshold, []interface{}]) func(t *testing.T) {
+	return func(t *testing.T) {
+		expectedConfig := test.createTestMobileAppAlertConfig(ruleTestPair.input, timeThresholdTestPair.input)
+		expectedConfig.AlertChannelIDs = []string{"channel-2", "channel-1"}
+
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldAlertChannelIDs, []interface{}{"channel-2", "channel-1"})
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldMobileAppID, "mobile-app-id")
+		setValueOnResourceData(t, resourceData, DefaultCustomPayloadFieldsName, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "static-key",
+				CustomPayloadFieldsFieldStaticStringValue: "static-value",
+				CustomPayloadFieldsFieldDynamicValue:      []interface{}{},
+			},
+		})
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldDescription, "mobile-app-alert-config-description")
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldGranularity, restapi.Granularity600000)
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldName, "mobile-app-alert-config-name")
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldRule, ruleTestPair.expected)
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldSeverity, restapi.SeverityCritical.GetTerraformRepresentation())
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldTagFilter, "service.name@src EQUALS 'test'")
+		setValueOnResourceData(t, resourceData, ResourceFieldThreshold, test.createExpectedStaticThresholdState())
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldTimeThreshold, timeThresholdTestPair.expected)
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldTriggering, true)
+		resourceData.SetId("mobile-app-alert-config-id")
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, expectedConfig, result)
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestCaseShouldFailToMapTerraformResourceStateToModelWhenSeverityIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldName, "mobile-app-alert-config-name")
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldSeverity, "invalid")
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.Equal(t, "invalid is not a valid severity", err.Error())
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestCaseShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldName, "mobile-app-alert-config-name")
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation())
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldTagFilter, "invalid invalid invalid")
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unexpected token")
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestCaseShouldNotMapUnsetAggregationOfRuleToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation())
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldRule, []interface{}{
+			map[string]interface{}{
+				MobileAppAlertConfigFieldRuleThroughput: []interface{}{
+					map[string]interface{}{
+						WebsiteAlertConfigFieldRuleMetricName: "test-metric",
+					},
+				},
+			},
+		})
+		setValueOnResourceData(t, resourceData, ResourceFieldThreshold, test.createExpectedStaticThresholdState())
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldTimeThreshold, []interface{}{
+			map[string]interface{}{
+				WebsiteAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{
+					map[string]interface{}{
+						WebsiteAlertConfigFieldTimeThresholdTimeWindow: 12345,
+					},
+				},
+			},
+		})
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, restapi.MobileAppAlertRule{AlertType: "throughput", MetricName: "test-metric"}, result.Rule)
+		ruleJSON, err := json.Marshal(result.Rule)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"alertType":"throughput","metricName":"test-metric"}`, string(ruleJSON))
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestMobileAppAlertConfig(rule restapi.MobileAppAlertRule, timeThreshold restapi.WebsiteTimeThreshold) *restapi.MobileAppAlertConfig {
+	thresholdValue := 123.3
+	thresholdLastUpdate := int64(12345)
+	return &restapi.MobileAppAlertConfig{
+		ID:              "mobile-app-alert-config-id",
+		AlertChannelIDs: []string{"channel-1", "channel-2"},
+		MobileAppID:     "mobile-app-id",
+		Description:     "mobile-app-alert-config-description",
+		Granularity:     restapi.Granularity600000,
+		CustomerPayloadFields: []restapi.CustomPayloadField[any]{
+			{
+				Type:  restapi.StaticStringCustomPayloadType,
+				Key:   "static-key",
+				Value: restapi.StaticStringCustomPayloadFieldValue("static-value"),
+			},
+		},
+		Name:                "mobile-app-alert-config-name",
+		Rule:                rule,
+		Severity:            restapi.SeverityCritical.GetAPIRepresentation(),
+		TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntitySource, "service.name", restapi.EqualsOperator, "test"),
+		Threshold: restapi.Threshold{
+			Type:        "staticThreshold",
+			Operator:    restapi.ThresholdOperatorGreaterThan,
+			LastUpdated: &thresholdLastUpdate,
+			Value:       &thresholdValue,
+		},
+		TimeThreshold: timeThreshold,
+		Triggering:    true,
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createExpectedStaticThresholdState() []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			ResourceFieldThresholdHistoricBaseline: []interface{}{},
+			ResourceFieldThresholdStatic: []interface{}{
+				map[string]interface{}{
+					ResourceFieldThresholdLastUpdated: 12345,
+					ResourceFieldThresholdOperator:    string(restapi.ThresholdOperatorGreaterThan),
+					ResourceFieldThresholdStaticValue: 123.3,
+				},
+			},
+		},
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createRuleAndTimeThresholdTestData() ([]testPair[restapi.MobileAppAlertRule, []interface{}], []testPair[restapi.WebsiteTimeThreshold, []interface{}]) {
+	metricName := "test-metric"
+	equalsOperator := restapi.EqualsOperator
+	sumAggregation := restapi.SumAggregation
+	statusCodeValue := "500"
+	customEventName := "checkout-failed"
+	emptyRules := map[string]interface{}{
+		MobileAppAlertConfigFieldRuleCustomEvent: []interface{}{},
+		MobileAppAlertConfigFieldRuleStatusCode:  []interface{}{},
+		MobileAppAlertConfigFieldRuleThroughput:  []interface{}{},
+	}
+	ruleState := func(ruleType string, attributes map[string]interface{}) []interface{} {
+		result := make(map[string]interface{})
+		for k, v := range emptyRules {
+			result[k] = v
+		}
+		result[ruleType] = []interface{}{attributes}
+		return []interface{}{result}
+	}
+	rules := []testPair[restapi.MobileAppAlertRule, []interface{}]{
+		{
+			name:  "CustomEvent",
+			input: restapi.MobileAppAlertRule{AlertType: "customEvent", MetricName: metricName, Aggregation: &sumAggregation, CustomEventName: &customEventName},
+			expected: ruleState(MobileAppAlertConfigFieldRuleCustomEvent, map[string]interface{}{
+				WebsiteAlertConfigFieldRuleMetricName:        metricName,
+				WebsiteAlertConfigFieldRuleAggregation:       string(sumAggregation),
+				MobileAppAlertConfigFieldRuleCustomEventName: customEventName,
+			}),
+		},
+		{
+			name:  "StatusCode",
+			input: restapi.MobileAppAlertRule{AlertType: "statusCode", MetricName: metricName, Aggregation: &sumAggregation, Operator: &equalsOperator, Value: &statusCodeValue},
+			expected: ruleState(MobileAppAlertConfigFieldRuleStatusCode, map[string]interface{}{
+				WebsiteAlertConfigFieldRuleMetricName:  metricName,
+				WebsiteAlertConfigFieldRuleAggregation: string(sumAggregation),
+				WebsiteAlertConfigFieldRuleOperator:    string(equalsOperator),
+				WebsiteAlertConfigFieldRuleValue:       statusCodeValue,
+			}),
+		},
+		{
+			name:  "Throughput",
+			input: restapi.MobileAppAlertRule{AlertType: "throughput", MetricName: metricName, Aggregation: &sumAggregation},
+			expected: ruleState(MobileAppAlertConfigFieldRuleThroughput, map[string]interface{}{
+				WebsiteAlertConfigFieldRuleMetricName:  metricName,
+				WebsiteAlertConfigFieldRuleAggregation: string(sumAggregation),
+			}),
+		},
+	}
+
+	timeThresholdWindow := int64(12345)
+	timeThresholdImpactMeasurementMethod := restapi.WebsiteImpactMeasurementMethodPerWindow
+	timeThresholdUsers := int32(5)
+	timeThresholdUserPercentage := 0.8
+	timeThresholdViolations := int32(3)
+	timeThresholds := []testPair[restapi.WebsiteTimeThreshold, []interface{}]{
+		{
+			name: "UserImpactOfViolationsInSequence",
+			input: restapi.WebsiteTimeThreshold{
+				Type:                    "userImpactOfViolationsInSequence",
+				TimeWindow:              &timeThresholdWindow,
+				ImpactMeasurementMethod: &timeThresholdImpactMeasurementMethod,
+				Users:                   &timeThresholdUsers,
+				UserPercentage:          &timeThresholdUserPercentage,
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence: []interface{}{
+						map[string]interface{}{
+							WebsiteAlertConfigFieldTimeThresholdTimeWindow:                                              int(timeThresholdWindow),
+							WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceImpactMeasurementMethod: string(timeThresholdImpactMeasurementMethod),
+							WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUserPercentage:          timeThresholdUserPercentage,
+							WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUsers:                   int(timeThresholdUsers),
+						},
+					},
+					WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod:   []interface{}{},
+					WebsiteAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{},
+				},
+			},
+		},
+		{
+			name: "ViolationsInPeriod",
+			input: restapi.WebsiteTimeThreshold{
+				Type:       "violationsInPeriod",
+				TimeWindow: &timeThresholdWindow,
+				Violations: &timeThresholdViolations,
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence: []interface{}{},
+					WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod: []interface{}{
+						map[string]interface{}{
+							WebsiteAlertConfigFieldTimeThresholdTimeWindow:                   int(timeThresholdWindow),
+							WebsiteAlertConfigFieldTimeThresholdViolationsInPeriodViolations: int(timeThresholdViolations),
+						},
+					},
+					WebsiteAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{},
+				},
+			},
+		},
+		{
+			name: "ViolationsInSequence",
+			input: restapi.WebsiteTimeThreshold{
+				Type:       "violationsInSequence",
+				TimeWindow: &timeThresholdWindow,
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence: []interface{}{},
+					WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod:               []interface{}{},
+					WebsiteAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{
+						map[string]interface{}{
+							WebsiteAlertConfigFieldTimeThresholdTimeWindow: int(timeThresholdWindow),
+						},
+					},
+				},
+			},
+		},
+	}
+	return rules, timeThresholds
+}
@@ -0,0 +1,111 @@
+package instana
+
+import (
+	"context"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaMobileAppConfig the name of the terraform-provider-instana resource to manage mobile app configurations
+const ResourceInstanaMobileAppConfig = "instana_mobile_app_config"
+
+const (
+	//MobileAppConfigFieldName constant value for the schema field name
+	MobileAppConfigFieldName = "name"
+)
+
+// NewMobileAppConfigResourceHandle creates the resource handle for mobile app configurations
+func NewMobileAppConfigResourceHandle() ResourceHandle[*restapi.MobileAppConfig] {
+	return &mobileAppConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaMobileAppConfig,
+			Schema: map[string]*schema.Schema{
+				MobileAppConfigFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Configures the name of the mobile app",
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				GeoLocationFieldGeoLocation:         withoutComputedValue(geoLocationSchema),
+				GeoMappingRulesFieldGeoMappingRules: withoutComputedValue(geoMappingRulesSchema),
+				IPMaskingFieldIPMasking:             withoutComputedValue(ipMaskingSchema),
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type mobileAppConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *mobileAppConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *mobileAppConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *mobileAppConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.MobileAppConfig] {
+	return api.MobileAppConfigs()
+}
+
+func (r *mobileAppConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+// ReadFromAPI reads the mobile app together with the geo location and IP masking settings which are managed by
+// terraform. Settings which are not configured are not read to avoid additional requests to the Instana API. All
+// settings are read when the mobile app is imported as the state does not contain any data in this case.
+func (r *mobileAppConfigResource) ReadFromAPI(ctx context.Context, d *schema.ResourceData, api restapi.InstanaAPI, id string) (*restapi.MobileAppConfig, error) {
+	restResource := r.GetRestResource(api)
+	reader, ok := restResource.(restapi.GeoLocationAndIPMaskingSettingsReader[*restapi.MobileAppConfig])
+	if !ok || len(d.Get(MobileAppConfigFieldName).(string)) == 0 {
+		return restResource.GetOne(ctx, id)
+	}
+	return reader.GetOneWithSettings(ctx, id, mapGeoLocationAndIPMaskingSettingsFromState(d).Selection())
+}
+
+// UpdateState updates the state of the mobile app. Geo location and IP masking settings which were not read from the
+// Instana API are kept as they are.
+func (r *mobileAppConfigResource) UpdateState(d *schema.ResourceData, config *restapi.MobileAppConfig) error {
+	d.SetId(config.ID)
+	data := map[string]interface{}{
+		MobileAppConfigFieldName: config.Name,
+	}
+	if config.GeoLocation != nil {
+		data[GeoLocationFieldGeoLocation] = mapGeoLocationToState(config.GeoLocation)
+	}
+	if config.GeoMappingRules != nil {
+		data[GeoMappingRulesFieldGeoMappingRules] = mapGeoMappingRulesToState(config.GeoMappingRules)
+	}
+	if config.IPMasking != nil {
+		data[IPMaskingFieldIPMasking] = mapIPMaskingToState(config.IPMasking)
+	}
+	return tfutils.UpdateState(d, data)
+}
+
+// MapStateToDataObject maps the state to the mobile app. Only geo location and IP masking settings which are
+// configured and changed are provided so that unchanged settings are not sent to the Instana API again.
+func (r *mobileAppConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.MobileAppConfig, error) {
+	settings := mapGeoLocationAndIPMaskingSettingsFromState(d)
+	if !d.HasChange(GeoLocationFieldGeoLocation) {
+		settings.GeoLocation = nil
+	}
+	if !d.HasChange(GeoMappingRulesFieldGeoMappingRules) {
+		settings.GeoMappingRules = nil
+	}
+	if !d.HasChange(IPMaskingFieldIPMasking) {
+		settings.IPMasking = nil
+	}
+	return &restapi.MobileAppConfig{
+		ID:                              d.Id(),
+		Name:                            d.Get(MobileAppConfigFieldName).(string),
+		GeoLocationAndIPMaskingSettings: settings,
+	}, nil
+}
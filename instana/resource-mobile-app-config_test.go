@@ -0,0 +1,344 @@
+package instana_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestMobileAppConfigResource(t *testing.T) {
+	ut := &mobileAppConfigUnitTest{}
+	t.Run("CRUD integration test with sub resources", mobileAppConfigIntegrationTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should update resource state", ut.shouldUpdateResourceState)
+	t.Run("should update resource state when sub resources are not available", ut.shouldUpdateResourceStateWhenSubResourcesAreNotAvailable)
+	t.Run("should keep sub resources which are not read when updating resource state", ut.shouldKeepSubResourcesWhichAreNotReadWhenUpdatingResourceState)
+	t.Run("should map state to data model", ut.shouldMapStateToDataModel)
+	t.Run("should not provide unchanged sub resources when mapping state to data model", ut.shouldNotProvideUnchangedSubResourcesWhenMappingStateToDataModel)
+	t.Run("should map state to data model without sub resources", ut.shouldMapStateToDataModelWithoutSubResources)
+	t.Run("should read only configured sub resources", ut.shouldReadOnlyConfiguredSubResources)
+	t.Run("should suppress diff of geo mapping rules when only line endings and surrounding whitespaces differ", ut.shouldSuppressDiffOfGeoMappingRulesWhenOnlyLineEndingsAndSurroundingWhitespacesDiffer)
+}
+
+const (
+	mobileAppConfigDefinition      = "instana_mobile_app_config.example"
+	mobileAppConfigID              = "mobile-app-id"
+	mobileAppConfigGeoMappingRules = "10.0.0.0/8,EU,Europe,DE,Germany\n"
+	mobileAppConfigNestedField     = "%s.0.%s"
+)
+
+const resourceMobileAppConfigDefinitionTemplate = `
+resource "instana_mobile_app_config" "example" {
+  name = "name %d"
+
+  geo_location {
+    geo_detail_removal = "%s"
+  }
+
+  geo_mapping_rules {
+    csv = <<-EOT
+      10.0.0.0/8,EU,Europe,DE,Germany
+    EOT
+  }
+
+  ip_masking {
+    mode = "%s"
+  }
+}
+`
+
+const resourceMobileAppConfigWithoutSubResourcesDefinitionTemplate = `
+resource "instana_mobile_app_config" "example" {
+  name = "name %d"
+}
+`
+
+func mobileAppConfigIntegrationTest(t *testing.T) {
+	httpServer := createMockHttpServerForMobileAppConfig()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createMobileAppConfigTestStep(httpServer.GetPort(), fmt.Sprintf(resourceMobileAppConfigDefinitionTemplate, 0, "REMOVE_CITY", "STRICT"), 0, "REMOVE_CITY", "STRICT"),
+			testStepImport(mobileAppConfigDefinition),
+			createMobileAppConfigTestStep(httpServer.GetPort(), fmt.Sprintf(resourceMobileAppConfigDefinitionTemplate, 1, "REMOVE_ALL", "DEFAULT"), 1, "REMOVE_ALL", "DEFAULT"),
+			testStepImport(mobileAppConfigDefinition),
+			{
+				Config: appendProviderConfig(fmt.Sprintf(resourceMobileAppConfigWithoutSubResourcesDefinitionTemplate, 2), httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(mobileAppConfigDefinition, "id", mobileAppConfigID),
+					resource.TestCheckResourceAttr(mobileAppConfigDefinition, MobileAppConfigFieldName, formatResourceName(2)),
+					resource.TestCheckNoResourceAttr(mobileAppConfigDefinition, fmt.Sprintf(mobileAppConfigNestedField, GeoLocationFieldGeoLocation, GeoLocationFieldGeoDetailRemoval)),
+					resource.TestCheckNoResourceAttr(mobileAppConfigDefinition, fmt.Sprintf(mobileAppConfigNestedField, IPMaskingFieldIPMasking, IPMaskingFieldMode)),
+					verifyMobileAppConfigCallCount(httpServer, http.MethodPut, "/geo-location", 2),
+					verifyMobileAppConfigCallCount(httpServer, http.MethodPut, "/geo-mapping-rules", 1),
+					verifyMobileAppConfigCallCount(httpServer, http.MethodPut, "/ip-masking", 2),
+				),
+			},
+		},
+	})
+}
+
+func createMobileAppConfigTestStep(httpPort int, config string, iteration int, geoDetailRemoval string, ipMasking string) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(config, httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(mobileAppConfigDefinition, "id", mobileAppConfigID),
+			resource.TestCheckResourceAttr(mobileAppConfigDefinition, MobileAppConfigFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(mobileAppConfigDefinition, fmt.Sprintf(mobileAppConfigNestedField, GeoLocationFieldGeoLocation, GeoLocationFieldGeoDetailRemoval), geoDetailRemoval),
+			resource.TestCheckResourceAttr(mobileAppConfigDefinition, fmt.Sprintf(mobileAppConfigNestedField, GeoMappingRulesFieldGeoMappingRules, GeoMappingRulesFieldCSV), mobileAppConfigGeoMappingRules),
+			resource.TestCheckResourceAttr(mobileAppConfigDefinition, fmt.Sprintf(mobileAppConfigNestedField, IPMaskingFieldIPMasking, IPMaskingFieldMode), ipMasking),
+		),
+	}
+}
+
+func verifyMobileAppConfigCallCount(httpServer testutils.TestHTTPServer, method string, subPath string, expected int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		path := restapi.MobileAppConfigResourcePath + "/" + mobileAppConfigID + subPath
+		if actual := httpServer.GetCallCount(method, path); actual != expected {
+			return fmt.Errorf("expected %d %s requests of %s but got %d", expected, method, subPath, actual)
+		}
+		return nil
+	}
+}
+
+// createMockHttpServerForMobileAppConfig creates a test server which stores a single mobile app and its sub resources
+// in memory. Like the Instana API, single mobile apps can only be retrieved through the list of all mobile apps.
+func createMockHttpServerForMobileAppConfig() testutils.TestHTTPServer {
+	resourcePath := restapi.MobileAppConfigResourcePath
+	pathTemplate := resourcePath + "/{id}"
+	httpServer := testutils.NewTestHTTPServer()
+	var mutex sync.Mutex
+	var app *restapi.MobileAppConfig
+
+	writeJSON := func(w http.ResponseWriter, value interface{}) {
+		data, err := json.Marshal(value)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		httpServer.WriteJSONResponse(w, data)
+	}
+	withApp := func(handler func(w http.ResponseWriter, r *http.Request)) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if app == nil || app.ID != mux.Vars(r)["id"] {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			handler(w, r)
+		}
+	}
+
+	httpServer.AddRoute(http.MethodGet, resourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		apps := make([]*restapi.MobileAppConfig, 0)
+		if app != nil {
+			apps = append(apps, app)
+		}
+		writeJSON(w, apps)
+	})
+	httpServer.AddRoute(http.MethodPost, resourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		app = &restapi.MobileAppConfig{ID: mobileAppConfigID, Name: r.URL.Query().Get("name")}
+		writeJSON(w, app)
+	})
+	httpServer.AddRoute(http.MethodPut, pathTemplate, withApp(func(w http.ResponseWriter, r *http.Request) {
+		app.Name = r.URL.Query().Get("name")
+		writeJSON(w, app)
+	}))
+	httpServer.AddRoute(http.MethodDelete, pathTemplate, withApp(func(w http.ResponseWriter, r *http.Request) {
+		app = nil
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	addGeoLocationAndIPMaskingRoutes(httpServer, pathTemplate)
+	return httpServer
+}
+
+type mobileAppConfigUnitTest struct{}
+
+func (ut *mobileAppConfigUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	schemaMap := NewMobileAppConfigResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 4)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MobileAppConfigFieldName)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(GeoLocationFieldGeoLocation)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(GeoMappingRulesFieldGeoMappingRules)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(IPMaskingFieldIPMasking)
+
+	testutils.NewTerraformSchemaAssert(schemaMap[GeoLocationFieldGeoLocation].Elem.(*schema.Resource).Schema, t).AssertSchemaIsRequiredAndOfTypeString(GeoLocationFieldGeoDetailRemoval)
+	testutils.NewTerraformSchemaAssert(schemaMap[GeoMappingRulesFieldGeoMappingRules].Elem.(*schema.Resource).Schema, t).AssertSchemaIsRequiredAndOfTypeString(GeoMappingRulesFieldCSV)
+	testutils.NewTerraformSchemaAssert(schemaMap[IPMaskingFieldIPMasking].Elem.(*schema.Resource).Schema, t).AssertSchemaIsRequiredAndOfTypeString(IPMaskingFieldMode)
+}
+
+func (ut *mobileAppConfigUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_mobile_app_config", NewMobileAppConfigResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *mobileAppConfigUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewMobileAppConfigResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *mobileAppConfigUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewMobileAppConfigResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *mobileAppConfigUnitTest) shouldUpdateResourceState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.MobileAppConfig](t)
+	resourceHandle := NewMobileAppConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	rules := mobileAppConfigGeoMappingRules
+	data := &restapi.MobileAppConfig{
+		ID:   mobileAppConfigID,
+		Name: resourceName,
+		GeoLocationAndIPMaskingSettings: restapi.GeoLocationAndIPMaskingSettings{
+			GeoLocation:     &restapi.GeoLocationConfiguration{GeoDetailRemoval: restapi.GeoDetailRemovalRemoveCoordinates},
+			GeoMappingRules: &rules,
+			IPMasking:       &restapi.IPMaskingConfiguration{IPMasking: restapi.IPMaskingRemoveAllDetails},
+		},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, data)
+
+	require.NoError(t, err)
+	require.Equal(t, mobileAppConfigID, resourceData.Id())
+	require.Equal(t, resourceName, resourceData.Get(MobileAppConfigFieldName))
+	require.Equal(t, []interface{}{map[string]interface{}{GeoLocationFieldGeoDetailRemoval: "REMOVE_COORDINATES"}}, resourceData.Get(GeoLocationFieldGeoLocation))
+	require.Equal(t, []interface{}{map[string]interface{}{GeoMappingRulesFieldCSV: mobileAppConfigGeoMappingRules}}, resourceData.Get(GeoMappingRulesFieldGeoMappingRules))
+	require.Equal(t, []interface{}{map[string]interface{}{IPMaskingFieldMode: "REMOVE_ALL_DETAILS"}}, resourceData.Get(IPMaskingFieldIPMasking))
+}
+
+func (ut *mobileAppConfigUnitTest) shouldUpdateResourceStateWhenSubResourcesAreNotAvailable(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.MobileAppConfig](t)
+	resourceHandle := NewMobileAppConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.MobileAppConfig{ID: mobileAppConfigID, Name: resourceName})
+
+	require.NoError(t, err)
+	require.Equal(t, resourceName, resourceData.Get(MobileAppConfigFieldName))
+	require.Empty(t, resourceData.Get(GeoLocationFieldGeoLocation))
+	require.Empty(t, resourceData.Get(GeoMappingRulesFieldGeoMappingRules))
+	require.Empty(t, resourceData.Get(IPMaskingFieldIPMasking))
+}
+
+func (ut *mobileAppConfigUnitTest) shouldKeepSubResourcesWhichAreNotReadWhenUpdatingResourceState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.MobileAppConfig](t)
+	resourceHandle := NewMobileAppConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, IPMaskingFieldIPMasking, []interface{}{map[string]interface{}{IPMaskingFieldMode: "STRICT"}})
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.MobileAppConfig{ID: mobileAppConfigID, Name: resourceName})
+
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{map[string]interface{}{IPMaskingFieldMode: "STRICT"}}, resourceData.Get(IPMaskingFieldIPMasking))
+	require.Empty(t, resourceData.Get(GeoLocationFieldGeoLocation))
+}
+
+func (ut *mobileAppConfigUnitTest) shouldMapStateToDataModel(t *testing.T) {
+	resourceHandle := NewMobileAppConfigResourceHandle()
+	resourceData := schema.TestResourceDataRaw(t, resourceHandle.MetaData().Schema, map[string]interface{}{
+		MobileAppConfigFieldName:            resourceName,
+		GeoLocationFieldGeoLocation:         []interface{}{map[string]interface{}{GeoLocationFieldGeoDetailRemoval: "REMOVE_CITY"}},
+		GeoMappingRulesFieldGeoMappingRules: []interface{}{map[string]interface{}{GeoMappingRulesFieldCSV: mobileAppConfigGeoMappingRules}},
+		IPMaskingFieldIPMasking:             []interface{}{map[string]interface{}{IPMaskingFieldMode: "STRICT"}},
+	})
+	resourceData.SetId(mobileAppConfigID)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	rules := mobileAppConfigGeoMappingRules
+	require.Equal(t, &restapi.MobileAppConfig{
+		ID:   mobileAppConfigID,
+		Name: resourceName,
+		GeoLocationAndIPMaskingSettings: restapi.GeoLocationAndIPMaskingSettings{
+			GeoLocation:     &restapi.GeoLocationConfiguration{GeoDetailRemoval: restapi.GeoDetailRemovalRemoveCity},
+			GeoMappingRules: &rules,
+			IPMasking:       &restapi.IPMaskingConfiguration{IPMasking: restapi.IPMaskingStrict},
+		},
+	}, result)
+}
+
+func (ut *mobileAppConfigUnitTest) shouldMapStateToDataModelWithoutSubResources(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.MobileAppConfig](t)
+	resourceHandle := NewMobileAppConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(mobileAppConfigID)
+	setValueOnResourceData(t, resourceData, MobileAppConfigFieldName, resourceName)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.MobileAppConfig{ID: mobileAppConfigID, Name: resourceName}, result)
+}
+
+func (ut *mobileAppConfigUnitTest) shouldNotProvideUnchangedSubResourcesWhenMappingStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.MobileAppConfig](t)
+	resourceHandle := NewMobileAppConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(mobileAppConfigID)
+	setValueOnResourceData(t, resourceData, MobileAppConfigFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, IPMaskingFieldIPMasking, []interface{}{map[string]interface{}{IPMaskingFieldMode: "STRICT"}})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, restapi.GeoLocationAndIPMaskingSettings{}, result.GeoLocationAndIPMaskingSettings)
+}
+
+func (ut *mobileAppConfigUnitTest) shouldReadOnlyConfiguredSubResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+
+	mockInstanaAPI.EXPECT().MobileAppConfigs().Return(restapi.NewMobileAppConfigRestResource(restapi.NewDefaultJSONUnmarshaller(&restapi.MobileAppConfig{}), client)).Times(1)
+	client.EXPECT().Get(gomock.Any(), restapi.MobileAppConfigResourcePath).Times(1).Return([]byte(`[{"id":"mobile-app-id","name":"name"}]`), nil)
+	client.EXPECT().GetContent(gomock.Any(), restapi.MobileAppConfigResourcePath+"/"+mobileAppConfigID+"/ip-masking", gomock.Any()).Times(1).Return([]byte(`{"ipMasking":"DEFAULT"}`), nil)
+
+	testHelper := NewTestHelper[*restapi.MobileAppConfig](t)
+	resourceHandle := NewMobileAppConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(mobileAppConfigID)
+	setValueOnResourceData(t, resourceData, MobileAppConfigFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, IPMaskingFieldIPMasking, []interface{}{map[string]interface{}{IPMaskingFieldMode: "STRICT"}})
+
+	diag := NewTerraformResource(resourceHandle).Read(context.TODO(), resourceData, &ProviderMeta{InstanaAPI: mockInstanaAPI})
+
+	require.False(t, diag.HasError())
+	require.Equal(t, []interface{}{map[string]interface{}{IPMaskingFieldMode: "DEFAULT"}}, resourceData.Get(IPMaskingFieldIPMasking))
+	require.Empty(t, resourceData.Get(GeoLocationFieldGeoLocation))
+	require.Empty(t, resourceData.Get(GeoMappingRulesFieldGeoMappingRules))
+}
+
+func (ut *mobileAppConfigUnitTest) shouldSuppressDiffOfGeoMappingRulesWhenOnlyLineEndingsAndSurroundingWhitespacesDiffer(t *testing.T) {
+	csvSchema := NewMobileAppConfigResourceHandle().MetaData().Schema[GeoMappingRulesFieldGeoMappingRules].Elem.(*schema.Resource).Schema[GeoMappingRulesFieldCSV]
+
+	require.True(t, csvSchema.DiffSuppressFunc("", "a,b\r\nc,d\r\n", "a,b\nc,d", nil))
+	require.False(t, csvSchema.DiffSuppressFunc("", "a,b\nc,d", "a,b\nc,e", nil))
+}
@@ -0,0 +1,188 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaRelease the name of the terraform-provider-instana resource to manage releases
+const ResourceInstanaRelease = "instana_release"
+
+const (
+	//ReleaseFieldName constant value for the schema field name
+	ReleaseFieldName = "name"
+	//ReleaseFieldStart constant value for the schema field start
+	ReleaseFieldStart = "start"
+	//ReleaseFieldApplication constant value for the schema field application
+	ReleaseFieldApplication = "application"
+	//ReleaseFieldService constant value for the schema field service
+	ReleaseFieldService = "service"
+	//ReleaseFieldScopedToApplication constant value for the schema field service.scoped_to_application
+	ReleaseFieldScopedToApplication = "scoped_to_application"
+	//ReleaseFieldTriggers constant value for the schema field triggers
+	ReleaseFieldTriggers = "triggers"
+)
+
+var releaseApplicationSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		ReleaseFieldName: {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "The name of the application",
+			ValidateFunc: validation.StringLenBetween(1, 256),
+		},
+	},
+}
+
+// NewReleaseResourceHandle creates the resource handle for releases
+func NewReleaseResourceHandle() ResourceHandle[*restapi.Release] {
+	return &releaseResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaRelease,
+			Schema: map[string]*schema.Schema{
+				ReleaseFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The name of the release",
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+				ReleaseFieldStart: {
+					Type:         schema.TypeInt,
+					Required:     true,
+					Description:  "The start time of the release as unix timestamp in milliseconds",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				ReleaseFieldApplication: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MinItems:    0,
+					MaxItems:    10,
+					Description: "The applications the release is scoped to",
+					Elem:        releaseApplicationSchema,
+				},
+				ReleaseFieldService: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MinItems:    0,
+					MaxItems:    10,
+					Description: "The services the release is scoped to",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							ReleaseFieldName: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The name of the service",
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+							ReleaseFieldScopedToApplication: {
+								Type:        schema.TypeList,
+								Optional:    true,
+								MinItems:    0,
+								MaxItems:    10,
+								Description: "The applications the service is limited to",
+								Elem:        releaseApplicationSchema,
+							},
+						},
+					},
+				},
+				ReleaseFieldTriggers: {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Arbitrary map of values which creates a new release when changed, e.g. the version of the deployed application",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type releaseResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *releaseResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *releaseResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *releaseResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.Release] {
+	return api.Releases()
+}
+
+func (r *releaseResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *releaseResource) UpdateState(d *schema.ResourceData, release *restapi.Release) error {
+	services := make([]interface{}, len(release.Services))
+	for i, service := range release.Services {
+		scopedToApplications := make([]interface{}, 0)
+		if service.ScopedTo != nil {
+			scopedToApplications = r.mapApplicationsToState(service.ScopedTo.Applications)
+		}
+		services[i] = map[string]interface{}{
+			ReleaseFieldName:                service.Name,
+			ReleaseFieldScopedToApplication: scopedToApplications,
+		}
+	}
+
+	d.SetId(release.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		ReleaseFieldName:        release.Name,
+		ReleaseFieldStart:       release.Start,
+		ReleaseFieldApplication: r.mapApplicationsToState(release.Applications),
+		ReleaseFieldService:     services,
+	})
+}
+
+func (r *releaseResource) mapApplicationsToState(applications []restapi.ReleaseApplication) []interface{} {
+	result := make([]interface{}, len(applications))
+	for i, application := range applications {
+		result[i] = map[string]interface{}{
+			ReleaseFieldName: application.Name,
+		}
+	}
+	return result
+}
+
+func (r *releaseResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.Release, error) {
+	return &restapi.Release{
+		ID:           d.Id(),
+		Name:         d.Get(ReleaseFieldName).(string),
+		Start:        int64(d.Get(ReleaseFieldStart).(int)),
+		Applications: r.mapApplicationsFromState(d.Get(ReleaseFieldApplication).([]interface{})),
+		Services:     r.mapServicesFromState(d.Get(ReleaseFieldService).([]interface{})),
+	}, nil
+}
+
+func (r *releaseResource) mapApplicationsFromState(data []interface{}) []restapi.ReleaseApplication {
+	result := make([]restapi.ReleaseApplication, len(data))
+	for i, v := range data {
+		application := v.(map[string]interface{})
+		result[i] = restapi.ReleaseApplication{Name: application[ReleaseFieldName].(string)}
+	}
+	return result
+}
+
+func (r *releaseResource) mapServicesFromState(data []interface{}) []restapi.ReleaseService {
+	result := make([]restapi.ReleaseService, len(data))
+	for i, v := range data {
+		service := v.(map[string]interface{})
+		var scopedTo *restapi.ReleaseServiceScopedTo
+		if scopedToApplications, ok := service[ReleaseFieldScopedToApplication].([]interface{}); ok && len(scopedToApplications) > 0 {
+			scopedTo = &restapi.ReleaseServiceScopedTo{Applications: r.mapApplicationsFromState(scopedToApplications)}
+		}
+		result[i] = restapi.ReleaseService{Name: service[ReleaseFieldName].(string), ScopedTo: scopedTo}
+	}
+	return result
+}
@@ -0,0 +1,288 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestReleaseResource(t *testing.T) {
+	ut := &releaseUnitTest{}
+	t.Run("CRUD integration test", releaseIntegrationTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should update resource state", ut.shouldUpdateResourceState)
+	t.Run("should map state to data model", ut.shouldMapStateToDataModel)
+	t.Run("should map state to data model without scopes", ut.shouldMapStateToDataModelWithoutScopes)
+	t.Run("should send applications and services by name only", ut.shouldSendApplicationsAndServicesByNameOnly)
+}
+
+const (
+	releaseDefinition = "instana_release.example"
+)
+
+const resourceReleaseDefinitionTemplate = `
+resource "instana_release" "example" {
+  name  = "release %d"
+  start = 1700000000000
+
+  application {
+    name = "application-name"
+  }
+
+  service {
+    name = "service-name"
+    scoped_to_application {
+      name = "application-name"
+    }
+  }
+
+  triggers = {
+    version = "%s"
+  }
+}
+`
+
+func releaseIntegrationTest(t *testing.T) {
+	var mutex sync.Mutex
+	releases := make(map[string]*restapi.Release)
+	creations := 0
+	deletions := 0
+	resourceInstancePath := restapi.ReleasesResourcePath + "/{id}"
+	httpServer := testutils.NewTestHTTPServer()
+	writeRelease := func(w http.ResponseWriter, release *restapi.Release) {
+		data, err := json.Marshal(release)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		httpServer.WriteJSONResponse(w, data)
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.ReleasesResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		release := &restapi.Release{}
+		if err := json.NewDecoder(r.Body).Decode(release); err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		creations++
+		release.ID = fmt.Sprintf("release-id-%d", creations)
+		releases[release.ID] = release
+		writeRelease(w, release)
+	})
+	httpServer.AddRoute(http.MethodPut, resourceInstancePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		release := &restapi.Release{}
+		if err := json.NewDecoder(r.Body).Decode(release); err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		release.ID = mux.Vars(r)["id"]
+		releases[release.ID] = release
+		writeRelease(w, release)
+	})
+	httpServer.AddRoute(http.MethodGet, resourceInstancePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		release, ok := releases[mux.Vars(r)["id"]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeRelease(w, release)
+	})
+	httpServer.AddRoute(http.MethodDelete, resourceInstancePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		delete(releases, mux.Vars(r)["id"])
+		deletions++
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createReleaseTestStep(httpServer.GetPort(), 0, "1.0.0", "release-id-1"),
+			{
+				ResourceName:            releaseDefinition,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{ReleaseFieldTriggers},
+			},
+			createReleaseTestStep(httpServer.GetPort(), 1, "1.0.0", "release-id-1"),
+			createReleaseTestStep(httpServer.GetPort(), 1, "1.1.0", "release-id-2"),
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if creations != 2 || deletions != 2 {
+				return fmt.Errorf("expected 2 creations and 2 deletions but got %d creations and %d deletions", creations, deletions)
+			}
+			return nil
+		},
+	})
+}
+
+func createReleaseTestStep(httpPort int, iteration int, version string, expectedID string) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(resourceReleaseDefinitionTemplate, iteration, version), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(releaseDefinition, "id", expectedID),
+			resource.TestCheckResourceAttr(releaseDefinition, ReleaseFieldName, fmt.Sprintf("release %d", iteration)),
+			resource.TestCheckResourceAttr(releaseDefinition, ReleaseFieldStart, "1700000000000"),
+			resource.TestCheckResourceAttr(releaseDefinition, "application.0.name", "application-name"),
+			resource.TestCheckResourceAttr(releaseDefinition, "service.0.name", "service-name"),
+			resource.TestCheckResourceAttr(releaseDefinition, "service.0.scoped_to_application.0.name", "application-name"),
+			resource.TestCheckResourceAttr(releaseDefinition, "triggers.version", version),
+		),
+	}
+}
+
+type releaseUnitTest struct{}
+
+func (ut *releaseUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	schemaMap := NewReleaseResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 5)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ReleaseFieldName)
+	require.Equal(t, schema.TypeInt, schemaMap[ReleaseFieldStart].Type)
+	require.True(t, schemaMap[ReleaseFieldStart].Required)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(ReleaseFieldApplication)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(ReleaseFieldService)
+	require.Equal(t, schema.TypeMap, schemaMap[ReleaseFieldTriggers].Type)
+	require.True(t, schemaMap[ReleaseFieldTriggers].ForceNew)
+}
+
+func (ut *releaseUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_release", NewReleaseResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *releaseUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewReleaseResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *releaseUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewReleaseResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *releaseUnitTest) createTestRelease() *restapi.Release {
+	return &restapi.Release{
+		ID:    "release-id",
+		Name:  "release-name",
+		Start: 1700000000000,
+		Applications: []restapi.ReleaseApplication{
+			{Name: "application-name-1"},
+		},
+		Services: []restapi.ReleaseService{
+			{
+				Name: "service-name",
+				ScopedTo: &restapi.ReleaseServiceScopedTo{
+					Applications: []restapi.ReleaseApplication{{Name: "application-name-2"}},
+				},
+			},
+		},
+	}
+}
+
+func (ut *releaseUnitTest) shouldUpdateResourceState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Release](t)
+	resourceHandle := NewReleaseResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	err := resourceHandle.UpdateState(resourceData, ut.createTestRelease())
+
+	require.NoError(t, err)
+	require.Equal(t, "release-id", resourceData.Id())
+	require.Equal(t, "release-name", resourceData.Get(ReleaseFieldName))
+	require.Equal(t, 1700000000000, resourceData.Get(ReleaseFieldStart))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{ReleaseFieldName: "application-name-1"},
+	}, resourceData.Get(ReleaseFieldApplication))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			ReleaseFieldName: "service-name",
+			ReleaseFieldScopedToApplication: []interface{}{
+				map[string]interface{}{ReleaseFieldName: "application-name-2"},
+			},
+		},
+	}, resourceData.Get(ReleaseFieldService))
+}
+
+func (ut *releaseUnitTest) shouldMapStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Release](t)
+	resourceHandle := NewReleaseResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("release-id")
+	setValueOnResourceData(t, resourceData, ReleaseFieldName, "release-name")
+	setValueOnResourceData(t, resourceData, ReleaseFieldStart, 1700000000000)
+	setValueOnResourceData(t, resourceData, ReleaseFieldApplication, []interface{}{
+		map[string]interface{}{ReleaseFieldName: "application-name-1"},
+	})
+	setValueOnResourceData(t, resourceData, ReleaseFieldService, []interface{}{
+		map[string]interface{}{
+			ReleaseFieldName: "service-name",
+			ReleaseFieldScopedToApplication: []interface{}{
+				map[string]interface{}{ReleaseFieldName: "application-name-2"},
+			},
+		},
+	})
+	setValueOnResourceData(t, resourceData, ReleaseFieldTriggers, map[string]interface{}{"version": "1.0.0"})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, ut.createTestRelease(), result)
+}
+
+func (ut *releaseUnitTest) shouldMapStateToDataModelWithoutScopes(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Release](t)
+	resourceHandle := NewReleaseResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, ReleaseFieldName, "release-name")
+	setValueOnResourceData(t, resourceData, ReleaseFieldStart, 1700000000000)
+	setValueOnResourceData(t, resourceData, ReleaseFieldService, []interface{}{
+		map[string]interface{}{ReleaseFieldName: "service-name"},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.Release{
+		Name:         "release-name",
+		Start:        1700000000000,
+		Applications: []restapi.ReleaseApplication{},
+		Services:     []restapi.ReleaseService{{Name: "service-name"}},
+	}, result)
+}
+
+func (ut *releaseUnitTest) shouldSendApplicationsAndServicesByNameOnly(t *testing.T) {
+	data, err := json.Marshal(ut.createTestRelease())
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"id": "release-id",
+		"name": "release-name",
+		"start": 1700000000000,
+		"applications": [{"name": "application-name-1"}],
+		"services": [{"name": "service-name", "scopedTo": {"applications": [{"name": "application-name-2"}]}}]
+	}`, string(data))
+}
@@ -0,0 +1,178 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaServiceConfig the name of the terraform-provider-instana resource to manage service mapping rules
+const ResourceInstanaServiceConfig = "instana_service_config"
+
+const (
+	//ServiceConfigFieldName constant value for the schema field name
+	ServiceConfigFieldName = "name"
+	//ServiceConfigFieldComment constant value for the schema field comment
+	ServiceConfigFieldComment = "comment"
+	//ServiceConfigFieldLabel constant value for the schema field label
+	ServiceConfigFieldLabel = "label"
+	//ServiceConfigFieldEnabled constant value for the schema field enabled
+	ServiceConfigFieldEnabled = "enabled"
+	//ServiceConfigFieldMatchSpecification constant value for the schema field match_specification
+	ServiceConfigFieldMatchSpecification = "match_specification"
+	//ServiceConfigFieldMatchSpecificationKey constant value for the schema field match_specification.key
+	ServiceConfigFieldMatchSpecificationKey = "key"
+	//ServiceConfigFieldMatchSpecificationValue constant value for the schema field match_specification.value
+	ServiceConfigFieldMatchSpecificationValue = "value"
+	//ServiceConfigFieldPosition constant value for the schema field position
+	ServiceConfigFieldPosition = "position"
+)
+
+// NewServiceConfigResourceHandle creates the resource handle for service mapping rules
+func NewServiceConfigResourceHandle() ResourceHandle[*restapi.ServiceConfig] {
+	return &serviceConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaServiceConfig,
+			Schema: map[string]*schema.Schema{
+				ServiceConfigFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The name of the service mapping rule",
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				ServiceConfigFieldComment: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "A comment describing the service mapping rule",
+					ValidateFunc: validation.StringLenBetween(0, 2048),
+				},
+				ServiceConfigFieldLabel: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The label of the services created by the rule. Tags can be referenced using curly braces, e.g. {docker.container.name}",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				ServiceConfigFieldEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Flag to indicate whether the service mapping rule is enabled",
+				},
+				ServiceConfigFieldMatchSpecification: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MinItems:    0,
+					MaxItems:    20,
+					Description: "The tags which must match to apply the rule",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							ServiceConfigFieldMatchSpecificationKey: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The key of the tag",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							ServiceConfigFieldMatchSpecificationValue: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The regular expression the value of the tag must match",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+				ServiceConfigFieldPosition: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The position of the rule in the ordered list of all service mapping rules starting with 1. Rules are evaluated in this order. The position must not be greater than the number of rules. The position is only managed when it is configured",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type serviceConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *serviceConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *serviceConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *serviceConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.ServiceConfig] {
+	return api.ServiceConfigs()
+}
+
+func (r *serviceConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *serviceConfigResource) UpdateState(d *schema.ResourceData, config *restapi.ServiceConfig) error {
+	comment := ""
+	if config.Comment != nil {
+		comment = *config.Comment
+	}
+	matchSpecification := make([]interface{}, len(config.MatchSpecification))
+	for i, rule := range config.MatchSpecification {
+		matchSpecification[i] = map[string]interface{}{
+			ServiceConfigFieldMatchSpecificationKey:   rule.Key,
+			ServiceConfigFieldMatchSpecificationValue: rule.Value,
+		}
+	}
+	data := map[string]interface{}{
+		ServiceConfigFieldName:               config.Name,
+		ServiceConfigFieldComment:            comment,
+		ServiceConfigFieldLabel:              config.Label,
+		ServiceConfigFieldEnabled:            config.Enabled,
+		ServiceConfigFieldMatchSpecification: matchSpecification,
+	}
+	//the position is only stored when it is managed by terraform as the position changes whenever other rules are
+	//added, removed or moved
+	if config.Position != nil && d.Get(ServiceConfigFieldPosition).(int) > 0 {
+		data[ServiceConfigFieldPosition] = *config.Position + 1
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, data)
+}
+
+// MapStateToDataObject maps the state to the service config. The position of the terraform resource starts with 1
+// while the position of the API model is the index in the ordered list of service configs.
+func (r *serviceConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.ServiceConfig, error) {
+	var comment *string
+	if value, ok := d.GetOk(ServiceConfigFieldComment); ok {
+		commentString := value.(string)
+		comment = &commentString
+	}
+	var position *int
+	if value, ok := d.GetOk(ServiceConfigFieldPosition); ok {
+		index := value.(int) - 1
+		position = &index
+	}
+	matchSpecificationData := d.Get(ServiceConfigFieldMatchSpecification).([]interface{})
+	matchSpecification := make([]restapi.ServiceMatchingRule, len(matchSpecificationData))
+	for i, v := range matchSpecificationData {
+		rule := v.(map[string]interface{})
+		matchSpecification[i] = restapi.ServiceMatchingRule{
+			Key:   rule[ServiceConfigFieldMatchSpecificationKey].(string),
+			Value: rule[ServiceConfigFieldMatchSpecificationValue].(string),
+		}
+	}
+	return &restapi.ServiceConfig{
+		ID:                 d.Id(),
+		Name:               d.Get(ServiceConfigFieldName).(string),
+		Comment:            comment,
+		Label:              d.Get(ServiceConfigFieldLabel).(string),
+		Enabled:            d.Get(ServiceConfigFieldEnabled).(bool),
+		MatchSpecification: matchSpecification,
+		Position:           position,
+	}, nil
+}
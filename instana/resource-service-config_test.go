@@ -0,0 +1,322 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestServiceConfigResource(t *testing.T) {
+	ut := &serviceConfigUnitTest{}
+	t.Run("CRUD integration test", serviceConfigIntegrationTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should update resource state", ut.shouldUpdateResourceState)
+	t.Run("should not update position in resource state when position is not managed", ut.shouldNotUpdatePositionInResourceStateWhenPositionIsNotManaged)
+	t.Run("should map state to data model", ut.shouldMapStateToDataModel)
+	t.Run("should map state to data model without position", ut.shouldMapStateToDataModelWithoutPosition)
+}
+
+const (
+	serviceConfigDefinition = "instana_service_config.example"
+	serviceConfigID         = "service-config-id"
+)
+
+const resourceServiceConfigDefinitionTemplate = `
+resource "instana_service_config" "example" {
+  name     = "name %d"
+  comment  = "comment"
+  label    = "{gce.zone}-{jvm.args.abc}"
+  %s
+
+  match_specification {
+    key   = "gce.zone"
+    value = ".*"
+  }
+}
+`
+
+type serviceConfigTestServer struct {
+	httpServer testutils.TestHTTPServer
+	mutex      sync.Mutex
+	configs    []*restapi.ServiceConfig
+}
+
+func newServiceConfigTestServer() *serviceConfigTestServer {
+	server := &serviceConfigTestServer{
+		httpServer: testutils.NewTestHTTPServer(),
+		configs: []*restapi.ServiceConfig{
+			{ID: "other-1", Name: "other-1", Label: "{docker.container.name}", Enabled: true, MatchSpecification: []restapi.ServiceMatchingRule{}},
+			{ID: "other-2", Name: "other-2", Label: "{docker.container.name}", Enabled: true, MatchSpecification: []restapi.ServiceMatchingRule{}},
+		},
+	}
+	resourceInstancePath := restapi.ServiceConfigResourcePath + "/{id}"
+	server.httpServer.AddRoute(http.MethodGet, restapi.ServiceConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		server.writeJSON(w, server.configs)
+	})
+	server.httpServer.AddRoute(http.MethodPost, restapi.ServiceConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		config := &restapi.ServiceConfig{}
+		if err := json.NewDecoder(r.Body).Decode(config); err != nil {
+			server.httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		config.ID = serviceConfigID
+		server.configs = append(server.configs, config)
+		server.writeJSON(w, config)
+	})
+	server.httpServer.AddRoute(http.MethodPut, restapi.ServiceConfigOrderResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		ids := make([]string, 0)
+		if err := json.NewDecoder(r.Body).Decode(&ids); err != nil {
+			server.httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		ordered := make([]*restapi.ServiceConfig, len(ids))
+		for i, id := range ids {
+			ordered[i] = server.configs[server.indexOf(id)]
+		}
+		server.configs = ordered
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server.httpServer.AddRoute(http.MethodPut, resourceInstancePath, func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		config := &restapi.ServiceConfig{}
+		if err := json.NewDecoder(r.Body).Decode(config); err != nil {
+			server.httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		config.ID = mux.Vars(r)["id"]
+		server.configs[server.indexOf(config.ID)] = config
+		server.writeJSON(w, config)
+	})
+	server.httpServer.AddRoute(http.MethodDelete, resourceInstancePath, func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		index := server.indexOf(mux.Vars(r)["id"])
+		server.configs = append(server.configs[:index], server.configs[index+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return server
+}
+
+func (s *serviceConfigTestServer) indexOf(id string) int {
+	for i, config := range s.configs {
+		if config.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *serviceConfigTestServer) writeJSON(w http.ResponseWriter, data interface{}) {
+	response, err := json.Marshal(data)
+	if err != nil {
+		s.httpServer.WriteInternalServerError(w, err)
+		return
+	}
+	s.httpServer.WriteJSONResponse(w, response)
+}
+
+func (s *serviceConfigTestServer) verifyOrder(expectedIDs ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		ids := make([]string, len(s.configs))
+		for i, config := range s.configs {
+			ids[i] = config.ID
+		}
+		if fmt.Sprint(ids) != fmt.Sprint(expectedIDs) {
+			return fmt.Errorf("expected order %v but got %v", expectedIDs, ids)
+		}
+		return nil
+	}
+}
+
+func serviceConfigIntegrationTest(t *testing.T) {
+	server := newServiceConfigTestServer()
+	server.httpServer.Start()
+	defer server.httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createServiceConfigTestStep(server, 0, 1, serviceConfigID, "other-1", "other-2"),
+			testStepImportServiceConfig(),
+			createServiceConfigTestStep(server, 1, 3, "other-1", "other-2", serviceConfigID),
+			testStepImportServiceConfig(),
+			createServiceConfigTestStep(server, 2, 0, "other-1", "other-2", serviceConfigID),
+			testStepImportServiceConfig(),
+		},
+		CheckDestroy: func(state *terraform.State) error {
+			return server.verifyOrder("other-1", "other-2")(state)
+		},
+	})
+}
+
+// testStepImportServiceConfig the position is not imported as it is only managed when it is configured
+func testStepImportServiceConfig() resource.TestStep {
+	step := testStepImportWithCustomID(serviceConfigDefinition, serviceConfigID)
+	step.ImportStateVerifyIgnore = []string{ServiceConfigFieldPosition}
+	return step
+}
+
+// createServiceConfigTestStep creates the test step for the given iteration. The position is not configured and
+// therefore not stored in the state when it is 0
+func createServiceConfigTestStep(server *serviceConfigTestServer, iteration int, position int, expectedOrder ...string) resource.TestStep {
+	positionConfig := ""
+	if position > 0 {
+		positionConfig = fmt.Sprintf("position = %d", position)
+	}
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(resourceServiceConfigDefinitionTemplate, iteration, positionConfig), server.httpServer.GetPort()),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(serviceConfigDefinition, "id", serviceConfigID),
+			resource.TestCheckResourceAttr(serviceConfigDefinition, ServiceConfigFieldName, fmt.Sprintf("name %d", iteration)),
+			resource.TestCheckResourceAttr(serviceConfigDefinition, ServiceConfigFieldComment, "comment"),
+			resource.TestCheckResourceAttr(serviceConfigDefinition, ServiceConfigFieldLabel, "{gce.zone}-{jvm.args.abc}"),
+			resource.TestCheckResourceAttr(serviceConfigDefinition, ServiceConfigFieldEnabled, "true"),
+			resource.TestCheckResourceAttr(serviceConfigDefinition, "match_specification.0.key", "gce.zone"),
+			resource.TestCheckResourceAttr(serviceConfigDefinition, "match_specification.0.value", ".*"),
+			resource.TestCheckResourceAttr(serviceConfigDefinition, ServiceConfigFieldPosition, fmt.Sprintf("%d", position)),
+			server.verifyOrder(expectedOrder...),
+		),
+	}
+}
+
+type serviceConfigUnitTest struct{}
+
+func (ut *serviceConfigUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	schemaMap := NewServiceConfigResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 6)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ServiceConfigFieldName)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ServiceConfigFieldComment)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ServiceConfigFieldLabel)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(ServiceConfigFieldEnabled, true)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(ServiceConfigFieldMatchSpecification)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(ServiceConfigFieldPosition)
+	require.False(t, schemaMap[ServiceConfigFieldPosition].Computed)
+
+	matchSpecificationSchemaAssert := testutils.NewTerraformSchemaAssert(schemaMap[ServiceConfigFieldMatchSpecification].Elem.(*schema.Resource).Schema, t)
+	matchSpecificationSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(ServiceConfigFieldMatchSpecificationKey)
+	matchSpecificationSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(ServiceConfigFieldMatchSpecificationValue)
+}
+
+func (ut *serviceConfigUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_service_config", NewServiceConfigResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *serviceConfigUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewServiceConfigResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *serviceConfigUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewServiceConfigResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *serviceConfigUnitTest) createTestServiceConfig(position *int) *restapi.ServiceConfig {
+	comment := "comment"
+	return &restapi.ServiceConfig{
+		ID:                 serviceConfigID,
+		Name:               "name",
+		Comment:            &comment,
+		Label:              "{gce.zone}",
+		Enabled:            true,
+		MatchSpecification: []restapi.ServiceMatchingRule{{Key: "gce.zone", Value: ".*"}},
+		Position:           position,
+	}
+}
+
+func (ut *serviceConfigUnitTest) shouldUpdateResourceState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ServiceConfig](t)
+	resourceHandle := NewServiceConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, ServiceConfigFieldPosition, 1)
+	position := 2
+
+	err := resourceHandle.UpdateState(resourceData, ut.createTestServiceConfig(&position))
+
+	require.NoError(t, err)
+	require.Equal(t, serviceConfigID, resourceData.Id())
+	require.Equal(t, "name", resourceData.Get(ServiceConfigFieldName))
+	require.Equal(t, "comment", resourceData.Get(ServiceConfigFieldComment))
+	require.Equal(t, "{gce.zone}", resourceData.Get(ServiceConfigFieldLabel))
+	require.True(t, resourceData.Get(ServiceConfigFieldEnabled).(bool))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{ServiceConfigFieldMatchSpecificationKey: "gce.zone", ServiceConfigFieldMatchSpecificationValue: ".*"},
+	}, resourceData.Get(ServiceConfigFieldMatchSpecification))
+	require.Equal(t, 3, resourceData.Get(ServiceConfigFieldPosition))
+}
+
+func (ut *serviceConfigUnitTest) shouldNotUpdatePositionInResourceStateWhenPositionIsNotManaged(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ServiceConfig](t)
+	resourceHandle := NewServiceConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	position := 2
+
+	err := resourceHandle.UpdateState(resourceData, ut.createTestServiceConfig(&position))
+
+	require.NoError(t, err)
+	_, ok := resourceData.GetOk(ServiceConfigFieldPosition)
+	require.False(t, ok)
+}
+
+func (ut *serviceConfigUnitTest) shouldMapStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ServiceConfig](t)
+	resourceHandle := NewServiceConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(serviceConfigID)
+	setValueOnResourceData(t, resourceData, ServiceConfigFieldName, "name")
+	setValueOnResourceData(t, resourceData, ServiceConfigFieldComment, "comment")
+	setValueOnResourceData(t, resourceData, ServiceConfigFieldLabel, "{gce.zone}")
+	setValueOnResourceData(t, resourceData, ServiceConfigFieldMatchSpecification, []interface{}{
+		map[string]interface{}{ServiceConfigFieldMatchSpecificationKey: "gce.zone", ServiceConfigFieldMatchSpecificationValue: ".*"},
+	})
+	setValueOnResourceData(t, resourceData, ServiceConfigFieldPosition, 1)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	position := 0
+	require.Equal(t, ut.createTestServiceConfig(&position), result)
+}
+
+func (ut *serviceConfigUnitTest) shouldMapStateToDataModelWithoutPosition(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ServiceConfig](t)
+	resourceHandle := NewServiceConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, ServiceConfigFieldName, "name")
+	setValueOnResourceData(t, resourceData, ServiceConfigFieldLabel, "{gce.zone}")
+	setValueOnResourceData(t, resourceData, ServiceConfigFieldEnabled, false)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.ServiceConfig{
+		Name:               "name",
+		Label:              "{gce.zone}",
+		Enabled:            false,
+		MatchSpecification: []restapi.ServiceMatchingRule{},
+	}, result)
+}
@@ -0,0 +1,78 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaSessionSettings the name of the terraform-provider-instana resource to manage the session settings
+const ResourceInstanaSessionSettings = "instana_session_settings"
+
+const (
+	//SessionSettingsFieldIdleTime constant value for the schema field idle_time
+	SessionSettingsFieldIdleTime = "idle_time"
+	//SessionSettingsFieldTokenLife constant value for the schema field token_life
+	SessionSettingsFieldTokenLife = "token_life"
+)
+
+// NewSessionSettingsResourceHandle creates the resource handle for the session settings
+func NewSessionSettingsResourceHandle() ResourceHandle[*restapi.SessionSettings] {
+	return &sessionSettingsResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaSessionSettings,
+			Schema: map[string]*schema.Schema{
+				SessionSettingsFieldIdleTime: {
+					Type:         schema.TypeInt,
+					Required:     true,
+					Description:  "The time in milliseconds after which an inactive session expires (1 minute to 8 hours)",
+					ValidateFunc: validation.IntBetween(60000, 28800000),
+				},
+				SessionSettingsFieldTokenLife: {
+					Type:         schema.TypeInt,
+					Required:     true,
+					Description:  "The maximum lifetime of a session in milliseconds independent of the activity of the user (15 minutes to 7 days)",
+					ValidateFunc: validation.IntBetween(900000, 604800000),
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type sessionSettingsResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *sessionSettingsResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *sessionSettingsResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *sessionSettingsResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.SessionSettings] {
+	return api.SessionSettings()
+}
+
+func (r *sessionSettingsResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *sessionSettingsResource) UpdateState(d *schema.ResourceData, settings *restapi.SessionSettings) error {
+	d.SetId(restapi.SessionSettingsID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		SessionSettingsFieldIdleTime:  settings.IdleTime,
+		SessionSettingsFieldTokenLife: settings.TokenLife,
+	})
+}
+
+func (r *sessionSettingsResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.SessionSettings, error) {
+	return &restapi.SessionSettings{
+		IdleTime:  int64(d.Get(SessionSettingsFieldIdleTime).(int)),
+		TokenLife: int64(d.Get(SessionSettingsFieldTokenLife).(int)),
+	}, nil
+}
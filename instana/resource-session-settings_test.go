@@ -0,0 +1,172 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestSessionSettingsResource(t *testing.T) {
+	ut := &sessionSettingsUnitTest{}
+	t.Run("CRUD integration test", sessionSettingsIntegrationTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should reject durations out of the supported ranges", ut.shouldRejectDurationsOutOfTheSupportedRanges)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should update resource state", ut.shouldUpdateResourceState)
+	t.Run("should map state to data model", ut.shouldMapStateToDataModel)
+}
+
+const sessionSettingsDefinition = "instana_session_settings.example"
+
+const resourceSessionSettingsDefinitionTemplate = `
+resource "instana_session_settings" "example" {
+  idle_time  = %d
+  token_life = %d
+}
+`
+
+var sessionSettingsTestDefaults = restapi.SessionSettings{IdleTime: 7200000, TokenLife: 86400000}
+
+func sessionSettingsIntegrationTest(t *testing.T) {
+	var mutex sync.Mutex
+	settings := sessionSettingsTestDefaults
+	httpServer := testutils.NewTestHTTPServer()
+	writeSettings := func(w http.ResponseWriter) {
+		response, err := json.Marshal(settings)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		httpServer.WriteJSONResponse(w, response)
+	}
+	httpServer.AddRoute(http.MethodGet, restapi.SessionSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		writeSettings(w)
+	})
+	httpServer.AddRoute(http.MethodPut, restapi.SessionSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		updated := restapi.SessionSettings{}
+		if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		settings = updated
+		writeSettings(w)
+	})
+	httpServer.AddRoute(http.MethodDelete, restapi.SessionSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		settings = sessionSettingsTestDefaults
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createSessionSettingsTestStep(httpServer.GetPort(), 1800000, 43200000),
+			testStepImportWithCustomID(sessionSettingsDefinition, restapi.SessionSettingsID),
+			createSessionSettingsTestStep(httpServer.GetPort(), 3600000, 28800000),
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if settings != sessionSettingsTestDefaults {
+				return fmt.Errorf("expected session settings to be reset to defaults but got %+v", settings)
+			}
+			return nil
+		},
+	})
+}
+
+func createSessionSettingsTestStep(httpPort int, idleTime int64, tokenLife int64) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(resourceSessionSettingsDefinitionTemplate, idleTime, tokenLife), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(sessionSettingsDefinition, "id", restapi.SessionSettingsID),
+			resource.TestCheckResourceAttr(sessionSettingsDefinition, SessionSettingsFieldIdleTime, fmt.Sprintf("%d", idleTime)),
+			resource.TestCheckResourceAttr(sessionSettingsDefinition, SessionSettingsFieldTokenLife, fmt.Sprintf("%d", tokenLife)),
+		),
+	}
+}
+
+type sessionSettingsUnitTest struct{}
+
+func (ut *sessionSettingsUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	schemaMap := NewSessionSettingsResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 2)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeInt(SessionSettingsFieldIdleTime)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeInt(SessionSettingsFieldTokenLife)
+}
+
+func (ut *sessionSettingsUnitTest) shouldRejectDurationsOutOfTheSupportedRanges(t *testing.T) {
+	schemaMap := NewSessionSettingsResourceHandle().MetaData().Schema
+	for field, values := range map[string][]int{
+		SessionSettingsFieldIdleTime:  {59999, 28800001},
+		SessionSettingsFieldTokenLife: {899999, 604800001},
+	} {
+		for _, value := range values {
+			_, errs := schemaMap[field].ValidateFunc(value, field)
+			require.NotEmpty(t, errs, "expected %d to be rejected for %s", value, field)
+		}
+	}
+	for field, value := range map[string]int{SessionSettingsFieldIdleTime: 60000, SessionSettingsFieldTokenLife: 604800000} {
+		_, errs := schemaMap[field].ValidateFunc(value, field)
+		require.Empty(t, errs)
+	}
+}
+
+func (ut *sessionSettingsUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_session_settings", NewSessionSettingsResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *sessionSettingsUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewSessionSettingsResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *sessionSettingsUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewSessionSettingsResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *sessionSettingsUnitTest) shouldUpdateResourceState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SessionSettings](t)
+	resourceHandle := NewSessionSettingsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.SessionSettings{IdleTime: 1800000, TokenLife: 43200000})
+
+	require.NoError(t, err)
+	require.Equal(t, restapi.SessionSettingsID, resourceData.Id())
+	require.Equal(t, 1800000, resourceData.Get(SessionSettingsFieldIdleTime))
+	require.Equal(t, 43200000, resourceData.Get(SessionSettingsFieldTokenLife))
+}
+
+func (ut *sessionSettingsUnitTest) shouldMapStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SessionSettings](t)
+	resourceHandle := NewSessionSettingsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, SessionSettingsFieldIdleTime, 1800000)
+	setValueOnResourceData(t, resourceData, SessionSettingsFieldTokenLife, 43200000)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.SessionSettings{IdleTime: 1800000, TokenLife: 43200000}, result)
+}
@@ -0,0 +1,177 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaSyntheticCallsSettings the name of the terraform-provider-instana resource to manage the synthetic
+// calls settings
+const ResourceInstanaSyntheticCallsSettings = "instana_synthetic_calls_settings"
+
+const (
+	//SyntheticCallsSettingsFieldRule constant value for the schema field rule
+	SyntheticCallsSettingsFieldRule = "rule"
+	//SyntheticCallsSettingsFieldRuleName constant value for the schema field rule.name
+	SyntheticCallsSettingsFieldRuleName = "name"
+	//SyntheticCallsSettingsFieldRuleDescription constant value for the schema field rule.description
+	SyntheticCallsSettingsFieldRuleDescription = "description"
+	//SyntheticCallsSettingsFieldRuleEnabled constant value for the schema field rule.enabled
+	SyntheticCallsSettingsFieldRuleEnabled = "enabled"
+	//SyntheticCallsSettingsFieldRuleMatchSpecification constant value for the schema field rule.match_specification
+	SyntheticCallsSettingsFieldRuleMatchSpecification = "match_specification"
+	//SyntheticCallsSettingsFieldDefaultRulesEnabled constant value for the schema field default_rules_enabled
+	SyntheticCallsSettingsFieldDefaultRulesEnabled = "default_rules_enabled"
+)
+
+// NewSyntheticCallsSettingsResourceHandle creates the resource handle for the synthetic calls settings
+func NewSyntheticCallsSettingsResourceHandle() ResourceHandle[*restapi.SyntheticCallsSettings] {
+	return &syntheticCallsSettingsResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaSyntheticCallsSettings,
+			Schema: map[string]*schema.Schema{
+				SyntheticCallsSettingsFieldRule: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The custom rules which decide whether a call is classified as synthetic call",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SyntheticCallsSettingsFieldRuleName: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The name of the rule",
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+							SyntheticCallsSettingsFieldRuleDescription: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "The description of the rule",
+								ValidateFunc: validation.StringLenBetween(0, 2048),
+							},
+							SyntheticCallsSettingsFieldRuleEnabled: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     true,
+								Description: "Flag to indicate whether the rule is enabled",
+							},
+							SyntheticCallsSettingsFieldRuleMatchSpecification: {
+								Type:             schema.TypeString,
+								Required:         true,
+								Description:      "The tag filter expression of the calls which are classified as synthetic calls",
+								DiffSuppressFunc: tagFilterDiffSuppressFunc,
+								StateFunc:        tagFilterStateFunc,
+								ValidateFunc:     tagFilterValidateFunc,
+							},
+						},
+					},
+				},
+				SyntheticCallsSettingsFieldDefaultRulesEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Computed:    true,
+					Description: "Flag to indicate whether the default rules provided by Instana are enabled. The current value of Instana is kept when not configured",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type syntheticCallsSettingsResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *syntheticCallsSettingsResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *syntheticCallsSettingsResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *syntheticCallsSettingsResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.SyntheticCallsSettings] {
+	return api.SyntheticCallsSettings()
+}
+
+func (r *syntheticCallsSettingsResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *syntheticCallsSettingsResource) UpdateState(d *schema.ResourceData, settings *restapi.SyntheticCallsSettings) error {
+	rules := make([]interface{}, len(settings.CustomRules))
+	for i, rule := range settings.CustomRules {
+		ruleData := map[string]interface{}{
+			SyntheticCallsSettingsFieldRuleName:        rule.Name,
+			SyntheticCallsSettingsFieldRuleDescription: rule.Description,
+			SyntheticCallsSettingsFieldRuleEnabled:     rule.Enabled,
+		}
+		if rule.MatchSpecification != nil {
+			normalizedTagFilterString, err := tagfilter.MapTagFilterToNormalizedString(rule.MatchSpecification)
+			if err != nil {
+				return err
+			}
+			ruleData[SyntheticCallsSettingsFieldRuleMatchSpecification] = normalizedTagFilterString
+		}
+		rules[i] = ruleData
+	}
+
+	data := map[string]interface{}{
+		SyntheticCallsSettingsFieldRule: rules,
+	}
+	if settings.DefaultRulesEnabled != nil {
+		data[SyntheticCallsSettingsFieldDefaultRulesEnabled] = *settings.DefaultRulesEnabled
+	}
+
+	d.SetId(restapi.SyntheticCallsSettingsID)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *syntheticCallsSettingsResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.SyntheticCallsSettings, error) {
+	rulesData := d.Get(SyntheticCallsSettingsFieldRule).([]interface{})
+	rules := make([]restapi.SyntheticCallRule, len(rulesData))
+	for i, ruleData := range rulesData {
+		rule := ruleData.(map[string]interface{})
+		matchSpecification, err := r.mapTagFilterStringToAPIModel(rule[SyntheticCallsSettingsFieldRuleMatchSpecification].(string))
+		if err != nil {
+			return nil, err
+		}
+		rules[i] = restapi.SyntheticCallRule{
+			Name:               rule[SyntheticCallsSettingsFieldRuleName].(string),
+			Description:        rule[SyntheticCallsSettingsFieldRuleDescription].(string),
+			Enabled:            rule[SyntheticCallsSettingsFieldRuleEnabled].(bool),
+			MatchSpecification: matchSpecification,
+		}
+	}
+	return &restapi.SyntheticCallsSettings{
+		CustomRules:         rules,
+		DefaultRulesEnabled: r.mapDefaultRulesEnabledFromState(d),
+	}, nil
+}
+
+// mapDefaultRulesEnabledFromState returns the configured flag or the value of the state when the flag is not
+// configured. The flag is not provided when it is neither configured nor known from the state so that the current value
+// of Instana is kept on creation.
+func (r *syntheticCallsSettingsResource) mapDefaultRulesEnabledFromState(d *schema.ResourceData) *bool {
+	config := d.GetRawConfig()
+	configured := !config.IsNull() && !config.GetAttr(SyntheticCallsSettingsFieldDefaultRulesEnabled).IsNull()
+	if !configured && d.Id() == "" {
+		return nil
+	}
+	value := d.Get(SyntheticCallsSettingsFieldDefaultRulesEnabled).(bool)
+	return &value
+}
+
+func (r *syntheticCallsSettingsResource) mapTagFilterStringToAPIModel(input string) (*restapi.TagFilter, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return mapper.ToAPIModel(expr), nil
+}
@@ -0,0 +1,307 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+func TestSyntheticCallsSettingsResource(t *testing.T) {
+	ut := &syntheticCallsSettingsUnitTest{}
+	t.Run("CRUD integration test", syntheticCallsSettingsIntegrationTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should update resource state", ut.shouldUpdateResourceState)
+	t.Run("should update resource state without rules", ut.shouldUpdateResourceStateWithoutRules)
+	t.Run("should validate length of rule name and description", ut.shouldValidateLengthOfRuleNameAndDescription)
+	t.Run("should map state to data model", ut.shouldMapStateToDataModel)
+	t.Run("should not map default rules enabled flag to data model when not configured on creation", ut.shouldNotMapDefaultRulesEnabledFlagToDataModelWhenNotConfiguredOnCreation)
+	t.Run("should map default rules enabled flag of state to data model", ut.shouldMapDefaultRulesEnabledFlagOfStateToDataModel)
+	t.Run("should fail to map state to data model when match specification is not valid", ut.shouldFailToMapStateToDataModelWhenMatchSpecificationIsNotValid)
+}
+
+const (
+	syntheticCallsSettingsDefinition         = "instana_synthetic_calls_settings.example"
+	syntheticCallsSettingsMatchSpecification = "call.http.path@dest EQUALS '/health'"
+)
+
+const resourceSyntheticCallsSettingsDefinitionTemplate = `
+resource "instana_synthetic_calls_settings" "example" {
+  rule {
+    name                = "health-checks"
+    description         = "%s"
+    match_specification = "call.http.path EQUALS '/health'"
+  }
+
+  rule {
+    name                = "load-balancer-probes"
+    enabled             = %t
+    match_specification = "call.http.header:user-agent@dest EQUALS 'ELB-HealthChecker/2.0'"
+  }
+%s}
+`
+
+func syntheticCallsSettingsIntegrationTest(t *testing.T) {
+	var mutex sync.Mutex
+	defaultSettings := func() restapi.SyntheticCallsSettings {
+		defaultRulesEnabled := true
+		return restapi.SyntheticCallsSettings{CustomRules: []restapi.SyntheticCallRule{}, DefaultRulesEnabled: &defaultRulesEnabled}
+	}
+	settings := defaultSettings()
+	httpServer := testutils.NewTestHTTPServer()
+	writeSettings := func(w http.ResponseWriter) {
+		response, err := json.Marshal(settings)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		httpServer.WriteJSONResponse(w, response)
+	}
+	httpServer.AddRoute(http.MethodGet, restapi.SyntheticCallsSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		writeSettings(w)
+	})
+	httpServer.AddRoute(http.MethodPut, restapi.SyntheticCallsSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		updated := restapi.SyntheticCallsSettings{}
+		if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		if updated.DefaultRulesEnabled == nil {
+			updated.DefaultRulesEnabled = settings.DefaultRulesEnabled
+		}
+		settings = updated
+		writeSettings(w)
+	})
+	httpServer.AddRoute(http.MethodDelete, restapi.SyntheticCallsSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		settings = defaultSettings()
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createSyntheticCallsSettingsTestStep(httpServer.GetPort(), "description 1", true, nil),
+			testStepImportWithCustomID(syntheticCallsSettingsDefinition, restapi.SyntheticCallsSettingsID),
+			createSyntheticCallsSettingsTestStep(httpServer.GetPort(), "description 2", false, utils.BoolPtr(false)),
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if len(settings.CustomRules) != 0 {
+				return fmt.Errorf("expected synthetic calls settings to be reset but got %d custom rules", len(settings.CustomRules))
+			}
+			if !*settings.DefaultRulesEnabled {
+				return fmt.Errorf("expected default rules of synthetic calls settings to be enabled after reset")
+			}
+			return nil
+		},
+	})
+}
+
+func createSyntheticCallsSettingsTestStep(httpPort int, description string, enabled bool, defaultRulesEnabled *bool) resource.TestStep {
+	ruleName := func(index int, field string) string {
+		return fmt.Sprintf("%s.%d.%s", SyntheticCallsSettingsFieldRule, index, field)
+	}
+	defaultRulesEnabledConfig := ""
+	expectedDefaultRulesEnabled := true
+	if defaultRulesEnabled != nil {
+		defaultRulesEnabledConfig = fmt.Sprintf("\n  default_rules_enabled = %t\n", *defaultRulesEnabled)
+		expectedDefaultRulesEnabled = *defaultRulesEnabled
+	}
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(resourceSyntheticCallsSettingsDefinitionTemplate, description, enabled, defaultRulesEnabledConfig), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(syntheticCallsSettingsDefinition, "id", restapi.SyntheticCallsSettingsID),
+			resource.TestCheckResourceAttr(syntheticCallsSettingsDefinition, SyntheticCallsSettingsFieldDefaultRulesEnabled, fmt.Sprintf("%t", expectedDefaultRulesEnabled)),
+			resource.TestCheckResourceAttr(syntheticCallsSettingsDefinition, SyntheticCallsSettingsFieldRule+".#", "2"),
+			resource.TestCheckResourceAttr(syntheticCallsSettingsDefinition, ruleName(0, SyntheticCallsSettingsFieldRuleName), "health-checks"),
+			resource.TestCheckResourceAttr(syntheticCallsSettingsDefinition, ruleName(0, SyntheticCallsSettingsFieldRuleDescription), description),
+			resource.TestCheckResourceAttr(syntheticCallsSettingsDefinition, ruleName(0, SyntheticCallsSettingsFieldRuleEnabled), "true"),
+			resource.TestCheckResourceAttr(syntheticCallsSettingsDefinition, ruleName(0, SyntheticCallsSettingsFieldRuleMatchSpecification), syntheticCallsSettingsMatchSpecification),
+			resource.TestCheckResourceAttr(syntheticCallsSettingsDefinition, ruleName(1, SyntheticCallsSettingsFieldRuleName), "load-balancer-probes"),
+			resource.TestCheckResourceAttr(syntheticCallsSettingsDefinition, ruleName(1, SyntheticCallsSettingsFieldRuleDescription), ""),
+			resource.TestCheckResourceAttr(syntheticCallsSettingsDefinition, ruleName(1, SyntheticCallsSettingsFieldRuleEnabled), fmt.Sprintf("%t", enabled)),
+			resource.TestCheckResourceAttr(syntheticCallsSettingsDefinition, ruleName(1, SyntheticCallsSettingsFieldRuleMatchSpecification), "call.http.header:'user-agent'@dest EQUALS 'ELB-HealthChecker/2.0'"),
+		),
+	}
+}
+
+type syntheticCallsSettingsUnitTest struct{}
+
+func (ut *syntheticCallsSettingsUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	schemaMap := NewSyntheticCallsSettingsResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 2)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(SyntheticCallsSettingsFieldRule)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeBool(SyntheticCallsSettingsFieldDefaultRulesEnabled)
+	require.True(t, schemaMap[SyntheticCallsSettingsFieldDefaultRulesEnabled].Optional)
+
+	ruleSchemaMap := schemaMap[SyntheticCallsSettingsFieldRule].Elem.(*schema.Resource).Schema
+	ruleSchemaAssert := testutils.NewTerraformSchemaAssert(ruleSchemaMap, t)
+	require.Len(t, ruleSchemaMap, 4)
+	ruleSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticCallsSettingsFieldRuleName)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(SyntheticCallsSettingsFieldRuleDescription)
+	ruleSchemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticCallsSettingsFieldRuleEnabled, true)
+	ruleSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticCallsSettingsFieldRuleMatchSpecification)
+}
+
+func (ut *syntheticCallsSettingsUnitTest) shouldValidateLengthOfRuleNameAndDescription(t *testing.T) {
+	ruleSchemaMap := NewSyntheticCallsSettingsResourceHandle().MetaData().Schema[SyntheticCallsSettingsFieldRule].Elem.(*schema.Resource).Schema
+	validate := func(field string, value string) []error {
+		_, errs := ruleSchemaMap[field].ValidateFunc(value, field)
+		return errs
+	}
+
+	require.Empty(t, validate(SyntheticCallsSettingsFieldRuleName, strings.Repeat("a", 128)))
+	require.NotEmpty(t, validate(SyntheticCallsSettingsFieldRuleName, strings.Repeat("a", 129)))
+	require.NotEmpty(t, validate(SyntheticCallsSettingsFieldRuleName, ""))
+	require.Empty(t, validate(SyntheticCallsSettingsFieldRuleDescription, ""))
+	require.Empty(t, validate(SyntheticCallsSettingsFieldRuleDescription, strings.Repeat("a", 2048)))
+	require.NotEmpty(t, validate(SyntheticCallsSettingsFieldRuleDescription, strings.Repeat("a", 2049)))
+}
+
+func (ut *syntheticCallsSettingsUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_synthetic_calls_settings", NewSyntheticCallsSettingsResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *syntheticCallsSettingsUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewSyntheticCallsSettingsResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *syntheticCallsSettingsUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewSyntheticCallsSettingsResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *syntheticCallsSettingsUnitTest) shouldUpdateResourceState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticCallsSettings](t)
+	resourceHandle := NewSyntheticCallsSettingsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	settings := &restapi.SyntheticCallsSettings{
+		DefaultRulesEnabled: utils.BoolPtr(false),
+		CustomRules: []restapi.SyntheticCallRule{
+			{
+				Name:               "health-checks",
+				Description:        "description",
+				Enabled:            true,
+				MatchSpecification: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "call.http.path", restapi.EqualsOperator, "/health"),
+			},
+		},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, settings)
+
+	require.NoError(t, err)
+	require.Equal(t, restapi.SyntheticCallsSettingsID, resourceData.Id())
+	require.False(t, resourceData.Get(SyntheticCallsSettingsFieldDefaultRulesEnabled).(bool))
+	rules := resourceData.Get(SyntheticCallsSettingsFieldRule).([]interface{})
+	require.Len(t, rules, 1)
+	rule := rules[0].(map[string]interface{})
+	require.Equal(t, "health-checks", rule[SyntheticCallsSettingsFieldRuleName])
+	require.Equal(t, "description", rule[SyntheticCallsSettingsFieldRuleDescription])
+	require.Equal(t, true, rule[SyntheticCallsSettingsFieldRuleEnabled])
+	require.Equal(t, syntheticCallsSettingsMatchSpecification, rule[SyntheticCallsSettingsFieldRuleMatchSpecification])
+}
+
+func (ut *syntheticCallsSettingsUnitTest) shouldUpdateResourceStateWithoutRules(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticCallsSettings](t)
+	resourceHandle := NewSyntheticCallsSettingsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.SyntheticCallsSettings{})
+
+	require.NoError(t, err)
+	require.Equal(t, restapi.SyntheticCallsSettingsID, resourceData.Id())
+	require.Empty(t, resourceData.Get(SyntheticCallsSettingsFieldRule))
+}
+
+func (ut *syntheticCallsSettingsUnitTest) shouldMapStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticCallsSettings](t)
+	resourceHandle := NewSyntheticCallsSettingsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, SyntheticCallsSettingsFieldRule, []interface{}{
+		map[string]interface{}{
+			SyntheticCallsSettingsFieldRuleName:               "health-checks",
+			SyntheticCallsSettingsFieldRuleDescription:        "description",
+			SyntheticCallsSettingsFieldRuleEnabled:            false,
+			SyntheticCallsSettingsFieldRuleMatchSpecification: syntheticCallsSettingsMatchSpecification,
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.SyntheticCallsSettings{
+		CustomRules: []restapi.SyntheticCallRule{
+			{
+				Name:               "health-checks",
+				Description:        "description",
+				Enabled:            false,
+				MatchSpecification: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "call.http.path", restapi.EqualsOperator, "/health"),
+			},
+		},
+	}, result)
+}
+
+func (ut *syntheticCallsSettingsUnitTest) shouldFailToMapStateToDataModelWhenMatchSpecificationIsNotValid(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticCallsSettings](t)
+	resourceHandle := NewSyntheticCallsSettingsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, SyntheticCallsSettingsFieldRule, []interface{}{
+		map[string]interface{}{
+			SyntheticCallsSettingsFieldRuleName:               "health-checks",
+			SyntheticCallsSettingsFieldRuleMatchSpecification: "invalid invalid",
+		},
+	})
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Error(t, err)
+}
+
+func (ut *syntheticCallsSettingsUnitTest) shouldNotMapDefaultRulesEnabledFlagToDataModelWhenNotConfiguredOnCreation(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticCallsSettings](t)
+	resourceHandle := NewSyntheticCallsSettingsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Nil(t, result.DefaultRulesEnabled)
+}
+
+func (ut *syntheticCallsSettingsUnitTest) shouldMapDefaultRulesEnabledFlagOfStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticCallsSettings](t)
+	resourceHandle := NewSyntheticCallsSettingsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(restapi.SyntheticCallsSettingsID)
+	setValueOnResourceData(t, resourceData, SyntheticCallsSettingsFieldDefaultRulesEnabled, false)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, utils.BoolPtr(false), result.DefaultRulesEnabled)
+}
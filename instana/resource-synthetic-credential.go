@@ -0,0 +1,87 @@
+package instana
+
+import (
+	"regexp"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaSyntheticCredential the name of the terraform-provider-instana resource to manage synthetic credentials
+const ResourceInstanaSyntheticCredential = "instana_synthetic_credential"
+
+const (
+	//SyntheticCredentialFieldName constant value for the schema field name
+	SyntheticCredentialFieldName = "name"
+	//SyntheticCredentialFieldValue constant value for the schema field value
+	SyntheticCredentialFieldValue = "value"
+)
+
+var syntheticCredentialNamePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,63}$`)
+
+// NewSyntheticCredentialResourceHandle creates the resource handle for synthetic credentials
+func NewSyntheticCredentialResourceHandle() ResourceHandle[*restapi.SyntheticCredential] {
+	return &syntheticCredentialResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaSyntheticCredential,
+			Schema: map[string]*schema.Schema{
+				SyntheticCredentialFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "The name of the credential. The name must start with a letter and can only contain letters, numbers and underscores",
+					ValidateFunc: validation.StringMatch(syntheticCredentialNamePattern, "must start with a letter, only contain letters, numbers and underscores and must not be longer than 64 characters"),
+				},
+				SyntheticCredentialFieldValue: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Sensitive:    true,
+					Description:  "The value of the credential. The value is stored encrypted and cannot be read after creation",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			CreateOnly:       true,
+		},
+	}
+}
+
+type syntheticCredentialResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *syntheticCredentialResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *syntheticCredentialResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *syntheticCredentialResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.SyntheticCredential] {
+	return api.SyntheticCredentials()
+}
+
+func (r *syntheticCredentialResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+// UpdateState updates the name only. The Instana API does not provide access to the value of a credential. Therefore,
+// the value is kept as configured.
+func (r *syntheticCredentialResource) UpdateState(d *schema.ResourceData, credential *restapi.SyntheticCredential) error {
+	d.SetId(credential.GetIDForResourcePath())
+	return tfutils.UpdateState(d, map[string]interface{}{
+		SyntheticCredentialFieldName: credential.Name,
+	})
+}
+
+func (r *syntheticCredentialResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.SyntheticCredential, error) {
+	return &restapi.SyntheticCredential{
+		Name:  d.Get(SyntheticCredentialFieldName).(string),
+		Value: d.Get(SyntheticCredentialFieldValue).(string),
+	}, nil
+}
@@ -0,0 +1,252 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestSyntheticCredentialResource(t *testing.T) {
+	ut := &syntheticCredentialUnitTest{}
+	t.Run("CRUD integration test", syntheticCredentialIntegrationTest)
+	t.Run("should recreate credential when deleted remotely", syntheticCredentialRemoteDeletionIntegrationTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should be create only", ut.shouldBeCreateOnly)
+	t.Run("should update resource state", ut.shouldUpdateResourceState)
+	t.Run("should map state to data model", ut.shouldMapStateToDataModel)
+	t.Run("should accept valid names", ut.shouldAcceptValidNames)
+	t.Run("should reject invalid names", ut.shouldRejectInvalidNames)
+}
+
+const (
+	syntheticCredentialDefinition = "instana_synthetic_credential.example"
+	syntheticCredentialName       = "user1_password"
+)
+
+const resourceSyntheticCredentialDefinitionTemplate = `
+resource "instana_synthetic_credential" "example" {
+  name  = "user1_password"
+  value = "secret-%d"
+}
+`
+
+type syntheticCredentialTestServer struct {
+	httpServer  testutils.TestHTTPServer
+	mutex       sync.Mutex
+	credentials map[string]string
+	creations   int
+	deletions   int
+}
+
+func newSyntheticCredentialTestServer() *syntheticCredentialTestServer {
+	server := &syntheticCredentialTestServer{
+		httpServer:  testutils.NewTestHTTPServer(),
+		credentials: make(map[string]string),
+	}
+	server.httpServer.AddRoute(http.MethodGet, restapi.SyntheticCredentialResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		names := make([]string, 0, len(server.credentials))
+		for name := range server.credentials {
+			names = append(names, name)
+		}
+		data, err := json.Marshal(names)
+		if err != nil {
+			server.httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		server.httpServer.WriteJSONResponse(w, data)
+	})
+	server.httpServer.AddRoute(http.MethodPost, restapi.SyntheticCredentialResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		credential := &restapi.SyntheticCredential{}
+		if err := json.NewDecoder(r.Body).Decode(credential); err != nil {
+			server.httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		server.credentials[credential.Name] = credential.Value
+		server.creations++
+		server.httpServer.WriteJSONResponse(w, []byte(fmt.Sprintf(`{"credentialName":"%s"}`, credential.Name)))
+	})
+	server.httpServer.AddRoute(http.MethodDelete, restapi.SyntheticCredentialResourcePath+"/{name}", func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		server.deleteCredential(mux.Vars(r)["name"])
+		server.deletions++
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return server
+}
+
+func (s *syntheticCredentialTestServer) deleteCredential(name string) {
+	delete(s.credentials, name)
+}
+
+func (s *syntheticCredentialTestServer) deleteCredentialRemotely(name string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.deleteCredential(name)
+}
+
+func (s *syntheticCredentialTestServer) verifyCalls(expectedCreations int, expectedDeletions int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.creations != expectedCreations || s.deletions != expectedDeletions {
+		return fmt.Errorf("expected %d creations and %d deletions but got %d creations and %d deletions", expectedCreations, expectedDeletions, s.creations, s.deletions)
+	}
+	return nil
+}
+
+func syntheticCredentialIntegrationTest(t *testing.T) {
+	server := newSyntheticCredentialTestServer()
+	server.httpServer.Start()
+	defer server.httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createSyntheticCredentialTestStep(server.httpServer.GetPort(), 0),
+			{
+				ResourceName:            syntheticCredentialDefinition,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{SyntheticCredentialFieldValue},
+			},
+			createSyntheticCredentialTestStep(server.httpServer.GetPort(), 1),
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			return server.verifyCalls(2, 2)
+		},
+	})
+}
+
+func syntheticCredentialRemoteDeletionIntegrationTest(t *testing.T) {
+	server := newSyntheticCredentialTestServer()
+	server.httpServer.Start()
+	defer server.httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createSyntheticCredentialTestStep(server.httpServer.GetPort(), 0),
+			{
+				PreConfig: func() {
+					server.deleteCredentialRemotely(syntheticCredentialName)
+				},
+				Config:             appendProviderConfig(fmt.Sprintf(resourceSyntheticCredentialDefinitionTemplate, 0), server.httpServer.GetPort()),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			createSyntheticCredentialTestStep(server.httpServer.GetPort(), 0),
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			return server.verifyCalls(2, 1)
+		},
+	})
+}
+
+func createSyntheticCredentialTestStep(httpPort int, iteration int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(resourceSyntheticCredentialDefinitionTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(syntheticCredentialDefinition, "id", syntheticCredentialName),
+			resource.TestCheckResourceAttr(syntheticCredentialDefinition, SyntheticCredentialFieldName, syntheticCredentialName),
+			resource.TestCheckResourceAttr(syntheticCredentialDefinition, SyntheticCredentialFieldValue, fmt.Sprintf("secret-%d", iteration)),
+		),
+	}
+}
+
+type syntheticCredentialUnitTest struct{}
+
+func (ut *syntheticCredentialUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	schemaMap := NewSyntheticCredentialResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 2)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticCredentialFieldName)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticCredentialFieldValue)
+	require.True(t, schemaMap[SyntheticCredentialFieldValue].Sensitive)
+	for key, fieldSchema := range schemaMap {
+		require.Truef(t, fieldSchema.ForceNew, "field %s should force a new resource", key)
+	}
+}
+
+func (ut *syntheticCredentialUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_synthetic_credential", NewSyntheticCredentialResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *syntheticCredentialUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewSyntheticCredentialResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *syntheticCredentialUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewSyntheticCredentialResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *syntheticCredentialUnitTest) shouldBeCreateOnly(t *testing.T) {
+	require.True(t, NewSyntheticCredentialResourceHandle().MetaData().CreateOnly)
+}
+
+func (ut *syntheticCredentialUnitTest) shouldUpdateResourceState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticCredential](t)
+	resourceHandle := NewSyntheticCredentialResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, SyntheticCredentialFieldValue, "secret")
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.SyntheticCredential{Name: syntheticCredentialName})
+
+	require.NoError(t, err)
+	require.Equal(t, syntheticCredentialName, resourceData.Id())
+	require.Equal(t, syntheticCredentialName, resourceData.Get(SyntheticCredentialFieldName))
+	require.Equal(t, "secret", resourceData.Get(SyntheticCredentialFieldValue))
+}
+
+func (ut *syntheticCredentialUnitTest) shouldMapStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticCredential](t)
+	resourceHandle := NewSyntheticCredentialResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, SyntheticCredentialFieldName, syntheticCredentialName)
+	setValueOnResourceData(t, resourceData, SyntheticCredentialFieldValue, "secret")
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.SyntheticCredential{Name: syntheticCredentialName, Value: "secret"}, result)
+}
+
+func (ut *syntheticCredentialUnitTest) shouldAcceptValidNames(t *testing.T) {
+	validateFunc := NewSyntheticCredentialResourceHandle().MetaData().Schema[SyntheticCredentialFieldName].ValidateFunc
+	for _, name := range []string{"a", "user1_password", "A_1", "a123456789012345678901234567890123456789012345678901234567890123"} {
+		t.Run(name, func(t *testing.T) {
+			_, errs := validateFunc(name, SyntheticCredentialFieldName)
+
+			require.Empty(t, errs)
+		})
+	}
+}
+
+func (ut *syntheticCredentialUnitTest) shouldRejectInvalidNames(t *testing.T) {
+	validateFunc := NewSyntheticCredentialResourceHandle().MetaData().Schema[SyntheticCredentialFieldName].ValidateFunc
+	for _, name := range []string{"", "1user", "_user", "user-password", "user password", "a1234567890123456789012345678901234567890123456789012345678901234"} {
+		t.Run(name, func(t *testing.T) {
+			_, errs := validateFunc(name, SyntheticCredentialFieldName)
+
+			require.NotEmpty(t, errs)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package instana
+
+import (
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaUserInvitation the name of the terraform-provider-instana resource to invite users into Instana
+const ResourceInstanaUserInvitation = "instana_user_invitation"
+
+const (
+	//UserInvitationFieldEmail constant value for the schema field email
+	UserInvitationFieldEmail = "email"
+	//UserInvitationFieldGroupIDs constant value for the schema field group_ids
+	UserInvitationFieldGroupIDs = "group_ids"
+	//UserInvitationFieldAccepted constant value for the computed schema field accepted
+	UserInvitationFieldAccepted = "accepted"
+)
+
+// NewUserInvitationResourceHandle creates the resource handle for user invitations
+func NewUserInvitationResourceHandle() ResourceHandle[*restapi.UserInvitation] {
+	return &userInvitationResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaUserInvitation,
+			Schema: map[string]*schema.Schema{
+				UserInvitationFieldEmail: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "The email address of the invited user",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				UserInvitationFieldGroupIDs: {
+					Type:     schema.TypeSet,
+					Required: true,
+					ForceNew: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "The IDs of the groups the user is added to when accepting the invitation",
+				},
+				UserInvitationFieldAccepted: {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Flag indicating whether the invitation was accepted by the user",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			CreateOnly:       true,
+		},
+	}
+}
+
+type userInvitationResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *userInvitationResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *userInvitationResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *userInvitationResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.UserInvitation] {
+	return api.UserInvitations()
+}
+
+func (r *userInvitationResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+// UpdateState updates the state of the invitation. The groups of an invitation are not provided by the Instana API.
+// Therefore, the groups of the current state are kept unless the invitation was just created. Email addresses are compared case-insensitive and the spelling of the current state is kept.
+func (r *userInvitationResource) UpdateState(d *schema.ResourceData, invitation *restapi.UserInvitation) error {
+	email := invitation.Email
+	if currentEmail := d.Get(UserInvitationFieldEmail).(string); strings.EqualFold(currentEmail, email) {
+		email = currentEmail
+	}
+	data := map[string]interface{}{
+		UserInvitationFieldEmail:    email,
+		UserInvitationFieldAccepted: invitation.Accepted,
+	}
+	if invitation.GroupIDs != nil {
+		data[UserInvitationFieldGroupIDs] = invitation.GroupIDs
+	}
+	d.SetId(email)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *userInvitationResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.UserInvitation, error) {
+	email := d.Get(UserInvitationFieldEmail).(string)
+	if len(email) == 0 {
+		email = d.Id()
+	}
+	groupIDs := ReadStringSetParameterFromResource(d, UserInvitationFieldGroupIDs)
+	if groupIDs == nil {
+		groupIDs = []string{}
+	}
+	return &restapi.UserInvitation{
+		Email:    email,
+		GroupIDs: groupIDs,
+	}, nil
+}
@@ -0,0 +1,238 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestUserInvitationResource(t *testing.T) {
+	ut := &userInvitationUnitTest{}
+	t.Run("CRUD integration test", userInvitationIntegrationTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should update resource state of pending invitation", ut.shouldUpdateResourceStateOfPendingInvitation)
+	t.Run("should keep groups in state when invitation is accepted", ut.shouldKeepGroupsInStateWhenInvitationIsAccepted)
+	t.Run("should keep groups in state when invitation is pending", ut.shouldKeepGroupsInStateWhenInvitationIsPending)
+	t.Run("should keep spelling of email in state", ut.shouldKeepSpellingOfEmailInState)
+	t.Run("should map state to data model", ut.shouldMapStateToDataModel)
+}
+
+const (
+	userInvitationDefinition = "instana_user_invitation.example"
+	userInvitationEmail      = "user@example.com"
+)
+
+const resourceUserInvitationDefinition = `
+resource "instana_user_invitation" "example" {
+  email     = "user@example.com"
+  group_ids = [ "group-1", "group-2" ]
+}
+`
+
+func userInvitationIntegrationTest(t *testing.T) {
+	var mutex sync.Mutex
+	invitations := make(map[string]*restapi.UserInvitation)
+	users := make([]*restapi.User, 0)
+	httpServer := testutils.NewTestHTTPServer()
+	writeJSON := func(w http.ResponseWriter, data interface{}) {
+		response, err := json.Marshal(data)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		httpServer.WriteJSONResponse(w, response)
+	}
+	httpServer.AddRoute(http.MethodGet, restapi.UserInvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		result := make([]*restapi.UserInvitation, 0)
+		for _, invitation := range invitations {
+			result = append(result, invitation)
+		}
+		writeJSON(w, result)
+	})
+	httpServer.AddRoute(http.MethodPost, restapi.UserInvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		requests := make([]restapi.InvitationRequest, 0)
+		if err := json.NewDecoder(r.Body).Decode(&requests); err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		response := restapi.InvitationResponse{InvitationResults: make([]restapi.InvitationResult, 0)}
+		for _, request := range requests {
+			if len(request.GroupID) == 0 {
+				httpServer.WriteInternalServerError(w, fmt.Errorf("group id is missing for %s", request.Email))
+				return
+			}
+			invitations[request.Email] = &restapi.UserInvitation{Email: request.Email}
+			response.InvitationResults = append(response.InvitationResults, restapi.InvitationResult{UserEmail: request.Email, InvitationStatus: restapi.InvitationStatusSuccess})
+		}
+		writeJSON(w, response)
+	})
+	httpServer.AddRoute(http.MethodDelete, restapi.UserInvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		delete(invitations, r.URL.Query().Get("email"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.UsersResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		writeJSON(w, users)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	config := appendProviderConfig(resourceUserInvitationDefinition, httpServer.GetPort())
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  createUserInvitationTestCheckFunc(false),
+			},
+			{
+				ResourceName:            userInvitationDefinition,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           userInvitationEmail,
+				ImportStateVerifyIgnore: []string{UserInvitationFieldGroupIDs},
+			},
+			{
+				PreConfig: func() {
+					mutex.Lock()
+					defer mutex.Unlock()
+					delete(invitations, userInvitationEmail)
+					users = append(users, &restapi.User{ID: "user-id", Email: userInvitationEmail, FullName: "User"})
+				},
+				Config: config,
+				Check:  createUserInvitationTestCheckFunc(true),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if len(users) != 1 {
+				return fmt.Errorf("expected user of accepted invitation to remain")
+			}
+			return nil
+		},
+	})
+}
+
+func createUserInvitationTestCheckFunc(accepted bool) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr(userInvitationDefinition, "id", userInvitationEmail),
+		resource.TestCheckResourceAttr(userInvitationDefinition, UserInvitationFieldEmail, userInvitationEmail),
+		resource.TestCheckResourceAttr(userInvitationDefinition, UserInvitationFieldGroupIDs+".#", "2"),
+		resource.TestCheckTypeSetElemAttr(userInvitationDefinition, UserInvitationFieldGroupIDs+".*", "group-1"),
+		resource.TestCheckTypeSetElemAttr(userInvitationDefinition, UserInvitationFieldGroupIDs+".*", "group-2"),
+		resource.TestCheckResourceAttr(userInvitationDefinition, UserInvitationFieldAccepted, fmt.Sprintf("%t", accepted)),
+	)
+}
+
+type userInvitationUnitTest struct{}
+
+func (ut *userInvitationUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	schemaMap := NewUserInvitationResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 3)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(UserInvitationFieldEmail)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeSetOfStrings(UserInvitationFieldGroupIDs)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeBool(UserInvitationFieldAccepted)
+	require.True(t, NewUserInvitationResourceHandle().MetaData().CreateOnly)
+}
+
+func (ut *userInvitationUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_user_invitation", NewUserInvitationResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *userInvitationUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewUserInvitationResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *userInvitationUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewUserInvitationResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *userInvitationUnitTest) shouldUpdateResourceStateOfPendingInvitation(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.UserInvitation](t)
+	resourceHandle := NewUserInvitationResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.UserInvitation{Email: userInvitationEmail, GroupIDs: []string{"group-1"}})
+
+	require.NoError(t, err)
+	require.Equal(t, userInvitationEmail, resourceData.Id())
+	require.Equal(t, userInvitationEmail, resourceData.Get(UserInvitationFieldEmail))
+	require.Equal(t, []interface{}{"group-1"}, resourceData.Get(UserInvitationFieldGroupIDs).(*schema.Set).List())
+	require.Equal(t, false, resourceData.Get(UserInvitationFieldAccepted))
+}
+
+func (ut *userInvitationUnitTest) shouldKeepGroupsInStateWhenInvitationIsAccepted(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.UserInvitation](t)
+	resourceHandle := NewUserInvitationResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, UserInvitationFieldGroupIDs, []interface{}{"group-1"})
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.UserInvitation{Email: userInvitationEmail, Accepted: true})
+
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"group-1"}, resourceData.Get(UserInvitationFieldGroupIDs).(*schema.Set).List())
+	require.Equal(t, true, resourceData.Get(UserInvitationFieldAccepted))
+}
+
+func (ut *userInvitationUnitTest) shouldKeepGroupsInStateWhenInvitationIsPending(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.UserInvitation](t)
+	resourceHandle := NewUserInvitationResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, UserInvitationFieldGroupIDs, []interface{}{"group-1"})
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.UserInvitation{Email: userInvitationEmail, InvitationStatus: restapi.InvitationStatusSuccess})
+
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"group-1"}, resourceData.Get(UserInvitationFieldGroupIDs).(*schema.Set).List())
+	require.Equal(t, false, resourceData.Get(UserInvitationFieldAccepted))
+}
+
+func (ut *userInvitationUnitTest) shouldKeepSpellingOfEmailInState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.UserInvitation](t)
+	resourceHandle := NewUserInvitationResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, UserInvitationFieldEmail, "User@Example.com")
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.UserInvitation{Email: userInvitationEmail, GroupIDs: []string{}})
+
+	require.NoError(t, err)
+	require.Equal(t, "User@Example.com", resourceData.Id())
+	require.Equal(t, "User@Example.com", resourceData.Get(UserInvitationFieldEmail))
+}
+
+func (ut *userInvitationUnitTest) shouldMapStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.UserInvitation](t)
+	resourceHandle := NewUserInvitationResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, UserInvitationFieldEmail, userInvitationEmail)
+	setValueOnResourceData(t, resourceData, UserInvitationFieldGroupIDs, []interface{}{"group-1"})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.UserInvitation{Email: userInvitationEmail, GroupIDs: []string{"group-1"}}, result)
+}
@@ -335,6 +335,11 @@ var websiteAlertConfigResourceSchema = map[string]*schema.Schema{
 	WebsiteAlertConfigFieldWebsiteID:       websiteAlertConfigSchemaWebsiteID,
 }
 
+var websiteAlertConfigRuleMapper = newAlertRuleMapper(WebsiteAlertConfigFieldRule, map[string]string{
+	WebsiteAlertConfigFieldRuleSpecificJsError: "specificJsError",
+	WebsiteAlertConfigFieldRuleStatusCode:      "statusCode",
+})
+
 // NewWebsiteAlertConfigResourceHandle creates the resource handle for Website Alert Configs
 func NewWebsiteAlertConfigResourceHandle() ResourceHandle[*restapi.WebsiteAlertConfig] {
 	return &websiteAlertConfigResource{
@@ -397,79 +402,20 @@ func (r *websiteAlertConfigResource) UpdateState(d *schema.ResourceData, config
 		WebsiteAlertConfigFieldSeverity:        severity,
 		WebsiteAlertConfigFieldTagFilter:       normalizedTagFilterString,
 		ResourceFieldThreshold:                 newThresholdMapper().toState(&config.Threshold),
-		WebsiteAlertConfigFieldTimeThreshold:   r.mapTimeThresholdToSchema(config),
+		WebsiteAlertConfigFieldTimeThreshold:   mapWebsiteTimeThresholdToState(&config.TimeThreshold),
 		WebsiteAlertConfigFieldTriggering:      config.Triggering,
 		WebsiteAlertConfigFieldWebsiteID:       config.WebsiteID,
 	})
 }
 
 func (r *websiteAlertConfigResource) mapRuleToSchema(config *restapi.WebsiteAlertConfig) []map[string]interface{} {
-	ruleAttribute := make(map[string]interface{})
-	ruleAttribute[WebsiteAlertConfigFieldRuleMetricName] = config.Rule.MetricName
-
-	if config.Rule.Aggregation != nil {
-		ruleAttribute[WebsiteAlertConfigFieldRuleAggregation] = string(*config.Rule.Aggregation)
-	}
-	if config.Rule.Operator != nil {
-		ruleAttribute[WebsiteAlertConfigFieldRuleOperator] = string(*config.Rule.Operator)
-	}
-	if config.Rule.Value != nil {
-		ruleAttribute[WebsiteAlertConfigFieldRuleValue] = *config.Rule.Value
-	}
-
-	alertType := r.mapAlertTypeToSchema(config.Rule.AlertType)
-	rule := make(map[string]interface{})
-	rule[alertType] = []interface{}{ruleAttribute}
-	result := make([]map[string]interface{}, 1)
-	result[0] = rule
-	return result
-}
-
-func (r *websiteAlertConfigResource) mapAlertTypeToSchema(alertType string) string {
-	if alertType == "specificJsError" {
-		return WebsiteAlertConfigFieldRuleSpecificJsError
-	} else if alertType == "statusCode" {
-		return WebsiteAlertConfigFieldRuleStatusCode
+	attributes := alertRuleAttributes{
+		MetricName:  config.Rule.MetricName,
+		Aggregation: config.Rule.Aggregation,
+		Operator:    config.Rule.Operator,
+		Value:       config.Rule.Value,
 	}
-	return alertType
-}
-
-func (r *websiteAlertConfigResource) mapTimeThresholdToSchema(config *restapi.WebsiteAlertConfig) []map[string]interface{} {
-	timeThresholdConfig := make(map[string]interface{})
-
-	if config.TimeThreshold.TimeWindow != nil {
-		timeThresholdConfig[WebsiteAlertConfigFieldTimeThresholdTimeWindow] = config.TimeThreshold.TimeWindow
-	}
-	if config.TimeThreshold.Violations != nil {
-		timeThresholdConfig[WebsiteAlertConfigFieldTimeThresholdViolationsInPeriodViolations] = int(*config.TimeThreshold.Violations)
-	}
-	if config.TimeThreshold.ImpactMeasurementMethod != nil {
-		timeThresholdConfig[WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceImpactMeasurementMethod] = string(*config.TimeThreshold.ImpactMeasurementMethod)
-	}
-	if config.TimeThreshold.Users != nil {
-		timeThresholdConfig[WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUsers] = int(*config.TimeThreshold.Users)
-	}
-	if config.TimeThreshold.UserPercentage != nil {
-		timeThresholdConfig[WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUserPercentage] = *config.TimeThreshold.UserPercentage
-	}
-
-	timeThresholdType := r.mapTimeThresholdTypeToSchema(config.TimeThreshold.Type)
-	timeThreshold := make(map[string]interface{})
-	timeThreshold[timeThresholdType] = []interface{}{timeThresholdConfig}
-	result := make([]map[string]interface{}, 1)
-	result[0] = timeThreshold
-	return result
-}
-
-func (r *websiteAlertConfigResource) mapTimeThresholdTypeToSchema(input string) string {
-	if input == "userImpactOfViolationsInSequence" {
-		return WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence
-	} else if input == "violationsInPeriod" {
-		return WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod
-	} else if input == "violationsInSequence" {
-		return WebsiteAlertConfigFieldTimeThresholdViolationsInSequence
-	}
-	return input
+	return websiteAlertConfigRuleMapper.toState(config.Rule.AlertType, attributes.toState())
 }
 
 func (r *websiteAlertConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.WebsiteAlertConfig, error) {
@@ -504,7 +450,7 @@ func (r *websiteAlertConfigResource) MapStateToDataObject(d *schema.ResourceData
 		Severity:              severity,
 		TagFilterExpression:   tagFilter,
 		Threshold:             *threshold,
-		TimeThreshold:         *r.mapTimeThresholdFromSchema(d),
+		TimeThreshold:         *mapWebsiteTimeThresholdFromState(d, WebsiteAlertConfigFieldTimeThreshold),
 		Triggering:            d.Get(WebsiteAlertConfigFieldTriggering).(bool),
 		WebsiteID:             d.Get(WebsiteAlertConfigFieldWebsiteID).(string),
 	}, nil
@@ -522,18 +468,15 @@ func (r *websiteAlertConfigResource) mapTagFilterExpressionFromSchema(input stri
 }
 
 func (r *websiteAlertConfigResource) mapRuleFromSchema(d *schema.ResourceData) *restapi.WebsiteAlertRule {
-	ruleSlice := d.Get(WebsiteAlertConfigFieldRule).([]interface{})
-	rule := ruleSlice[0].(map[string]interface{})
-	for alertType, v := range rule {
-		configSlice := v.([]interface{})
-		if len(configSlice) == 1 {
-			config := configSlice[0].(map[string]interface{})
-			return r.mapRuleConfigFromSchema(config, alertType)
-		}
+	alertType, config, ok := websiteAlertConfigRuleMapper.fromState(d)
+	if !ok {
+		return &restapi.WebsiteAlertRule{}
 	}
-	return &restapi.WebsiteAlertRule{}
+	return r.mapRuleConfigFromSchema(config, alertType)
 }
 
+// mapRuleConfigFromSchema maps all attributes which are defined for the rule type. In contrast to mobile app alert
+// configs optional attributes which are not configured are sent as empty strings.
 func (r *websiteAlertConfigResource) mapRuleConfigFromSchema(config map[string]interface{}, alertType string) *restapi.WebsiteAlertRule {
 	var aggregationPtr *restapi.Aggregation
 	if aggregationStr, ok := config[WebsiteAlertConfigFieldRuleAggregation]; ok {
@@ -551,7 +494,7 @@ func (r *websiteAlertConfigResource) mapRuleConfigFromSchema(config map[string]i
 		operatorPtr = &operator
 	}
 	return &restapi.WebsiteAlertRule{
-		AlertType:   r.mapAlertTypeFromSchema(alertType),
+		AlertType:   alertType,
 		MetricName:  config[WebsiteAlertConfigFieldRuleMetricName].(string),
 		Aggregation: aggregationPtr,
 		Operator:    operatorPtr,
@@ -559,71 +502,6 @@ func (r *websiteAlertConfigResource) mapRuleConfigFromSchema(config map[string]i
 	}
 }
 
-func (r *websiteAlertConfigResource) mapAlertTypeFromSchema(alertType string) string {
-	if alertType == WebsiteAlertConfigFieldRuleSpecificJsError {
-		return "specificJsError"
-	} else if alertType == WebsiteAlertConfigFieldRuleStatusCode {
-		return "statusCode"
-	}
-	return alertType
-}
-
-func (r *websiteAlertConfigResource) mapTimeThresholdFromSchema(d *schema.ResourceData) *restapi.WebsiteTimeThreshold {
-	timeThresholdSlice := d.Get(WebsiteAlertConfigFieldTimeThreshold).([]interface{})
-	timeThreshold := timeThresholdSlice[0].(map[string]interface{})
-	for timeThresholdType, v := range timeThreshold {
-		configSlice := v.([]interface{})
-		if len(configSlice) == 1 {
-			config := configSlice[0].(map[string]interface{})
-			var timeWindowPtr *int64
-			if v, ok := config[WebsiteAlertConfigFieldTimeThresholdTimeWindow]; ok {
-				timeWindow := int64(v.(int))
-				timeWindowPtr = &timeWindow
-			}
-			var violationsPtr *int32
-			if v, ok := config[WebsiteAlertConfigFieldTimeThresholdViolationsInPeriodViolations]; ok {
-				violations := int32(v.(int))
-				violationsPtr = &violations
-			}
-			var impactMeasurementMethodPtr *restapi.WebsiteImpactMeasurementMethod
-			if v, ok := config[WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceImpactMeasurementMethod]; ok {
-				impactMeasurementMethod := restapi.WebsiteImpactMeasurementMethod(v.(string))
-				impactMeasurementMethodPtr = &impactMeasurementMethod
-			}
-			var userPercentagePtr *float64
-			if v, ok := config[WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUserPercentage]; ok {
-				userPercentage := v.(float64)
-				userPercentagePtr = &userPercentage
-			}
-			var usersPtr *int32
-			if v, ok := config[WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUsers]; ok {
-				users := int32(v.(int))
-				usersPtr = &users
-			}
-			return &restapi.WebsiteTimeThreshold{
-				Type:                    r.mapTimeThresholdTypeFromSchema(timeThresholdType),
-				TimeWindow:              timeWindowPtr,
-				Violations:              violationsPtr,
-				ImpactMeasurementMethod: impactMeasurementMethodPtr,
-				UserPercentage:          userPercentagePtr,
-				Users:                   usersPtr,
-			}
-		}
-	}
-	return &restapi.WebsiteTimeThreshold{}
-}
-
-func (r *websiteAlertConfigResource) mapTimeThresholdTypeFromSchema(input string) string {
-	if input == WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence {
-		return "userImpactOfViolationsInSequence"
-	} else if input == WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod {
-		return "violationsInPeriod"
-	} else if input == WebsiteAlertConfigFieldTimeThresholdViolationsInSequence {
-		return "violationsInSequence"
-	}
-	return input
-}
-
 func (r *websiteAlertConfigResource) websiteAlertConfigStateUpgradeV0(_ context.Context, state map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
 	if _, ok := state[WebsiteAlertConfigFieldFullName]; ok {
 		state[WebsiteAlertConfigFieldName] = state[WebsiteAlertConfigFieldFullName]
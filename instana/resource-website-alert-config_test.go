@@ -680,6 +680,8 @@ func (test *websiteAlertConfigTest) createTestCasesForMappingOfTerraformResource
 	minAggregation := restapi.MinAggregation
 	statusCodeValue := "200"
 	jsErrorValue := "jsErrorValue"
+	emptyAggregation := restapi.Aggregation("")
+	emptyValue := ""
 	rules := []testPair[[]map[string]interface{}, restapi.WebsiteAlertRule]{
 		{
 			name: WebsiteAlertConfigFieldRuleThroughput,
@@ -773,6 +775,29 @@ func (test *websiteAlertConfigTest) createTestCasesForMappingOfTerraformResource
 				},
 			},
 		},
+		{
+			name: "SpecificJsErrorWithoutAggregationAndValue",
+			expected: restapi.WebsiteAlertRule{
+				AlertType:   "specificJsError",
+				Aggregation: &emptyAggregation,
+				MetricName:  metricName,
+				Operator:    &equalsOperator,
+				Value:       &emptyValue,
+			},
+			input: []map[string]interface{}{
+				{
+					WebsiteAlertConfigFieldRuleSpecificJsError: []interface{}{
+						map[string]interface{}{
+							WebsiteAlertConfigFieldRuleMetricName: metricName,
+							WebsiteAlertConfigFieldRuleOperator:   string(equalsOperator),
+						},
+					},
+					WebsiteAlertConfigFieldRuleSlowness:   []interface{}{},
+					WebsiteAlertConfigFieldRuleStatusCode: []interface{}{},
+					WebsiteAlertConfigFieldRuleThroughput: []interface{}{},
+				},
+			},
+		},
 	}
 
 	thresholdValue := 123.3
@@ -50,6 +50,9 @@ func NewWebsiteMonitoringConfigResourceHandle() ResourceHandle[*restapi.WebsiteM
 			Schema: map[string]*schema.Schema{
 				WebsiteMonitoringConfigFieldName:    WebsiteMonitoringConfigSchemaName,
 				WebsiteMonitoringConfigFieldAppName: WebsiteMonitoringConfigSchemaAppName,
+				GeoLocationFieldGeoLocation:         withoutComputedValue(geoLocationSchema),
+				GeoMappingRulesFieldGeoMappingRules: withoutComputedValue(geoMappingRulesSchema),
+				IPMaskingFieldIPMasking:             withoutComputedValue(ipMaskingSchema),
 			},
 			SchemaVersion: 1,
 		},
@@ -82,18 +85,55 @@ func (r *websiteMonitoringConfigResource) SetComputedFields(_ *schema.ResourceDa
 	return nil
 }
 
+// ReadFromAPI reads the website together with the geo location and IP masking settings which are managed by terraform.
+// Settings which are not configured are not read to avoid additional requests to the Instana API. All settings are read
+// when the website is imported as the state does not contain any data in this case.
+func (r *websiteMonitoringConfigResource) ReadFromAPI(ctx context.Context, d *schema.ResourceData, api restapi.InstanaAPI, id string) (*restapi.WebsiteMonitoringConfig, error) {
+	restResource := r.GetRestResource(api)
+	reader, ok := restResource.(restapi.GeoLocationAndIPMaskingSettingsReader[*restapi.WebsiteMonitoringConfig])
+	if !ok || len(d.Get(WebsiteMonitoringConfigFieldName).(string)) == 0 {
+		return restResource.GetOne(ctx, id)
+	}
+	return reader.GetOneWithSettings(ctx, id, mapGeoLocationAndIPMaskingSettingsFromState(d).Selection())
+}
+
+// UpdateState updates the state of the website. Geo location and IP masking settings which were not read from the
+// Instana API are kept as they are.
 func (r *websiteMonitoringConfigResource) UpdateState(d *schema.ResourceData, config *restapi.WebsiteMonitoringConfig) error {
 	d.SetId(config.ID)
-	return tfutils.UpdateState(d, map[string]interface{}{
+	data := map[string]interface{}{
 		WebsiteMonitoringConfigFieldName:    config.Name,
 		WebsiteMonitoringConfigFieldAppName: config.AppName,
-	})
+	}
+	if config.GeoLocation != nil {
+		data[GeoLocationFieldGeoLocation] = mapGeoLocationToState(config.GeoLocation)
+	}
+	if config.GeoMappingRules != nil {
+		data[GeoMappingRulesFieldGeoMappingRules] = mapGeoMappingRulesToState(config.GeoMappingRules)
+	}
+	if config.IPMasking != nil {
+		data[IPMaskingFieldIPMasking] = mapIPMaskingToState(config.IPMasking)
+	}
+	return tfutils.UpdateState(d, data)
 }
 
+// MapStateToDataObject maps the state to the website. Only geo location and IP masking settings which are configured
+// and changed are provided so that unchanged settings are not sent to the Instana API again.
 func (r *websiteMonitoringConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.WebsiteMonitoringConfig, error) {
+	settings := mapGeoLocationAndIPMaskingSettingsFromState(d)
+	if !d.HasChange(GeoLocationFieldGeoLocation) {
+		settings.GeoLocation = nil
+	}
+	if !d.HasChange(GeoMappingRulesFieldGeoMappingRules) {
+		settings.GeoMappingRules = nil
+	}
+	if !d.HasChange(IPMaskingFieldIPMasking) {
+		settings.IPMasking = nil
+	}
 	return &restapi.WebsiteMonitoringConfig{
-		ID:   d.Id(),
-		Name: d.Get(WebsiteMonitoringConfigFieldName).(string),
+		ID:                              d.Id(),
+		Name:                            d.Get(WebsiteMonitoringConfigFieldName).(string),
+		GeoLocationAndIPMaskingSettings: settings,
 	}, nil
 }
 
@@ -1,6 +1,7 @@
 package instana_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -8,11 +9,15 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"go.uber.org/mock/gomock"
 )
 
 const websiteMonitoringConfigTerraformTemplate = `
@@ -21,6 +26,24 @@ resource "instana_website_monitoring_config" "example_website_monitoring_config"
 }
 `
 
+const websiteMonitoringConfigWithGeoLocationAndIPMaskingTerraformTemplate = `
+resource "instana_website_monitoring_config" "example_website_monitoring_config" {
+	name = "name %d"
+
+	geo_location {
+		geo_detail_removal = "REMOVE_COORDINATES"
+	}
+
+	geo_mapping_rules {
+		csv = "10.0.0.0/8,EU,Europe"
+	}
+
+	ip_masking {
+		mode = "STRICT"
+	}
+}
+`
+
 const (
 	websiteMonitoringConfigApiPath    = restapi.WebsiteMonitoringConfigResourcePath + "/{id}"
 	websiteMonitoringConfigDefinition = "instana_website_monitoring_config.example_website_monitoring_config"
@@ -36,18 +59,55 @@ func TestCRUDOfWebsiteMonitoringConfiguration(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: appendProviderConfig(fmt.Sprintf(websiteMonitoringConfigTerraformTemplate, 0), server.GetPort()),
-				Check:  resource.ComposeTestCheckFunc(createWebsiteMonitoringConfigTestCheckFunctions(0)...),
+				Check: resource.ComposeTestCheckFunc(append(createWebsiteMonitoringConfigTestCheckFunctions(0),
+					server.verifyCallCount(http.MethodGet, "/geo-location", 0),
+					server.verifyCallCount(http.MethodGet, "/geo-mapping-rules", 0),
+					server.verifyCallCount(http.MethodGet, "/ip-masking", 0),
+					server.verifyCallCount(http.MethodPut, "/ip-masking", 0),
+				)...),
 			},
-			testStepImport(websiteMonitoringConfigDefinition),
+			testStepImportIgnoringGeoLocationAndIPMaskingSettings(),
 			{
 				Config: appendProviderConfig(fmt.Sprintf(websiteMonitoringConfigTerraformTemplate, 1), server.GetPort()),
 				Check:  resource.ComposeTestCheckFunc(createWebsiteMonitoringConfigTestCheckFunctions(1)...),
 			},
+			testStepImportIgnoringGeoLocationAndIPMaskingSettings(),
+			{
+				Config: appendProviderConfig(fmt.Sprintf(websiteMonitoringConfigWithGeoLocationAndIPMaskingTerraformTemplate, 2), server.GetPort()),
+				Check: resource.ComposeTestCheckFunc(append(createWebsiteMonitoringConfigTestCheckFunctions(2),
+					resource.TestCheckResourceAttr(websiteMonitoringConfigDefinition, GeoLocationFieldGeoLocation+".0."+GeoLocationFieldGeoDetailRemoval, "REMOVE_COORDINATES"),
+					resource.TestCheckResourceAttr(websiteMonitoringConfigDefinition, GeoMappingRulesFieldGeoMappingRules+".0."+GeoMappingRulesFieldCSV, "10.0.0.0/8,EU,Europe"),
+					resource.TestCheckResourceAttr(websiteMonitoringConfigDefinition, IPMaskingFieldIPMasking+".0."+IPMaskingFieldMode, "STRICT"),
+					server.verifyCallCount(http.MethodPut, "/geo-location", 1),
+					server.verifyCallCount(http.MethodPut, "/geo-mapping-rules", 1),
+					server.verifyCallCount(http.MethodPut, "/ip-masking", 1),
+				)...),
+			},
 			testStepImport(websiteMonitoringConfigDefinition),
+			{
+				Config: appendProviderConfig(fmt.Sprintf(websiteMonitoringConfigWithGeoLocationAndIPMaskingTerraformTemplate, 3), server.GetPort()),
+				Check: resource.ComposeTestCheckFunc(append(createWebsiteMonitoringConfigTestCheckFunctions(3),
+					resource.TestCheckResourceAttr(websiteMonitoringConfigDefinition, IPMaskingFieldIPMasking+".0."+IPMaskingFieldMode, "STRICT"),
+					server.verifyCallCount(http.MethodPut, "/geo-location", 1),
+					server.verifyCallCount(http.MethodPut, "/geo-mapping-rules", 1),
+					server.verifyCallCount(http.MethodPut, "/ip-masking", 1),
+				)...),
+			},
 		},
 	})
 }
 
+// testStepImportIgnoringGeoLocationAndIPMaskingSettings all settings are imported while the settings are not managed
+// when they are not configured
+func testStepImportIgnoringGeoLocationAndIPMaskingSettings() resource.TestStep {
+	return resource.TestStep{
+		ResourceName:            websiteMonitoringConfigDefinition,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: []string{GeoLocationFieldGeoLocation, GeoMappingRulesFieldGeoMappingRules, IPMaskingFieldIPMasking},
+	}
+}
+
 func createWebsiteMonitoringConfigTestCheckFunctions(iteration int) []resource.TestCheckFunc {
 	testCheckFunctions := []resource.TestCheckFunc{
 		resource.TestCheckResourceAttrSet(websiteMonitoringConfigDefinition, "id"),
@@ -72,6 +132,7 @@ func (s *websiteMonitoringConfigTestServer) Start() {
 	s.httpServer.AddRoute(http.MethodPut, websiteMonitoringConfigApiPath, s.onPut)
 	s.httpServer.AddRoute(http.MethodDelete, websiteMonitoringConfigApiPath, testutils.EchoHandlerFunc)
 	s.httpServer.AddRoute(http.MethodGet, websiteMonitoringConfigApiPath, s.onGet)
+	addGeoLocationAndIPMaskingRoutes(s.httpServer, websiteMonitoringConfigApiPath)
 	s.httpServer.Start()
 }
 
@@ -163,6 +224,16 @@ func (s *websiteMonitoringConfigTestServer) onGet(w http.ResponseWriter, r *http
 	}
 }
 
+func (s *websiteMonitoringConfigTestServer) verifyCallCount(method string, subPath string, expected int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		path := restapi.WebsiteMonitoringConfigResourcePath + "/" + s.serverState.ID + subPath
+		if actual := s.GetCallCount(method, path); actual != expected {
+			return fmt.Errorf("expected %d %s requests of %s but got %d", expected, method, subPath, actual)
+		}
+		return nil
+	}
+}
+
 func (s *websiteMonitoringConfigTestServer) Close() {
 	if s.httpServer != nil {
 		s.httpServer.Close()
@@ -177,6 +248,9 @@ func TestResourceWebsiteMonitoringConfigDefinition(t *testing.T) {
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteMonitoringConfigFieldName)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeString(WebsiteMonitoringConfigFieldAppName)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(GeoLocationFieldGeoLocation)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(GeoMappingRulesFieldGeoMappingRules)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(IPMaskingFieldIPMasking)
 }
 
 func TestShouldUpdateResourceStateForWebsiteMonitoringConfig(t *testing.T) {
@@ -197,6 +271,33 @@ func TestShouldUpdateResourceStateForWebsiteMonitoringConfig(t *testing.T) {
 	require.Equal(t, "id", resourceData.Id(), "id should be equal")
 	require.Equal(t, resourceName, resourceData.Get(WebsiteMonitoringConfigFieldName))
 	require.Equal(t, appname, resourceData.Get(WebsiteMonitoringConfigFieldAppName))
+	require.Empty(t, resourceData.Get(GeoLocationFieldGeoLocation))
+	require.Empty(t, resourceData.Get(GeoMappingRulesFieldGeoMappingRules))
+	require.Empty(t, resourceData.Get(IPMaskingFieldIPMasking))
+}
+
+func TestShouldUpdateResourceStateForWebsiteMonitoringConfigWithGeoLocationAndIPMaskingSettings(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteMonitoringConfig](t)
+	resourceHandle := NewWebsiteMonitoringConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	rules := "10.0.0.0/8,EU,Europe"
+	data := restapi.WebsiteMonitoringConfig{
+		ID:      "id",
+		Name:    resourceName,
+		AppName: resourceName,
+		GeoLocationAndIPMaskingSettings: restapi.GeoLocationAndIPMaskingSettings{
+			GeoLocation:     &restapi.GeoLocationConfiguration{GeoDetailRemoval: restapi.GeoDetailRemovalRemoveAll},
+			GeoMappingRules: &rules,
+			IPMasking:       &restapi.IPMaskingConfiguration{IPMasking: restapi.IPMaskingStrict},
+		},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{map[string]interface{}{GeoLocationFieldGeoDetailRemoval: "REMOVE_ALL"}}, resourceData.Get(GeoLocationFieldGeoLocation))
+	require.Equal(t, []interface{}{map[string]interface{}{GeoMappingRulesFieldCSV: rules}}, resourceData.Get(GeoMappingRulesFieldGeoMappingRules))
+	require.Equal(t, []interface{}{map[string]interface{}{IPMaskingFieldMode: "STRICT"}}, resourceData.Get(IPMaskingFieldIPMasking))
 }
 
 func TestShouldConvertStateOfWebsiteMonitoringConfigToDataModel(t *testing.T) {
@@ -212,6 +313,111 @@ func TestShouldConvertStateOfWebsiteMonitoringConfigToDataModel(t *testing.T) {
 	require.IsType(t, &restapi.WebsiteMonitoringConfig{}, model)
 	require.Equal(t, "id", model.GetIDForResourcePath())
 	require.Equal(t, resourceName, model.Name)
+	require.Equal(t, restapi.GeoLocationAndIPMaskingSettings{}, model.GeoLocationAndIPMaskingSettings)
+}
+
+func TestShouldConvertStateOfWebsiteMonitoringConfigWithChangedGeoLocationAndIPMaskingSettingsToDataModel(t *testing.T) {
+	resourceHandle := NewWebsiteMonitoringConfigResourceHandle()
+	resourceData := schema.TestResourceDataRaw(t, resourceHandle.MetaData().Schema, map[string]interface{}{
+		WebsiteMonitoringConfigFieldName:    "name",
+		GeoLocationFieldGeoLocation:         []interface{}{map[string]interface{}{GeoLocationFieldGeoDetailRemoval: "REMOVE_CITY"}},
+		GeoMappingRulesFieldGeoMappingRules: []interface{}{map[string]interface{}{GeoMappingRulesFieldCSV: "rules"}},
+		IPMaskingFieldIPMasking:             []interface{}{map[string]interface{}{IPMaskingFieldMode: "REMOVE_ALL_DETAILS"}},
+	})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, restapi.GeoDetailRemovalRemoveCity, model.GeoLocation.GeoDetailRemoval)
+	require.Equal(t, "rules", *model.GeoMappingRules)
+	require.Equal(t, restapi.IPMaskingRemoveAllDetails, model.IPMasking.IPMasking)
+}
+
+func TestShouldNotProvideUnchangedGeoLocationAndIPMaskingSettingsWhenConvertingStateOfWebsiteMonitoringConfigToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteMonitoringConfig](t)
+	resourceHandle := NewWebsiteMonitoringConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, WebsiteMonitoringConfigFieldName, "name")
+	setValueOnResourceData(t, resourceData, GeoLocationFieldGeoLocation, []interface{}{map[string]interface{}{GeoLocationFieldGeoDetailRemoval: "REMOVE_CITY"}})
+	setValueOnResourceData(t, resourceData, GeoMappingRulesFieldGeoMappingRules, []interface{}{map[string]interface{}{GeoMappingRulesFieldCSV: "rules"}})
+	setValueOnResourceData(t, resourceData, IPMaskingFieldIPMasking, []interface{}{map[string]interface{}{IPMaskingFieldMode: "REMOVE_ALL_DETAILS"}})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, restapi.GeoLocationAndIPMaskingSettings{}, model.GeoLocationAndIPMaskingSettings)
+}
+
+func TestShouldLeaveRemoteGeoLocationAndIPMaskingSettingsUnchangedWhenBlocksAreRemovedFromWebsiteMonitoringConfig(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteMonitoringConfig](t)
+	resourceHandle := NewWebsiteMonitoringConfigResourceHandle()
+	currentResourceData := testHelper.CreateResourceDataForResourceHandle(resourceHandle, map[string]interface{}{
+		WebsiteMonitoringConfigFieldName:    resourceName,
+		GeoLocationFieldGeoLocation:         []interface{}{map[string]interface{}{GeoLocationFieldGeoDetailRemoval: "REMOVE_CITY"}},
+		GeoMappingRulesFieldGeoMappingRules: []interface{}{map[string]interface{}{GeoMappingRulesFieldCSV: "rules"}},
+		IPMaskingFieldIPMasking:             []interface{}{map[string]interface{}{IPMaskingFieldMode: "STRICT"}},
+	})
+	currentResourceData.SetId("id")
+	state := currentResourceData.State()
+	schemaMap := schema.InternalMap(resourceHandle.MetaData().Schema)
+	diff, err := schemaMap.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		WebsiteMonitoringConfigFieldName: resourceName,
+	}), nil, nil, true)
+	require.NoError(t, err)
+	resourceData, err := schemaMap.Data(state, diff)
+	require.NoError(t, err)
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, restapi.GeoLocationAndIPMaskingSettings{}, model.GeoLocationAndIPMaskingSettings)
+
+	err = resourceHandle.UpdateState(resourceData, &restapi.WebsiteMonitoringConfig{ID: "id", Name: resourceName, AppName: resourceName})
+
+	require.NoError(t, err)
+	require.Empty(t, resourceData.Get(GeoLocationFieldGeoLocation))
+	require.Empty(t, resourceData.Get(GeoMappingRulesFieldGeoMappingRules))
+	require.Empty(t, resourceData.Get(IPMaskingFieldIPMasking))
+}
+
+func TestShouldKeepGeoLocationAndIPMaskingSettingsWhichAreNotReadWhenUpdatingResourceStateForWebsiteMonitoringConfig(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteMonitoringConfig](t)
+	resourceHandle := NewWebsiteMonitoringConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, IPMaskingFieldIPMasking, []interface{}{map[string]interface{}{IPMaskingFieldMode: "STRICT"}})
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.WebsiteMonitoringConfig{ID: "id", Name: resourceName, AppName: resourceName})
+
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{map[string]interface{}{IPMaskingFieldMode: "STRICT"}}, resourceData.Get(IPMaskingFieldIPMasking))
+	require.Empty(t, resourceData.Get(GeoLocationFieldGeoLocation))
+}
+
+func TestShouldReadOnlyConfiguredGeoLocationAndIPMaskingSettingsOfWebsiteMonitoringConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+	serializedConfig := []byte(`{"id":"id","name":"name","appName":"name"}`)
+
+	mockInstanaAPI.EXPECT().WebsiteMonitoringConfig().Return(restapi.NewWebsiteMonitoringConfigRestResource(restapi.NewDefaultJSONUnmarshaller(&restapi.WebsiteMonitoringConfig{}), client)).Times(1)
+	client.EXPECT().GetOne(gomock.Any(), "id", restapi.WebsiteMonitoringConfigResourcePath).Times(1).Return(serializedConfig, nil)
+	client.EXPECT().GetContent(gomock.Any(), restapi.WebsiteMonitoringConfigResourcePath+"/id/ip-masking", gomock.Any()).Times(1).Return([]byte(`{"ipMasking":"DEFAULT"}`), nil)
+
+	testHelper := NewTestHelper[*restapi.WebsiteMonitoringConfig](t)
+	resourceHandle := NewWebsiteMonitoringConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, WebsiteMonitoringConfigFieldName, "name")
+	setValueOnResourceData(t, resourceData, IPMaskingFieldIPMasking, []interface{}{map[string]interface{}{IPMaskingFieldMode: "STRICT"}})
+
+	diag := NewTerraformResource(resourceHandle).Read(context.TODO(), resourceData, &ProviderMeta{InstanaAPI: mockInstanaAPI})
+
+	require.False(t, diag.HasError())
+	require.Equal(t, []interface{}{map[string]interface{}{IPMaskingFieldMode: "DEFAULT"}}, resourceData.Get(IPMaskingFieldIPMasking))
+	require.Empty(t, resourceData.Get(GeoLocationFieldGeoLocation))
+	require.Empty(t, resourceData.Get(GeoMappingRulesFieldGeoMappingRules))
 }
 
 func TestWebsiteMonitoringConfigkShouldHaveSchemaVersionZero(t *testing.T) {
@@ -0,0 +1,145 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaWebsiteSourceMapUpload the name of the terraform-provider-instana resource to upload source map files of websites
+const ResourceInstanaWebsiteSourceMapUpload = "instana_website_source_map_upload"
+
+const (
+	//WebsiteSourceMapUploadFieldWebsiteID constant value for the schema field website_id
+	WebsiteSourceMapUploadFieldWebsiteID = "website_id"
+	//WebsiteSourceMapUploadFieldSourceMapConfigID constant value for the schema field source_map_config_id
+	WebsiteSourceMapUploadFieldSourceMapConfigID = "source_map_config_id"
+	//WebsiteSourceMapUploadFieldSourceMap constant value for the schema field source_map
+	WebsiteSourceMapUploadFieldSourceMap = "source_map"
+	//WebsiteSourceMapUploadFieldURL constant value for the schema field source_map.url
+	WebsiteSourceMapUploadFieldURL = "url"
+	//WebsiteSourceMapUploadFieldFileFormat constant value for the schema field source_map.file_format
+	WebsiteSourceMapUploadFieldFileFormat = "file_format"
+	//WebsiteSourceMapUploadFieldSourceMapFile constant value for the schema field source_map.source_map_file
+	WebsiteSourceMapUploadFieldSourceMapFile = "source_map_file"
+	//WebsiteSourceMapUploadFieldSourceMapFileHash constant value for the schema field source_map.source_map_file_hash
+	WebsiteSourceMapUploadFieldSourceMapFileHash = "source_map_file_hash"
+)
+
+// NewWebsiteSourceMapUploadResourceHandle creates the resource handle for the upload of source map files of websites
+func NewWebsiteSourceMapUploadResourceHandle() ResourceHandle[*restapi.WebsiteSourceMapUpload] {
+	return &websiteSourceMapUploadResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaWebsiteSourceMapUpload,
+			Schema: map[string]*schema.Schema{
+				WebsiteSourceMapUploadFieldWebsiteID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "The id of the website the source map files belong to",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				WebsiteSourceMapUploadFieldSourceMapConfigID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "The id of the source map upload configuration of the website. The resource manages all files of the configuration",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				WebsiteSourceMapUploadFieldSourceMap: {
+					Type:        schema.TypeList,
+					Required:    true,
+					ForceNew:    true,
+					MinItems:    1,
+					Description: "The source map files which are uploaded to the source map upload configuration",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							WebsiteSourceMapUploadFieldURL: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								Description:  "The URL of the minified script the source map file belongs to",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							WebsiteSourceMapUploadFieldFileFormat: {
+								Type:        schema.TypeString,
+								Optional:    true,
+								ForceNew:    true,
+								Description: "The format of the uploaded file",
+							},
+							WebsiteSourceMapUploadFieldSourceMapFile: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								Description:  "The path of the source map file which is uploaded",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							WebsiteSourceMapUploadFieldSourceMapFileHash: {
+								Type:        schema.TypeString,
+								Optional:    true,
+								ForceNew:    true,
+								Description: "A hash of the source map file. The files are uploaded again when the hash changes",
+							},
+						},
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			CreateOnly:       true,
+		},
+	}
+}
+
+type websiteSourceMapUploadResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *websiteSourceMapUploadResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *websiteSourceMapUploadResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *websiteSourceMapUploadResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.WebsiteSourceMapUpload] {
+	return api.WebsiteSourceMapUploads()
+}
+
+func (r *websiteSourceMapUploadResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+// UpdateState updates the identifying fields only. The Instana API does not provide the content of uploaded files.
+// Therefore, the source map files are kept as stored in the state.
+func (r *websiteSourceMapUploadResource) UpdateState(d *schema.ResourceData, upload *restapi.WebsiteSourceMapUpload) error {
+	d.SetId(upload.GetIDForResourcePath())
+	return tfutils.UpdateState(d, map[string]interface{}{
+		WebsiteSourceMapUploadFieldWebsiteID:         upload.WebsiteID,
+		WebsiteSourceMapUploadFieldSourceMapConfigID: upload.SourceMapConfigID,
+	})
+}
+
+func (r *websiteSourceMapUploadResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.WebsiteSourceMapUpload, error) {
+	sourceMapsData := d.Get(WebsiteSourceMapUploadFieldSourceMap).([]interface{})
+	sourceMaps := make([]restapi.WebsiteSourceMap, len(sourceMapsData))
+	for i, sourceMapData := range sourceMapsData {
+		sourceMap := sourceMapData.(map[string]interface{})
+		var fileFormat *string
+		if format, ok := sourceMap[WebsiteSourceMapUploadFieldFileFormat].(string); ok && format != "" {
+			fileFormat = &format
+		}
+		sourceMaps[i] = restapi.WebsiteSourceMap{
+			URL:           sourceMap[WebsiteSourceMapUploadFieldURL].(string),
+			FileFormat:    fileFormat,
+			SourceMapFile: sourceMap[WebsiteSourceMapUploadFieldSourceMapFile].(string),
+		}
+	}
+	return &restapi.WebsiteSourceMapUpload{
+		WebsiteID:         d.Get(WebsiteSourceMapUploadFieldWebsiteID).(string),
+		SourceMapConfigID: d.Get(WebsiteSourceMapUploadFieldSourceMapConfigID).(string),
+		SourceMaps:        sourceMaps,
+	}, nil
+}
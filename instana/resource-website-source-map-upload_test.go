@@ -0,0 +1,284 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestWebsiteSourceMapUploadResource(t *testing.T) {
+	ut := &websiteSourceMapUploadUnitTest{}
+	t.Run("CRUD integration test", websiteSourceMapUploadIntegrationTest)
+	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
+	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
+	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
+	t.Run("should have no state upgrader", ut.shouldHaveNoStateUpgrader)
+	t.Run("should be create only", ut.shouldBeCreateOnly)
+	t.Run("should update resource state", ut.shouldUpdateResourceState)
+	t.Run("should keep stored files when updating resource state", ut.shouldKeepStoredFilesWhenUpdatingResourceState)
+	t.Run("should map state to data model", ut.shouldMapStateToDataModel)
+	t.Run("should map state to data model without file format", ut.shouldMapStateToDataModelWithoutFileFormat)
+}
+
+const (
+	websiteSourceMapUploadDefinition  = "instana_website_source_map_upload.example"
+	websiteSourceMapUploadWebsiteID   = "website-id"
+	websiteSourceMapUploadConfigID    = "source-map-config-id"
+	websiteSourceMapUploadURL         = "https://example.com/js/app.min.js"
+	websiteSourceMapUploadVendorURL   = "https://example.com/js/vendor.min.js"
+	websiteSourceMapUploadFileContent = `{"version":3,"mappings":""}`
+	websiteSourceMapUploadID          = websiteSourceMapUploadWebsiteID + ":" + websiteSourceMapUploadConfigID
+)
+
+const resourceWebsiteSourceMapUploadDefinitionTemplate = `
+resource "instana_website_source_map_upload" "example" {
+  website_id           = "website-id"
+  source_map_config_id = "source-map-config-id"
+
+  source_map {
+    url                  = "https://example.com/js/app.min.js"
+    file_format          = "js"
+    source_map_file      = "%s"
+    source_map_file_hash = "hash-%d"
+  }
+
+  source_map {
+    url             = "https://example.com/js/vendor.min.js"
+    source_map_file = "%s"
+  }
+}
+`
+
+func websiteSourceMapUploadIntegrationTest(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "app.min.js.map")
+	require.NoError(t, os.WriteFile(file, []byte(websiteSourceMapUploadFileContent), 0600))
+
+	var mutex sync.Mutex
+	uploads := 0
+	clears := 0
+	uploadedURLs := make([]string, 0)
+	configPath := restapi.WebsiteMonitoringConfigResourcePath + "/" + websiteSourceMapUploadWebsiteID + "/sourcemap-upload/" + websiteSourceMapUploadConfigID
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, restapi.WebsiteMonitoringConfigResourcePath+"/"+websiteSourceMapUploadWebsiteID, func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, []byte(`{"id":"website-id","name":"website","appName":"website"}`))
+	})
+	httpServer.AddRoute(http.MethodGet, configPath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		metadata := make([]map[string]string, len(uploadedURLs))
+		for i, url := range uploadedURLs {
+			metadata[i] = map[string]string{"url": url, "type": "JS_MAP", "format": "js"}
+		}
+		data, err := json.Marshal(map[string]interface{}{"id": websiteSourceMapUploadConfigID, "metadata": metadata})
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.AddRoute(http.MethodPut, configPath+"/form", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		url := r.FormValue("url")
+		if (url != websiteSourceMapUploadURL || r.FormValue("fileFormat") != "js") && url != websiteSourceMapUploadVendorURL {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		sourceMap, _, err := r.FormFile("sourceMap")
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		defer sourceMap.Close()
+		content, err := io.ReadAll(sourceMap)
+		if err != nil || string(content) != websiteSourceMapUploadFileContent {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		uploads++
+		uploadedURLs = append(uploadedURLs, url)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.AddRoute(http.MethodPut, configPath+"/clear", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		clears++
+		uploadedURLs = make([]string, 0)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createWebsiteSourceMapUploadTestStep(httpServer.GetPort(), file, 0),
+			{
+				ResourceName:            websiteSourceMapUploadDefinition,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{WebsiteSourceMapUploadFieldSourceMap},
+			},
+			createWebsiteSourceMapUploadTestStep(httpServer.GetPort(), file, 1),
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if uploads != 4 || clears != 2 {
+				return fmt.Errorf("expected 4 uploads and 2 clears but got %d uploads and %d clears", uploads, clears)
+			}
+			if len(uploadedURLs) != 0 {
+				return fmt.Errorf("expected all source map files to be removed but got %v", uploadedURLs)
+			}
+			return nil
+		},
+	})
+}
+
+func createWebsiteSourceMapUploadTestStep(httpPort int, file string, iteration int) resource.TestStep {
+	filePath := filepath.ToSlash(file)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(resourceWebsiteSourceMapUploadDefinitionTemplate, filePath, iteration, filePath), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(websiteSourceMapUploadDefinition, "id", websiteSourceMapUploadID),
+			resource.TestCheckResourceAttr(websiteSourceMapUploadDefinition, WebsiteSourceMapUploadFieldWebsiteID, websiteSourceMapUploadWebsiteID),
+			resource.TestCheckResourceAttr(websiteSourceMapUploadDefinition, WebsiteSourceMapUploadFieldSourceMapConfigID, websiteSourceMapUploadConfigID),
+			resource.TestCheckResourceAttr(websiteSourceMapUploadDefinition, "source_map.#", "2"),
+			resource.TestCheckResourceAttr(websiteSourceMapUploadDefinition, "source_map.0.url", websiteSourceMapUploadURL),
+			resource.TestCheckResourceAttr(websiteSourceMapUploadDefinition, "source_map.0.file_format", "js"),
+			resource.TestCheckResourceAttr(websiteSourceMapUploadDefinition, "source_map.0.source_map_file", filePath),
+			resource.TestCheckResourceAttr(websiteSourceMapUploadDefinition, "source_map.0.source_map_file_hash", fmt.Sprintf("hash-%d", iteration)),
+			resource.TestCheckResourceAttr(websiteSourceMapUploadDefinition, "source_map.1.url", websiteSourceMapUploadVendorURL),
+			resource.TestCheckResourceAttr(websiteSourceMapUploadDefinition, "source_map.1.source_map_file", filePath),
+		),
+	}
+}
+
+type websiteSourceMapUploadUnitTest struct{}
+
+func (ut *websiteSourceMapUploadUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
+	schemaMap := NewWebsiteSourceMapUploadResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 3)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteSourceMapUploadFieldWebsiteID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteSourceMapUploadFieldSourceMapConfigID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeListOfResource(WebsiteSourceMapUploadFieldSourceMap)
+	for key, fieldSchema := range schemaMap {
+		require.Truef(t, fieldSchema.ForceNew, "field %s should force a new resource", key)
+	}
+
+	sourceMapSchema := schemaMap[WebsiteSourceMapUploadFieldSourceMap].Elem.(*schema.Resource).Schema
+	sourceMapSchemaAssert := testutils.NewTerraformSchemaAssert(sourceMapSchema, t)
+	require.Len(t, sourceMapSchema, 4)
+	sourceMapSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteSourceMapUploadFieldURL)
+	sourceMapSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(WebsiteSourceMapUploadFieldFileFormat)
+	sourceMapSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteSourceMapUploadFieldSourceMapFile)
+	sourceMapSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(WebsiteSourceMapUploadFieldSourceMapFileHash)
+	for key, fieldSchema := range sourceMapSchema {
+		require.Truef(t, fieldSchema.ForceNew, "field %s should force a new resource", key)
+	}
+}
+
+func (ut *websiteSourceMapUploadUnitTest) shouldReturnCorrectResourceName(t *testing.T) {
+	require.Equal(t, "instana_website_source_map_upload", NewWebsiteSourceMapUploadResourceHandle().MetaData().ResourceName)
+}
+
+func (ut *websiteSourceMapUploadUnitTest) shouldHaveSchemaVersionZero(t *testing.T) {
+	require.Equal(t, 0, NewWebsiteSourceMapUploadResourceHandle().MetaData().SchemaVersion)
+}
+
+func (ut *websiteSourceMapUploadUnitTest) shouldHaveNoStateUpgrader(t *testing.T) {
+	require.Len(t, NewWebsiteSourceMapUploadResourceHandle().StateUpgraders(), 0)
+}
+
+func (ut *websiteSourceMapUploadUnitTest) shouldBeCreateOnly(t *testing.T) {
+	require.True(t, NewWebsiteSourceMapUploadResourceHandle().MetaData().CreateOnly)
+}
+
+func (ut *websiteSourceMapUploadUnitTest) shouldUpdateResourceState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteSourceMapUpload](t)
+	resourceHandle := NewWebsiteSourceMapUploadResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.WebsiteSourceMapUpload{
+		WebsiteID:         websiteSourceMapUploadWebsiteID,
+		SourceMapConfigID: websiteSourceMapUploadConfigID,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, websiteSourceMapUploadID, resourceData.Id())
+	require.Equal(t, websiteSourceMapUploadWebsiteID, resourceData.Get(WebsiteSourceMapUploadFieldWebsiteID))
+	require.Equal(t, websiteSourceMapUploadConfigID, resourceData.Get(WebsiteSourceMapUploadFieldSourceMapConfigID))
+	require.Equal(t, 0, resourceData.Get("source_map.#"))
+}
+
+func (ut *websiteSourceMapUploadUnitTest) shouldKeepStoredFilesWhenUpdatingResourceState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteSourceMapUpload](t)
+	resourceHandle := NewWebsiteSourceMapUploadResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	storedSourceMaps := []interface{}{
+		map[string]interface{}{WebsiteSourceMapUploadFieldURL: websiteSourceMapUploadURL, WebsiteSourceMapUploadFieldFileFormat: "js", WebsiteSourceMapUploadFieldSourceMapFile: "app.min.js.map", WebsiteSourceMapUploadFieldSourceMapFileHash: "hash"},
+	}
+	setValueOnResourceData(t, resourceData, WebsiteSourceMapUploadFieldSourceMap, storedSourceMaps)
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.WebsiteSourceMapUpload{
+		WebsiteID:         websiteSourceMapUploadWebsiteID,
+		SourceMapConfigID: websiteSourceMapUploadConfigID,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, storedSourceMaps, resourceData.Get(WebsiteSourceMapUploadFieldSourceMap))
+}
+
+func (ut *websiteSourceMapUploadUnitTest) shouldMapStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteSourceMapUpload](t)
+	resourceHandle := NewWebsiteSourceMapUploadResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, WebsiteSourceMapUploadFieldWebsiteID, websiteSourceMapUploadWebsiteID)
+	setValueOnResourceData(t, resourceData, WebsiteSourceMapUploadFieldSourceMapConfigID, websiteSourceMapUploadConfigID)
+	setValueOnResourceData(t, resourceData, WebsiteSourceMapUploadFieldSourceMap, []interface{}{
+		map[string]interface{}{WebsiteSourceMapUploadFieldURL: websiteSourceMapUploadURL, WebsiteSourceMapUploadFieldFileFormat: "js", WebsiteSourceMapUploadFieldSourceMapFile: "app.min.js.map"},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	fileFormat := "js"
+	require.Equal(t, &restapi.WebsiteSourceMapUpload{
+		WebsiteID:         websiteSourceMapUploadWebsiteID,
+		SourceMapConfigID: websiteSourceMapUploadConfigID,
+		SourceMaps: []restapi.WebsiteSourceMap{
+			{URL: websiteSourceMapUploadURL, FileFormat: &fileFormat, SourceMapFile: "app.min.js.map"},
+		},
+	}, result)
+}
+
+func (ut *websiteSourceMapUploadUnitTest) shouldMapStateToDataModelWithoutFileFormat(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteSourceMapUpload](t)
+	resourceHandle := NewWebsiteSourceMapUploadResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, WebsiteSourceMapUploadFieldSourceMap, []interface{}{
+		map[string]interface{}{WebsiteSourceMapUploadFieldURL: websiteSourceMapUploadURL, WebsiteSourceMapUploadFieldSourceMapFile: "app.min.js.map"},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Len(t, result.SourceMaps, 1)
+	require.Nil(t, result.SourceMaps[0].FileFormat)
+}
@@ -1,5 +1,10 @@
 package restapi
 
+import (
+	"context"
+	"net/url"
+)
+
 const (
 	//InstanaAPIBasePath path to Instana RESTful API
 	InstanaAPIBasePath = "/api"
@@ -15,6 +20,8 @@ const (
 	RBACSettingsBasePath = SettingsBasePath + "/rbac"
 	//WebsiteMonitoringResourcePath path to website monitoring
 	WebsiteMonitoringResourcePath = InstanaAPIBasePath + "/website-monitoring"
+	//MobileAppMonitoringResourcePath path to mobile app monitoring
+	MobileAppMonitoringResourcePath = InstanaAPIBasePath + "/mobile-app-monitoring"
 	//SyntheticSettingsBasePath path to synthetic monitoring
 	SyntheticSettingsBasePath = InstanaAPIBasePath + "/synthetics" + settingsPathElement
 	//SyntheticTestResourcePath path to synthetic monitoring tests
@@ -43,20 +50,48 @@ type InstanaAPI interface {
 	SloCorrectionConfig() RestResource[*SloCorrectionConfig]
 	WebsiteMonitoringConfig() RestResource[*WebsiteMonitoringConfig]
 	WebsiteAlertConfig() RestResource[*WebsiteAlertConfig]
+	WebsiteSourceMapUploads() RestResource[*WebsiteSourceMapUpload]
 	InfraAlertConfig() RestResource[*InfraAlertConfig]
 	Groups() RestResource[*Group]
+	GroupMappings() RestResource[*GroupMapping]
+	GroupMappingSettings() RestResource[*GroupMappingSettings]
+	GroupMemberships() RestResource[*GroupMembership]
+	UserInvitations() RestResource[*UserInvitation]
+	Users() ReadOnlyRestResource[*User]
+	UsersOverview() ReadOnlyRestResource[*User]
+	UserGroups(email string) ReadOnlyRestResource[*Group]
+	SessionSettings() RestResource[*SessionSettings]
+	SyntheticCallsSettings() RestResource[*SyntheticCallsSettings]
 	CustomDashboards() RestResource[*CustomDashboard]
 	SyntheticTest() RestResource[*SyntheticTest]
 	SyntheticLocation() ReadOnlyRestResource[*SyntheticLocation]
+	SyntheticCredentials() RestResource[*SyntheticCredential]
 	AutomationActions() RestResource[*AutomationAction]
 	AutomationPolicies() RestResource[*AutomationPolicy]
 	HostAgents() ReadOnlyRestResource[*HostAgent]
+	MaintenanceWindowConfigs() RestResource[*MaintenanceWindowConfig]
+	MobileAppConfigs() RestResource[*MobileAppConfig]
+	MobileAppAlertConfigs() RestResource[*MobileAppAlertConfig]
+	Releases() RestResource[*Release]
+	HTTPEndpointConfigs() RestResource[*HTTPEndpointConfig]
+	ManualServiceConfigs() RestResource[*ManualServiceConfig]
+	ServiceConfigs() RestResource[*ServiceConfig]
+	ApdexConfigs() RestResource[*ApdexConfig]
+	ApdexReports(apdexID string) ReadOnlyRestResource[*ApdexReport]
+	GlobalSyntheticAlertConfigs() RestResource[*SyntheticAlertConfig]
+	GlobalCustomPayloadConfiguration() RestResource[*GlobalCustomPayloadConfiguration]
+	CustomPayloadTagCatalog(ctx context.Context) (*CustomPayloadTagCatalog, error)
+	Version(ctx context.Context) (*VersionInfo, error)
+	Health(ctx context.Context) (*HealthState, error)
 }
 
 // NewInstanaAPI creates a new instance of the instana API
-func NewInstanaAPI(apiToken string, endpoint string, skipTlsVerification bool) InstanaAPI {
-	client := NewClient(apiToken, endpoint, skipTlsVerification)
-	return &baseInstanaAPI{client: client}
+func NewInstanaAPI(config ClientConfig) (InstanaAPI, error) {
+	client, err := NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &baseInstanaAPI{client: client}, nil
 }
 
 type baseInstanaAPI struct {
@@ -135,6 +170,51 @@ func (api *baseInstanaAPI) Groups() RestResource[*Group] {
 	return NewCreatePOSTUpdatePUTRestResource(GroupsResourcePath, NewDefaultJSONUnmarshaller(&Group{}), api.client)
 }
 
+// GroupMappings implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GroupMappings() RestResource[*GroupMapping] {
+	return NewGroupMappingRestResource(NewDefaultJSONUnmarshaller(&GroupMapping{}), api.client)
+}
+
+// GroupMappingSettings implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GroupMappingSettings() RestResource[*GroupMappingSettings] {
+	return NewSingletonRestResourceWithDefaults(GroupMappingSettingsResourcePath, NewDefaultJSONUnmarshaller(&GroupMappingSettings{}), api.client, &GroupMappingSettings{RestrictEmptyIdpGroups: false})
+}
+
+// GroupMemberships implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GroupMemberships() RestResource[*GroupMembership] {
+	return NewGroupMembershipRestResource(NewDefaultJSONUnmarshaller(&Group{}), api.client)
+}
+
+// UserInvitations implementation of InstanaAPI interface
+func (api *baseInstanaAPI) UserInvitations() RestResource[*UserInvitation] {
+	return NewUserInvitationRestResource(NewDefaultJSONUnmarshaller(&UserInvitation{}), api.client)
+}
+
+// Users implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Users() ReadOnlyRestResource[*User] {
+	return NewReadOnlyRestResource(UsersResourcePath, NewDefaultJSONUnmarshaller(&User{}), api.client)
+}
+
+// UsersOverview implementation of InstanaAPI interface. The overview returns the users wrapped in a users attribute.
+func (api *baseInstanaAPI) UsersOverview() ReadOnlyRestResource[*User] {
+	return NewReadOnlyRestResource(UsersOverviewResourcePath, NewUsersOverviewJSONUnmarshaller(), api.client)
+}
+
+// SessionSettings implementation of InstanaAPI interface
+func (api *baseInstanaAPI) SessionSettings() RestResource[*SessionSettings] {
+	return NewSingletonRestResource(SessionSettingsResourcePath, NewDefaultJSONUnmarshaller(&SessionSettings{}), api.client)
+}
+
+// SyntheticCallsSettings implementation of InstanaAPI interface
+func (api *baseInstanaAPI) SyntheticCallsSettings() RestResource[*SyntheticCallsSettings] {
+	return NewSingletonRestResource(SyntheticCallsSettingsResourcePath, NewDefaultJSONUnmarshaller(&SyntheticCallsSettings{}), api.client)
+}
+
+// UserGroups implementation of InstanaAPI interface
+func (api *baseInstanaAPI) UserGroups(email string) ReadOnlyRestResource[*Group] {
+	return NewReadOnlyRestResource(UserGroupsResourcePath+"/"+url.PathEscape(email), NewDefaultJSONUnmarshaller(&Group{}), api.client)
+}
+
 func (api *baseInstanaAPI) CustomDashboards() RestResource[*CustomDashboard] {
 	return NewCreatePOSTUpdatePUTRestResource(CustomDashboardsResourcePath, NewDefaultJSONUnmarshaller(&CustomDashboard{}), api.client)
 }
@@ -148,6 +228,11 @@ func (api *baseInstanaAPI) SyntheticLocation() ReadOnlyRestResource[*SyntheticLo
 	return NewReadOnlyRestResource(SyntheticLocationResourcePath, NewDefaultJSONUnmarshaller(&SyntheticLocation{}), api.client)
 }
 
+// SyntheticCredentials implementation of InstanaAPI interface
+func (api *baseInstanaAPI) SyntheticCredentials() RestResource[*SyntheticCredential] {
+	return NewSyntheticCredentialRestResource(api.client)
+}
+
 func (api *baseInstanaAPI) AutomationActions() RestResource[*AutomationAction] {
 	return NewCreatePOSTUpdatePUTRestResource(AutomationActionResourcePath, NewDefaultJSONUnmarshaller(&AutomationAction{}), api.client)
 }
@@ -159,3 +244,64 @@ func (api *baseInstanaAPI) AutomationPolicies() RestResource[*AutomationPolicy]
 func (api *baseInstanaAPI) HostAgents() ReadOnlyRestResource[*HostAgent] {
 	return NewReadOnlyRestResource(HostAgentResourcePath, NewHostAgentJSONUnmarshaller(&HostAgent{}), api.client)
 }
+
+// MaintenanceWindowConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MaintenanceWindowConfigs() RestResource[*MaintenanceWindowConfig] {
+	return NewMaintenanceWindowConfigRestResource(NewDefaultJSONUnmarshaller(&MaintenanceWindowConfig{}), api.client)
+}
+
+// MobileAppConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MobileAppConfigs() RestResource[*MobileAppConfig] {
+	return NewMobileAppConfigRestResource(NewDefaultJSONUnmarshaller(&MobileAppConfig{}), api.client)
+}
+
+// MobileAppAlertConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MobileAppAlertConfigs() RestResource[*MobileAppAlertConfig] {
+	return NewCreatePOSTUpdatePOSTRestResource(MobileAppAlertConfigResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&MobileAppAlertConfig{})), api.client)
+}
+
+// GlobalSyntheticAlertConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GlobalSyntheticAlertConfigs() RestResource[*SyntheticAlertConfig] {
+	return NewCreatePOSTUpdatePOSTRestResource(GlobalSyntheticAlertConfigResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&SyntheticAlertConfig{})), api.client)
+}
+
+// Releases implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Releases() RestResource[*Release] {
+	return NewCreatePOSTUpdatePUTRestResource(ReleasesResourcePath, NewDefaultJSONUnmarshaller(&Release{}), api.client)
+}
+
+// HTTPEndpointConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) HTTPEndpointConfigs() RestResource[*HTTPEndpointConfig] {
+	return NewCreatePOSTUpdatePUTRestResource(HTTPEndpointConfigResourcePath, NewDefaultJSONUnmarshaller(&HTTPEndpointConfig{}), api.client)
+}
+
+// ManualServiceConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ManualServiceConfigs() RestResource[*ManualServiceConfig] {
+	return NewManualServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ManualServiceConfig{}), api.client)
+}
+
+// ServiceConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ServiceConfigs() RestResource[*ServiceConfig] {
+	return NewServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ServiceConfig{}), api.client)
+}
+
+// ApdexConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ApdexConfigs() RestResource[*ApdexConfig] {
+	return NewCreatePOSTUpdateNotSupportedRestResource(ApdexConfigResourcePath, NewDefaultJSONUnmarshaller(&ApdexConfig{}), api.client)
+}
+
+// ApdexReports implementation of InstanaAPI interface. The resource provides the reports of the Apdex configuration
+// with the given ID
+func (api *baseInstanaAPI) ApdexReports(apdexID string) ReadOnlyRestResource[*ApdexReport] {
+	return NewReadOnlyRestResource(ApdexReportResourcePath+"/"+url.PathEscape(apdexID), NewDefaultJSONUnmarshaller(&ApdexReport{}), api.client)
+}
+
+// WebsiteSourceMapUploads implementation of InstanaAPI interface
+func (api *baseInstanaAPI) WebsiteSourceMapUploads() RestResource[*WebsiteSourceMapUpload] {
+	return NewWebsiteSourceMapUploadRestResource(api.client)
+}
+
+// GlobalCustomPayloadConfiguration implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GlobalCustomPayloadConfiguration() RestResource[*GlobalCustomPayloadConfiguration] {
+	return NewSingletonRestResource(GlobalCustomPayloadConfigurationResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&GlobalCustomPayloadConfiguration{})), api.client)
+}
@@ -8,7 +8,8 @@ import (
 )
 
 func TestShouldReturnResourcesFromInstanaAPI(t *testing.T) {
-	api := NewInstanaAPI("api-token", "endpoint", false)
+	api, err := NewInstanaAPI(ClientConfig{APIToken: "api-token", Endpoint: "endpoint", Retry: DefaultRetryConfig(), Throttle: DefaultThrottleConfig()})
+	require.NoError(t, err)
 
 	t.Run("Should return CustomEventSpecification instance", func(t *testing.T) {
 		resource := api.CustomEventSpecifications()
@@ -86,5 +87,122 @@ func TestShouldReturnResourcesFromInstanaAPI(t *testing.T) {
 
 		require.NotNil(t, resource)
 	})
+	t.Run("Should return SyntheticCredential instance", func(t *testing.T) {
+		resource := api.SyntheticCredentials()
 
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MaintenanceWindowConfig instance", func(t *testing.T) {
+		resource := api.MaintenanceWindowConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MobileAppConfig instance", func(t *testing.T) {
+		resource := api.MobileAppConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MobileAppAlertConfig instance", func(t *testing.T) {
+		resource := api.MobileAppAlertConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return GlobalSyntheticAlertConfig instance", func(t *testing.T) {
+		resource := api.GlobalSyntheticAlertConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return Release instance", func(t *testing.T) {
+		resource := api.Releases()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return HTTPEndpointConfig instance", func(t *testing.T) {
+		resource := api.HTTPEndpointConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ManualServiceConfig instance", func(t *testing.T) {
+		resource := api.ManualServiceConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ServiceConfig instance", func(t *testing.T) {
+		resource := api.ServiceConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ApdexConfig instance", func(t *testing.T) {
+		resource := api.ApdexConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ApdexReport instance", func(t *testing.T) {
+		resource := api.ApdexReports("apdex-id")
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return GlobalCustomPayloadConfiguration instance", func(t *testing.T) {
+		resource := api.GlobalCustomPayloadConfiguration()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return GroupMapping instance", func(t *testing.T) {
+		resource := api.GroupMappings()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return GroupMappingSettings instance", func(t *testing.T) {
+		resource := api.GroupMappingSettings()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return GroupMembership instance", func(t *testing.T) {
+		resource := api.GroupMemberships()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return UserInvitation instance", func(t *testing.T) {
+		resource := api.UserInvitations()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return Users instance", func(t *testing.T) {
+		resource := api.Users()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return UsersOverview instance", func(t *testing.T) {
+		resource := api.UsersOverview()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return UserGroups instance", func(t *testing.T) {
+		resource := api.UserGroups("user@example.com")
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return SessionSettings instance", func(t *testing.T) {
+		resource := api.SessionSettings()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return SyntheticCallsSettings instance", func(t *testing.T) {
+		resource := api.SyntheticCallsSettings()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return WebsiteSourceMapUpload instance", func(t *testing.T) {
+		resource := api.WebsiteSourceMapUploads()
+
+		require.NotNil(t, resource)
+	})
+
+}
+
+func TestShouldFailToCreateInstanaAPIWhenClientConfigIsInvalid(t *testing.T) {
+	api, err := NewInstanaAPI(ClientConfig{APIToken: "api-token", Endpoint: "ftp://endpoint", Retry: DefaultRetryConfig(), Throttle: DefaultThrottleConfig()})
+
+	require.Error(t, err)
+	require.Nil(t, api)
 }
@@ -0,0 +1,47 @@
+package restapi
+
+// ApdexConfigResourcePath path to apdex config resource of Instana RESTful API
+const ApdexConfigResourcePath = SettingsBasePath + "/apdex"
+
+// ApdexConfig data structure of an Apdex configuration of the Instana API
+type ApdexConfig struct {
+	ID        string      `json:"id,omitempty"`
+	Name      string      `json:"apdexName"`
+	Entity    ApdexEntity `json:"apdexEntity"`
+	CreatedAt *int64      `json:"createdAt,omitempty"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *ApdexConfig) GetIDForResourcePath() string {
+	return c.ID
+}
+
+// ApdexEntity data structure of the entity of an Apdex configuration of the Instana API. The fields BoundaryScope,
+// IncludeInternal and IncludeSynthetic are only supported for applications and the field BeaconType is only supported
+// for websites
+type ApdexEntity struct {
+	Type                ApdexEntityType `json:"apdexType"`
+	EntityID            string          `json:"entityId"`
+	TagFilterExpression *TagFilter      `json:"tagFilterExpression"`
+	Threshold           int32           `json:"threshold"`
+	BoundaryScope       *BoundaryScope  `json:"boundaryScope,omitempty"`
+	IncludeInternal     *bool           `json:"includeInternal,omitempty"`
+	IncludeSynthetic    *bool           `json:"includeSynthetic,omitempty"`
+	BeaconType          *string         `json:"beaconType,omitempty"`
+}
+
+// ApdexEntityType custom type for the type of the entity of an Apdex configuration
+type ApdexEntityType string
+
+const (
+	//ApdexEntityTypeApplication constant value for the application ApdexEntityType
+	ApdexEntityTypeApplication = ApdexEntityType("application")
+	//ApdexEntityTypeWebsite constant value for the website ApdexEntityType
+	ApdexEntityTypeWebsite = ApdexEntityType("website")
+)
+
+// SupportedApdexApplicationBoundaryScopes list of all BoundaryScope supported by Apdex configurations of applications
+var SupportedApdexApplicationBoundaryScopes = BoundaryScopes{BoundaryScopeAll, BoundaryScopeInbound}
+
+// SupportedApdexWebsiteBeaconTypes list of all beacon types supported by Apdex configurations of websites
+var SupportedApdexWebsiteBeaconTypes = []string{"pageLoad", "resourceLoad", "httpRequest", "error", "custom", "pageChange"}
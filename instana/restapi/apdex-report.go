@@ -0,0 +1,18 @@
+package restapi
+
+// ApdexReportResourcePath path to apdex report resource of Instana RESTful API
+const ApdexReportResourcePath = InstanaAPIBasePath + "/apdex/report"
+
+// ApdexReport data structure of an Apdex report of the Instana API. The scores are provided as pairs of timestamp in
+// milliseconds and Apdex score
+type ApdexReport struct {
+	ApdexID    string      `json:"apdexId"`
+	ApdexScore [][]float64 `json:"apdexScore"`
+	From       int64       `json:"from"`
+	To         int64       `json:"to"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *ApdexReport) GetIDForResourcePath() string {
+	return r.ApdexID
+}
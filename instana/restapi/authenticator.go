@@ -0,0 +1,157 @@
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// AuthorizationScheme the scheme of the Authorization header sent to the Instana API
+type AuthorizationScheme string
+
+// AuthorizationSchemes custom type for a slice of AuthorizationScheme
+type AuthorizationSchemes []AuthorizationScheme
+
+// ToStringSlice Returns the corresponding string representations
+func (schemes AuthorizationSchemes) ToStringSlice() []string {
+	result := make([]string, len(schemes))
+	for i, v := range schemes {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//AuthorizationSchemeAPIToken the scheme used for API tokens created in Instana
+	AuthorizationSchemeAPIToken = AuthorizationScheme("apiToken")
+	//AuthorizationSchemeBearer the scheme used for OAuth style bearer tokens
+	AuthorizationSchemeBearer = AuthorizationScheme("Bearer")
+)
+
+// SupportedAuthorizationSchemes list of all supported AuthorizationScheme
+var SupportedAuthorizationSchemes = AuthorizationSchemes{AuthorizationSchemeAPIToken, AuthorizationSchemeBearer}
+
+// TokenSource provides the token used to authenticate at the Instana API
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// NewStaticTokenSource creates a TokenSource which always returns the given token
+func NewStaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(_ context.Context) (string, error) {
+	return string(s), nil
+}
+
+// NewFileTokenSource creates a TokenSource which reads the token from the given file. The file is read again each
+// time a token is requested so that rotated tokens are picked up.
+func NewFileTokenSource(path string) TokenSource {
+	return &fileTokenSource{path: path}
+}
+
+type fileTokenSource struct {
+	path string
+}
+
+func (s *fileTokenSource) Token(_ context.Context) (string, error) {
+	content, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read API token from file %s; %w", s.path, err)
+	}
+	return requireToken(string(content), fmt.Sprintf("file %s", s.path))
+}
+
+// NewCommandTokenSource creates a TokenSource which executes the given command and uses its standard output as token.
+// The first element is the executable and the remaining elements are its arguments.
+func NewCommandTokenSource(command []string) TokenSource {
+	return &commandTokenSource{command: command}
+}
+
+type commandTokenSource struct {
+	command []string
+}
+
+func (s *commandTokenSource) Token(ctx context.Context) (string, error) {
+	if len(s.command) == 0 {
+		return "", errors.New("failed to get API token from command; no command provided")
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.command[0], s.command[1:]...) //nolint:gosec
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("failed to get API token from command %s; %w; %s", s.command[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return requireToken(stdout.String(), fmt.Sprintf("command %s", s.command[0]))
+}
+
+func requireToken(value string, source string) (string, error) {
+	token := strings.TrimSpace(value)
+	if token == "" {
+		return "", fmt.Errorf("API token provided by %s is empty", source)
+	}
+	return token, nil
+}
+
+// Authenticator provides the Authorization header for requests to the Instana API
+type Authenticator interface {
+	//AuthorizationHeader returns the value of the Authorization header
+	AuthorizationHeader(ctx context.Context) (string, error)
+	//Refresh is called when the Instana API rejected the given Authorization header. Returns true when a different
+	//Authorization header is available and the request should be repeated.
+	Refresh(ctx context.Context, rejectedHeader string) (bool, error)
+}
+
+// NewTokenAuthenticator creates a new Authenticator sending the token of the given TokenSource with the given scheme.
+// The token is cached and only requested again from the TokenSource when the Instana API rejects it.
+func NewTokenAuthenticator(scheme AuthorizationScheme, tokenSource TokenSource) Authenticator {
+	return &tokenAuthenticator{scheme: scheme, tokenSource: tokenSource}
+}
+
+type tokenAuthenticator struct {
+	mu          sync.Mutex
+	scheme      AuthorizationScheme
+	tokenSource TokenSource
+	header      string
+}
+
+func (a *tokenAuthenticator) AuthorizationHeader(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.header == "" {
+		return a.loadHeader(ctx)
+	}
+	return a.header, nil
+}
+
+func (a *tokenAuthenticator) Refresh(ctx context.Context, rejectedHeader string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.header != "" && a.header != rejectedHeader {
+		//already refreshed by a concurrent request
+		return true, nil
+	}
+	header, err := a.loadHeader(ctx)
+	if err != nil {
+		return false, err
+	}
+	return header != rejectedHeader, nil
+}
+
+func (a *tokenAuthenticator) loadHeader(ctx context.Context) (string, error) {
+	token, err := a.tokenSource.Token(ctx)
+	if err != nil {
+		return "", err
+	}
+	a.header = fmt.Sprintf("%s %s", a.scheme, token)
+	return a.header, nil
+}
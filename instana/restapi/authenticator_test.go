@@ -0,0 +1,114 @@
+package restapi_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReturnStringRepresentationOfSupportedAuthorizationSchemes(t *testing.T) {
+	require.Equal(t, []string{"apiToken", "Bearer"}, SupportedAuthorizationSchemes.ToStringSlice())
+}
+
+func TestShouldProvideAuthorizationHeaderWithStaticToken(t *testing.T) {
+	sut := NewTokenAuthenticator(AuthorizationSchemeAPIToken, NewStaticTokenSource("api-token"))
+
+	header, err := sut.AuthorizationHeader(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "apiToken api-token", header)
+
+	refreshed, err := sut.Refresh(context.Background(), header)
+	require.NoError(t, err)
+	require.False(t, refreshed)
+}
+
+func TestShouldReadTokenFromFileAndRefreshItWhenRejected(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	writeTokenFile(t, tokenFile, "token-1\n")
+	sut := NewTokenAuthenticator(AuthorizationSchemeBearer, NewFileTokenSource(tokenFile))
+
+	header, err := sut.AuthorizationHeader(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "Bearer token-1", header)
+
+	writeTokenFile(t, tokenFile, "token-2")
+	header, err = sut.AuthorizationHeader(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "Bearer token-1", header, "token should be cached until it is rejected")
+
+	refreshed, err := sut.Refresh(context.Background(), header)
+	require.NoError(t, err)
+	require.True(t, refreshed)
+	header, err = sut.AuthorizationHeader(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "Bearer token-2", header)
+}
+
+func TestShouldNotReloadTokenWhenRejectedTokenWasAlreadyRefreshedByAnotherRequest(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	writeTokenFile(t, tokenFile, "token-1")
+	sut := NewTokenAuthenticator(AuthorizationSchemeAPIToken, NewFileTokenSource(tokenFile))
+	rejectedHeader, err := sut.AuthorizationHeader(context.Background())
+	require.NoError(t, err)
+	writeTokenFile(t, tokenFile, "token-2")
+	_, err = sut.Refresh(context.Background(), rejectedHeader)
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(tokenFile))
+
+	refreshed, err := sut.Refresh(context.Background(), rejectedHeader)
+
+	require.NoError(t, err)
+	require.True(t, refreshed)
+}
+
+func TestShouldFailToProvideAuthorizationHeaderWhenTokenFileDoesNotExist(t *testing.T) {
+	sut := NewTokenAuthenticator(AuthorizationSchemeAPIToken, NewFileTokenSource("/not/existing/token"))
+
+	_, err := sut.AuthorizationHeader(context.Background())
+
+	require.ErrorContains(t, err, "failed to read API token from file /not/existing/token")
+}
+
+func TestShouldFailToProvideAuthorizationHeaderWhenTokenFileIsEmpty(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	writeTokenFile(t, tokenFile, " \n")
+	sut := NewTokenAuthenticator(AuthorizationSchemeAPIToken, NewFileTokenSource(tokenFile))
+
+	_, err := sut.AuthorizationHeader(context.Background())
+
+	require.ErrorContains(t, err, "API token provided by file "+tokenFile+" is empty")
+}
+
+func TestShouldGetTokenFromCommand(t *testing.T) {
+	sut := NewTokenAuthenticator(AuthorizationSchemeAPIToken, NewCommandTokenSource([]string{"echo", "command-token"}))
+
+	header, err := sut.AuthorizationHeader(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, "apiToken command-token", header)
+}
+
+func TestShouldFailToGetTokenFromCommandWhenCommandFails(t *testing.T) {
+	sut := NewTokenAuthenticator(AuthorizationSchemeAPIToken, NewCommandTokenSource([]string{"sh", "-c", "echo 'access denied' >&2; exit 1"}))
+
+	_, err := sut.AuthorizationHeader(context.Background())
+
+	require.ErrorContains(t, err, "failed to get API token from command sh")
+	require.ErrorContains(t, err, "access denied")
+}
+
+func TestShouldFailToGetTokenFromCommandWhenNoCommandIsProvided(t *testing.T) {
+	sut := NewTokenAuthenticator(AuthorizationSchemeAPIToken, NewCommandTokenSource([]string{}))
+
+	_, err := sut.AuthorizationHeader(context.Background())
+
+	require.ErrorContains(t, err, "no command provided")
+}
+
+func writeTokenFile(t *testing.T, path string, token string) {
+	require.NoError(t, os.WriteFile(path, []byte(token), 0600))
+}
@@ -1,6 +1,9 @@
 package restapi
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/gessnerfl/terraform-provider-instana/utils"
@@ -56,10 +59,31 @@ func NewCreatePUTUpdateNotSupportedRestResource[T InstanaDataObject](resourcePat
 	}
 }
 
+// NewSingletonRestResource creates a new REST resource using the provided unmarshaller function to convert the response from the REST API to the corresponding InstanaDataObject. The REST resource is used for endpoints which exist exactly once per tenant and are not identified by an ID. Create and update are implemented as HTTP PUT and delete resets the endpoint to its defaults using HTTP DELETE. The ID of the singleton is provided by the InstanaDataObject itself.
+func NewSingletonRestResource[T InstanaDataObject](resourcePath string, unmarshaller JSONUnmarshaller[T], client RestClient) RestResource[T] {
+	return &defaultRestResource[T]{
+		mode:         DefaultRestResourceModeSingleton,
+		resourcePath: resourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+// NewSingletonRestResourceWithDefaults creates a new REST resource using the provided unmarshaller function to convert the response from the REST API to the corresponding InstanaDataObject. The REST resource is used for endpoints which exist exactly once per tenant, are not identified by an ID and do not support HTTP DELETE. Create and update are implemented as HTTP PUT and delete resets the endpoint by sending the provided defaults using HTTP PUT. The ID of the singleton is provided by the InstanaDataObject itself.
+func NewSingletonRestResourceWithDefaults[T InstanaDataObject](resourcePath string, unmarshaller JSONUnmarshaller[T], client RestClient, defaults T) RestResource[T] {
+	return &defaultRestResource[T]{
+		mode:         DefaultRestResourceModeSingletonResetByPUT,
+		resourcePath: resourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+		defaults:     defaults,
+	}
+}
+
 // DefaultRestResourceMode custom type for create/update behavior of the defaultRestResource
 type DefaultRestResourceMode string
 
-type restClientOperation func(InstanaDataObject, string) ([]byte, error)
+type restClientOperation func(context.Context, InstanaDataObject, string) ([]byte, error)
 
 const (
 	//DefaultRestResourceModeCreateAndUpdatePUT constant value for the DefaultRestResourceMode CREATE_PUT_UPDATE_PUT where create and update is implemented as an upsert using HTTP PUT method only
@@ -72,6 +96,10 @@ const (
 	DefaultRestResourceModeCreateAndUpdatePOST = DefaultRestResourceMode("CREATE_POST_UPDATE_POST")
 	//DefaultRestResourceModeCreatePOSTAndUpdateNotSupported constant value for the DefaultRestResourceMode CREATE_POST_UPDATE_NOT_SUPPORTED where create is implemented as an HTTP POST method and update is not supported and returns an error
 	DefaultRestResourceModeCreatePOSTAndUpdateNotSupported = DefaultRestResourceMode("CREATE_POST_UPDATE_NOT_SUPPORTED")
+	//DefaultRestResourceModeSingleton constant value for the DefaultRestResourceMode SINGLETON where the resource path does not contain an ID, create and update are implemented as HTTP PUT method and delete resets the resource using HTTP DELETE method
+	DefaultRestResourceModeSingleton = DefaultRestResourceMode("SINGLETON")
+	//DefaultRestResourceModeSingletonResetByPUT constant value for the DefaultRestResourceMode SINGLETON_RESET_BY_PUT where the resource path does not contain an ID, create and update are implemented as HTTP PUT method and delete resets the resource by sending the defaults using HTTP PUT method
+	DefaultRestResourceModeSingletonResetByPUT = DefaultRestResourceMode("SINGLETON_RESET_BY_PUT")
 )
 
 type defaultRestResource[T InstanaDataObject] struct {
@@ -79,10 +107,22 @@ type defaultRestResource[T InstanaDataObject] struct {
 	resourcePath string
 	unmarshaller JSONUnmarshaller[T]
 	client       RestClient
+	defaults     T
+}
+
+func (r *defaultRestResource[T]) isSingleton() bool {
+	return r.mode == DefaultRestResourceModeSingleton || r.mode == DefaultRestResourceModeSingletonResetByPUT
 }
 
-func (r *defaultRestResource[T]) GetAll() (*[]T, error) {
-	data, err := r.client.Get(r.resourcePath)
+func (r *defaultRestResource[T]) GetAll(ctx context.Context) (*[]T, error) {
+	if r.isSingleton() {
+		object, err := r.getSingleton(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &[]T{object}, nil
+	}
+	data, err := r.client.Get(ctx, r.resourcePath)
 	if err != nil {
 		return nil, err
 	}
@@ -93,24 +133,47 @@ func (r *defaultRestResource[T]) GetAll() (*[]T, error) {
 	return objects, nil
 }
 
-func (r *defaultRestResource[T]) GetOne(id string) (T, error) {
-	data, err := r.client.GetOne(id, r.resourcePath)
+func (r *defaultRestResource[T]) GetOne(ctx context.Context, id string) (T, error) {
+	if r.isSingleton() {
+		object, err := r.getSingleton(ctx)
+		if err != nil {
+			return utils.GetZeroValue[T](), err
+		}
+		if object.GetIDForResourcePath() != id {
+			return utils.GetZeroValue[T](), ErrEntityNotFound
+		}
+		return object, nil
+	}
+	data, err := r.client.GetOne(ctx, id, r.resourcePath)
 	if err != nil {
 		return utils.GetZeroValue[T](), err
 	}
 	return r.validateResponseAndConvertToStruct(data)
 }
 
-func (r *defaultRestResource[T]) Create(data T) (T, error) {
+func (r *defaultRestResource[T]) getSingleton(ctx context.Context) (T, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
+	if err != nil {
+		return utils.GetZeroValue[T](), err
+	}
+	return r.validateResponseAndConvertToStruct(data)
+}
+
+func (r *defaultRestResource[T]) Create(ctx context.Context, data T) (T, error) {
+	if r.isSingleton() {
+		return r.putSingleton(ctx, data)
+	}
 	if r.mode == DefaultRestResourceModeCreateAndUpdatePUT || r.mode == DefaultRestResourceModeCreatePUTAndUpdateNotSupported {
-		return r.upsert(data, r.client.Put)
+		return r.upsert(ctx, data, r.client.Put)
 	}
-	return r.upsert(data, r.client.Post)
+	return r.upsert(ctx, data, r.client.Post)
 }
 
-func (r *defaultRestResource[T]) Update(data T) (T, error) {
-	if r.mode == DefaultRestResourceModeCreateAndUpdatePOST {
-		return r.upsert(data, r.client.PostWithID)
+func (r *defaultRestResource[T]) Update(ctx context.Context, data T) (T, error) {
+	if r.isSingleton() {
+		return r.putSingleton(ctx, data)
+	} else if r.mode == DefaultRestResourceModeCreateAndUpdatePOST {
+		return r.upsert(ctx, data, r.client.PostWithID)
 	} else if r.mode == DefaultRestResourceModeCreatePOSTAndUpdateNotSupported || r.mode == DefaultRestResourceModeCreatePUTAndUpdateNotSupported {
 		emptyObject, err := r.unmarshaller.Unmarshal([]byte("{}"))
 		if err != nil {
@@ -118,14 +181,43 @@ func (r *defaultRestResource[T]) Update(data T) (T, error) {
 		}
 		return emptyObject, fmt.Errorf("update is not supported for %s", r.resourcePath)
 	}
-	return r.upsert(data, r.client.Put)
+	return r.upsert(ctx, data, r.client.Put)
+}
+
+func (r *defaultRestResource[T]) upsert(ctx context.Context, data T, operation restClientOperation) (T, error) {
+	response, err := operation(ctx, data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	return r.validateResponseAndConvertToStruct(response)
 }
 
-func (r *defaultRestResource[T]) upsert(data T, operation restClientOperation) (T, error) {
-	response, err := operation(data, r.resourcePath)
+// putSingleton sends the given data using HTTP PUT without an ID in the resource path. Singleton endpoints may respond
+// without content or with an array containing the stored object. The provided data is returned when the response does
+// not contain the stored object.
+func (r *defaultRestResource[T]) putSingleton(ctx context.Context, data T) (T, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return data, err
+	}
+	response, err := r.client.PutContent(ctx, r.resourcePath, encodingApplicationJSON, payload)
 	if err != nil {
 		return data, err
 	}
+	response = bytes.TrimSpace(response)
+	if len(response) == 0 {
+		return data, nil
+	}
+	if response[0] == '[' {
+		objects, err := r.unmarshaller.UnmarshalArray(response)
+		if err != nil {
+			return data, err
+		}
+		if objects == nil || len(*objects) == 0 {
+			return data, nil
+		}
+		return (*objects)[0], nil
+	}
 	return r.validateResponseAndConvertToStruct(response)
 }
 
@@ -137,10 +229,16 @@ func (r *defaultRestResource[T]) validateResponseAndConvertToStruct(data []byte)
 	return dataObject, nil
 }
 
-func (r *defaultRestResource[T]) Delete(data T) error {
-	return r.DeleteByID(data.GetIDForResourcePath())
+func (r *defaultRestResource[T]) Delete(ctx context.Context, data T) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
 }
 
-func (r *defaultRestResource[T]) DeleteByID(id string) error {
-	return r.client.Delete(id, r.resourcePath)
+func (r *defaultRestResource[T]) DeleteByID(ctx context.Context, id string) error {
+	if r.mode == DefaultRestResourceModeSingleton {
+		return r.client.DeleteByPath(ctx, r.resourcePath)
+	} else if r.mode == DefaultRestResourceModeSingletonResetByPUT {
+		_, err := r.putSingleton(ctx, r.defaults)
+		return err
+	}
+	return r.client.Delete(ctx, id, r.resourcePath)
 }
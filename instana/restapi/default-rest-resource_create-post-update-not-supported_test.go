@@ -1,6 +1,7 @@
 package restapi_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"testing"
@@ -16,10 +17,10 @@ func TestSuccessfulCreateOfTestObjectThroughCreatePOSTUpdateNotSupportedRestReso
 		testObject := makeTestObject()
 		serializedJSON, _ := json.Marshal(testObject)
 
-		client.EXPECT().Post(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
+		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
 		unmarshaller.EXPECT().Unmarshal(serializedJSON).Times(1).Return(testObject, nil)
 
-		result, err := sut.Create(testObject)
+		result, err := sut.Create(context.Background(), testObject)
 
 		assert.NoError(t, err)
 		assert.Equal(t, testObject, result)
@@ -30,11 +31,11 @@ func TestShouldFailToCreateTestObjectThroughCreatePOSTUpdateNotSupportedRestReso
 	executeCreateOrUpdateOperationThroughCreatePOSTUpdateNotSupportedRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
 		testObject := makeTestObject()
 
-		client.EXPECT().Post(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("error during test"))
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("error during test"))
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
-		_, err := sut.Create(testObject)
+		_, err := sut.Create(context.Background(), testObject)
 
 		assert.Error(t, err)
 	})
@@ -45,11 +46,11 @@ func TestShouldFailToCreateTestObjectThroughCreatePOSTUpdateNotSupportedRestReso
 		testObject := makeTestObject()
 		expectedError := errors.New("test")
 
-		client.EXPECT().Post(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(invalidResponse, nil)
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(invalidResponse, nil)
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(invalidResponse).Times(1).Return(nil, expectedError)
 
-		_, err := sut.Create(testObject)
+		_, err := sut.Create(context.Background(), testObject)
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedError, err)
@@ -63,7 +64,7 @@ func TestShouldFailToUpdateTestObjectThroughCreatePOSTUpdateNotSupportedRestReso
 
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(1).Return(emptyObject, nil)
 
-		_, err := sut.Update(testData)
+		_, err := sut.Update(context.Background(), testData)
 
 		assert.Error(t, err)
 		assert.ErrorContains(t, err, "update is not supported for /test")
@@ -78,7 +79,7 @@ func TestShouldFailToUpdateTestObjectThroughCreatePOSTUpdateNotSupportedRestReso
 
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(1).Return(emptyObject, unmarshallingError)
 
-		_, err := sut.Update(testData)
+		_, err := sut.Update(context.Background(), testData)
 
 		assert.Error(t, err)
 		assert.ErrorContains(t, err, "update is not supported for /test; unmarshalling-error")
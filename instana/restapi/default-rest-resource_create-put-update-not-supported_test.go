@@ -1,6 +1,7 @@
 package restapi_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"testing"
@@ -16,11 +17,11 @@ func TestSuccessfulCreateOfTestObjectThroughCreatePUTUpdateNotSupportedRestResou
 		testObject := makeTestObject()
 		serializedJSON, _ := json.Marshal(testObject)
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
-		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(serializedJSON).Times(1).Return(testObject, nil)
 
-		result, err := sut.Create(testObject)
+		result, err := sut.Create(context.Background(), testObject)
 
 		assert.NoError(t, err)
 		assert.Equal(t, testObject, result)
@@ -31,11 +32,11 @@ func TestShouldFailToCreateTestObjectThroughCreatePUTUpdateNotSupportedRestResou
 	executeCreateOrUpdateOperationThroughCreatePUTUpdateNotSupportedRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
 		testObject := makeTestObject()
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("error during test"))
-		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("error during test"))
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
-		_, err := sut.Create(testObject)
+		_, err := sut.Create(context.Background(), testObject)
 
 		assert.Error(t, err)
 	})
@@ -46,11 +47,11 @@ func TestShouldFailToCreateTestObjectThroughCreatePUTUpdateNotSupportedRestResou
 		testObject := makeTestObject()
 		expectedError := errors.New("test")
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(invalidResponse, nil)
-		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(invalidResponse, nil)
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(invalidResponse).Times(1).Return(nil, expectedError)
 
-		_, err := sut.Create(testObject)
+		_, err := sut.Create(context.Background(), testObject)
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedError, err)
@@ -64,7 +65,7 @@ func TestShouldFailToUpdateTestObjectThroughCreatePUTUpdateNotSupportedRestResou
 
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(1).Return(emptyObject, nil)
 
-		_, err := sut.Update(testData)
+		_, err := sut.Update(context.Background(), testData)
 
 		assert.Error(t, err)
 		assert.ErrorContains(t, err, "update is not supported for /test")
@@ -79,7 +80,7 @@ func TestShouldFailToUpdateTestObjectThroughCreatePUTUpdateNotSupportedRestResou
 
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(1).Return(emptyObject, unmarshallingError)
 
-		_, err := sut.Update(testData)
+		_, err := sut.Update(context.Background(), testData)
 
 		assert.Error(t, err)
 		assert.ErrorContains(t, err, "update is not supported for /test; unmarshalling-error")
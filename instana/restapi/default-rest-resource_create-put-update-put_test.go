@@ -1,6 +1,7 @@
 package restapi_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,10 +18,10 @@ func TestSuccessfulCreateOrUpdateOfTestObjectThroughCreatePUTUpdatePUTRestResour
 		testObject := makeTestObject()
 		serializedJSON, _ := json.Marshal(testObject)
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
 		unmarshaller.EXPECT().Unmarshal(serializedJSON).Times(1).Return(testObject, nil)
 
-		result, err := resourceFunc(testObject)
+		result, err := resourceFunc(context.Background(), testObject)
 
 		assert.NoError(t, err)
 		assert.Equal(t, testObject, result)
@@ -31,10 +32,10 @@ func TestShouldFailToCreateOrUpdateTestObjectThroughCreatePUTUpdatePUTRestResour
 	executeCreateOrUpdateOperationThroughCreatePUTUpdatePUTRestResourceTest(t, func(t *testing.T, resourceFunc createUpdateFunc, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
 		testObject := makeTestObject()
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("Error during test"))
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("Error during test"))
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
-		_, err := resourceFunc(testObject)
+		_, err := resourceFunc(context.Background(), testObject)
 
 		assert.Error(t, err)
 	})
@@ -46,17 +47,17 @@ func TestShouldFailToCreateOrUpdateTestObjectThroughCreatePUTUpdatePUTRestResour
 		response := []byte("invalid response")
 		expectedError := errors.New("test")
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(response, nil)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(response, nil)
 		unmarshaller.EXPECT().Unmarshal(response).Times(1).Return(nil, expectedError)
 
-		_, err := resourceFunc(testObject)
+		_, err := resourceFunc(context.Background(), testObject)
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedError, err)
 	})
 }
 
-type createUpdateFunc func(data *testObject) (*testObject, error)
+type createUpdateFunc func(ctx context.Context, data *testObject) (*testObject, error)
 type createPutUpdatePutContext struct {
 	operation           string
 	resourceFuncFactory func(RestResource[*testObject]) createUpdateFunc
@@ -79,7 +80,7 @@ func executeCreateOrUpdateOperationThroughCreatePUTUpdatePUTRestResourceTest(t *
 
 			sut := NewCreatePUTUpdatePUTRestResource[*testObject](testObjectResourcePath, unmarshaller, client)
 
-			client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+			client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 			testFunction(t, context.resourceFuncFactory(sut), client, unmarshaller)
 		})
 	}
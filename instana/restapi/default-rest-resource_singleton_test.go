@@ -0,0 +1,245 @@
+package restapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func executeSingletonRestResourceTest(t *testing.T, testFunction func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject])) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*testObject](ctrl)
+
+	testFunction(t, NewSingletonRestResource[*testObject](testObjectResourcePath, unmarshaller, client), client, unmarshaller)
+}
+
+func TestShouldGetOneTestObjectThroughSingletonRestResourceWithoutIDInResourcePath(t *testing.T) {
+	executeSingletonRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
+		testObject := makeTestObject()
+		serializedJSON, _ := json.Marshal(testObject)
+
+		client.EXPECT().Get(gomock.Any(), testObjectResourcePath).Times(1).Return(serializedJSON, nil)
+		unmarshaller.EXPECT().Unmarshal(serializedJSON).Times(1).Return(testObject, nil)
+
+		result, err := sut.GetOne(context.Background(), testObjectID)
+
+		require.NoError(t, err)
+		require.Equal(t, testObject, result)
+	})
+}
+
+func TestShouldReturnEntityNotFoundWhenSingletonIsRequestedWithOtherID(t *testing.T) {
+	executeSingletonRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
+		testObject := makeTestObject()
+		serializedJSON, _ := json.Marshal(testObject)
+
+		client.EXPECT().Get(gomock.Any(), testObjectResourcePath).Times(1).Return(serializedJSON, nil)
+		unmarshaller.EXPECT().Unmarshal(serializedJSON).Times(1).Return(testObject, nil)
+
+		_, err := sut.GetOne(context.Background(), "other-id")
+
+		require.ErrorIs(t, err, ErrEntityNotFound)
+	})
+}
+
+func TestShouldFailToGetOneTestObjectThroughSingletonRestResourceWhenClientReturnsError(t *testing.T) {
+	executeSingletonRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
+		expectedError := errors.New("test")
+		client.EXPECT().Get(gomock.Any(), testObjectResourcePath).Times(1).Return(nil, expectedError)
+		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+		_, err := sut.GetOne(context.Background(), testObjectID)
+
+		require.ErrorIs(t, err, expectedError)
+	})
+}
+
+func TestShouldFailToGetOneTestObjectThroughSingletonRestResourceWhenResponseCannotBeUnmarshalled(t *testing.T) {
+	executeSingletonRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
+		expectedError := errors.New("test")
+		response := []byte("invalid response")
+		client.EXPECT().Get(gomock.Any(), testObjectResourcePath).Times(1).Return(response, nil)
+		unmarshaller.EXPECT().Unmarshal(response).Times(1).Return(nil, expectedError)
+
+		_, err := sut.GetOne(context.Background(), testObjectID)
+
+		require.ErrorIs(t, err, expectedError)
+	})
+}
+
+func TestShouldGetAllTestObjectsThroughSingletonRestResourceAsSingleElement(t *testing.T) {
+	executeSingletonRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
+		object := makeTestObject()
+		serializedJSON, _ := json.Marshal(object)
+
+		client.EXPECT().Get(gomock.Any(), testObjectResourcePath).Times(1).Return(serializedJSON, nil)
+		unmarshaller.EXPECT().Unmarshal(serializedJSON).Times(1).Return(object, nil)
+		unmarshaller.EXPECT().UnmarshalArray(gomock.Any()).Times(0)
+
+		result, err := sut.GetAll(context.Background())
+
+		require.NoError(t, err)
+		require.Equal(t, &[]*testObject{object}, result)
+	})
+}
+
+func TestShouldFailToGetAllTestObjectsThroughSingletonRestResourceWhenClientReturnsError(t *testing.T) {
+	executeSingletonRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
+		expectedError := errors.New("test")
+		client.EXPECT().Get(gomock.Any(), testObjectResourcePath).Times(1).Return(nil, expectedError)
+
+		_, err := sut.GetAll(context.Background())
+
+		require.ErrorIs(t, err, expectedError)
+	})
+}
+
+func TestShouldCreateAndUpdateTestObjectThroughSingletonRestResourceUsingPutWithoutID(t *testing.T) {
+	executeSingletonRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
+		testObject := makeTestObject()
+		serializedJSON, _ := json.Marshal(testObject)
+
+		client.EXPECT().PutContent(gomock.Any(), testObjectResourcePath, "application/json; charset=utf-8", serializedJSON).Times(2).Return(serializedJSON, nil)
+		unmarshaller.EXPECT().Unmarshal(serializedJSON).Times(2).Return(testObject, nil)
+
+		created, err := sut.Create(context.Background(), testObject)
+		require.NoError(t, err)
+		require.Equal(t, testObject, created)
+
+		updated, err := sut.Update(context.Background(), testObject)
+		require.NoError(t, err)
+		require.Equal(t, testObject, updated)
+	})
+}
+
+func TestShouldReturnProvidedTestObjectWhenSingletonRestResourceRespondsWithoutContent(t *testing.T) {
+	executeSingletonRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
+		testObject := makeTestObject()
+
+		client.EXPECT().PutContent(gomock.Any(), testObjectResourcePath, gomock.Any(), gomock.Any()).Times(1).Return([]byte{}, nil)
+		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+		result, err := sut.Update(context.Background(), testObject)
+
+		require.NoError(t, err)
+		require.Equal(t, testObject, result)
+	})
+}
+
+func TestShouldReturnFirstTestObjectWhenSingletonRestResourceRespondsWithArray(t *testing.T) {
+	executeSingletonRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
+		object := makeTestObject()
+		stored := &testObject{ID: testObjectID, Name: "stored"}
+		response := []byte(`[{"id":"test-object-id","name":"stored"}]`)
+
+		client.EXPECT().PutContent(gomock.Any(), testObjectResourcePath, gomock.Any(), gomock.Any()).Times(1).Return(response, nil)
+		unmarshaller.EXPECT().UnmarshalArray(response).Times(1).Return(&[]*testObject{stored}, nil)
+		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+		result, err := sut.Update(context.Background(), object)
+
+		require.NoError(t, err)
+		require.Equal(t, stored, result)
+	})
+}
+
+func TestShouldReturnProvidedTestObjectWhenSingletonRestResourceRespondsWithEmptyArray(t *testing.T) {
+	executeSingletonRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
+		object := makeTestObject()
+		response := []byte(`[]`)
+
+		client.EXPECT().PutContent(gomock.Any(), testObjectResourcePath, gomock.Any(), gomock.Any()).Times(1).Return(response, nil)
+		unmarshaller.EXPECT().UnmarshalArray(response).Times(1).Return(&[]*testObject{}, nil)
+
+		result, err := sut.Create(context.Background(), object)
+
+		require.NoError(t, err)
+		require.Equal(t, object, result)
+	})
+}
+
+func TestShouldFailToUpdateTestObjectThroughSingletonRestResourceWhenArrayResponseCannotBeUnmarshalled(t *testing.T) {
+	executeSingletonRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
+		expectedError := errors.New("test")
+		response := []byte(`[invalid]`)
+
+		client.EXPECT().PutContent(gomock.Any(), testObjectResourcePath, gomock.Any(), gomock.Any()).Times(1).Return(response, nil)
+		unmarshaller.EXPECT().UnmarshalArray(response).Times(1).Return(nil, expectedError)
+
+		_, err := sut.Update(context.Background(), makeTestObject())
+
+		require.ErrorIs(t, err, expectedError)
+	})
+}
+
+func TestShouldFailToCreateTestObjectThroughSingletonRestResourceWhenClientReturnsError(t *testing.T) {
+	executeSingletonRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
+		expectedError := errors.New("test")
+		client.EXPECT().PutContent(gomock.Any(), testObjectResourcePath, gomock.Any(), gomock.Any()).Times(1).Return(nil, expectedError)
+
+		_, err := sut.Create(context.Background(), makeTestObject())
+
+		require.ErrorIs(t, err, expectedError)
+	})
+}
+
+func TestShouldResetTestObjectThroughSingletonRestResourceUsingDeleteWithoutID(t *testing.T) {
+	executeSingletonRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
+		client.EXPECT().DeleteByPath(gomock.Any(), testObjectResourcePath).Times(2).Return(nil)
+		client.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		require.NoError(t, sut.Delete(context.Background(), makeTestObject()))
+		require.NoError(t, sut.DeleteByID(context.Background(), testObjectID))
+	})
+}
+
+func TestShouldFailToResetTestObjectThroughSingletonRestResourceWhenClientReturnsError(t *testing.T) {
+	executeSingletonRestResourceTest(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
+		expectedError := errors.New("test")
+		client.EXPECT().DeleteByPath(gomock.Any(), testObjectResourcePath).Times(1).Return(expectedError)
+
+		err := sut.DeleteByID(context.Background(), testObjectID)
+
+		require.ErrorIs(t, err, expectedError)
+	})
+}
+
+func TestShouldResetTestObjectThroughSingletonRestResourceWithDefaultsUsingPutOfDefaults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*testObject](ctrl)
+	defaults := &testObject{ID: testObjectID}
+	expectedPayload, _ := json.Marshal(defaults)
+
+	client.EXPECT().PutContent(gomock.Any(), testObjectResourcePath, gomock.Any(), expectedPayload).Times(1).Return([]byte{}, nil)
+	client.EXPECT().DeleteByPath(gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewSingletonRestResourceWithDefaults[*testObject](testObjectResourcePath, unmarshaller, client, defaults)
+
+	require.NoError(t, sut.Delete(context.Background(), makeTestObject()))
+}
+
+func TestShouldFailToResetTestObjectThroughSingletonRestResourceWithDefaultsWhenClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*testObject](ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().PutContent(gomock.Any(), testObjectResourcePath, gomock.Any(), gomock.Any()).Times(1).Return(nil, expectedError)
+
+	sut := NewSingletonRestResourceWithDefaults[*testObject](testObjectResourcePath, unmarshaller, client, &testObject{ID: testObjectID})
+
+	err := sut.DeleteByID(context.Background(), testObjectID)
+
+	require.ErrorIs(t, err, expectedError)
+}
@@ -1,6 +1,7 @@
 package restapi_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -38,10 +39,10 @@ func TestSuccessfulGetOneTestObjectThroughDefaultRestResource(t *testing.T) {
 		testObject := makeTestObject()
 		serializedJSON, _ := json.Marshal(testObject)
 
-		client.EXPECT().GetOne(gomock.Eq(testObject.ID), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
+		client.EXPECT().GetOne(gomock.Any(), gomock.Eq(testObject.ID), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
 		unmarshaller.EXPECT().Unmarshal(serializedJSON).Times(1).Return(testObject, nil)
 
-		data, err := sut.GetOne(testObject.ID)
+		data, err := sut.GetOne(context.Background(), testObject.ID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, testObject, data)
@@ -50,10 +51,10 @@ func TestSuccessfulGetOneTestObjectThroughDefaultRestResource(t *testing.T) {
 
 func TestShouldFailToGetOneTestObjectThroughDefaultRestResourceWhenErrorIsRetrievedFromRestClient(t *testing.T) {
 	executeForAllImplementationsOfDefaultRestResource(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
-		client.EXPECT().GetOne(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("error during test"))
+		client.EXPECT().GetOne(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("error during test"))
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
-		_, err := sut.GetOne(testObjectID)
+		_, err := sut.GetOne(context.Background(), testObjectID)
 
 		assert.Error(t, err)
 	})
@@ -64,10 +65,10 @@ func TestShouldFailToGetOneTestObjectThroughDefaultRestResourceWhenResponseCanno
 		expectedError := errors.New("test")
 		response := []byte("[{ \"invalid\" : \"data\" }]")
 
-		client.EXPECT().GetOne(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(response, nil)
+		client.EXPECT().GetOne(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(response, nil)
 		unmarshaller.EXPECT().Unmarshal(response).Times(1).Return(nil, expectedError)
 
-		_, err := sut.GetOne(testObjectID)
+		_, err := sut.GetOne(context.Background(), testObjectID)
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedError, err)
@@ -78,9 +79,9 @@ func TestSuccessfulDeleteOfTestObjectByObjectThroughDefaultRestResource(t *testi
 	executeForAllImplementationsOfDefaultRestResource(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
 		testObject := makeTestObject()
 
-		client.EXPECT().Delete(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(nil)
+		client.EXPECT().Delete(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(nil)
 
-		err := sut.Delete(testObject)
+		err := sut.Delete(context.Background(), testObject)
 
 		assert.NoError(t, err)
 	})
@@ -90,9 +91,9 @@ func TestShouldFailToDeleteTestObjectThroughDefaultRestResourceWhenErrorIsRetrun
 	executeForAllImplementationsOfDefaultRestResource(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
 		testObject := makeTestObject()
 
-		client.EXPECT().Delete(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(errors.New("Error during test"))
+		client.EXPECT().Delete(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(errors.New("Error during test"))
 
-		err := sut.Delete(testObject)
+		err := sut.Delete(context.Background(), testObject)
 
 		assert.Error(t, err)
 	})
@@ -104,10 +105,10 @@ func TestShouldSuccessfullyGetAllTestObjects(t *testing.T) {
 		expectedResult := []*testObject{testData, testData, testData}
 		restResponseData := []byte("server-response")
 
-		client.EXPECT().Get(testObjectResourcePath).Times(1).Return(restResponseData, nil)
+		client.EXPECT().Get(gomock.Any(), testObjectResourcePath).Times(1).Return(restResponseData, nil)
 		unmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(1).Return(&expectedResult, nil)
 
-		result, err := sut.GetAll()
+		result, err := sut.GetAll(context.Background())
 
 		require.NoError(t, err)
 		require.Equal(t, &expectedResult, result)
@@ -118,10 +119,10 @@ func TestShouldReturnEmptySliceWhenNoTestObjectsIsReturnedForGetAll(t *testing.T
 	executeForAllImplementationsOfDefaultRestResource(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
 		restResponseData := []byte("[]")
 
-		client.EXPECT().Get(testObjectResourcePath).Times(1).Return(restResponseData, nil)
+		client.EXPECT().Get(gomock.Any(), testObjectResourcePath).Times(1).Return(restResponseData, nil)
 		unmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(1).Return(&[]*testObject{}, nil)
 
-		result, err := sut.GetAll()
+		result, err := sut.GetAll(context.Background())
 
 		require.NoError(t, err)
 		require.Equal(t, &[]*testObject{}, result)
@@ -132,10 +133,10 @@ func TestShouldFailToGetAllTestObjectsWhenClientReturnsError(t *testing.T) {
 	executeForAllImplementationsOfDefaultRestResource(t, func(t *testing.T, sut RestResource[*testObject], client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*testObject]) {
 		expectedError := errors.New("test")
 
-		client.EXPECT().Get(testObjectResourcePath).Times(1).Return(nil, expectedError)
+		client.EXPECT().Get(gomock.Any(), testObjectResourcePath).Times(1).Return(nil, expectedError)
 		unmarshaller.EXPECT().UnmarshalArray(gomock.Any()).Times(0)
 
-		_, err := sut.GetAll()
+		_, err := sut.GetAll(context.Background())
 
 		require.Error(t, err)
 		require.Equal(t, expectedError, err)
@@ -147,10 +148,10 @@ func TestShouldFailToGetAllTestObjectsWhenRestResultCannotBeUnmarshalled(t *test
 		restResponseData := []byte("invalidResponse")
 		expectedError := errors.New("test")
 
-		client.EXPECT().Get(testObjectResourcePath).Times(1).Return(restResponseData, nil)
+		client.EXPECT().Get(gomock.Any(), testObjectResourcePath).Times(1).Return(restResponseData, nil)
 		unmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(1).Return(nil, expectedError)
 
-		_, err := sut.GetAll()
+		_, err := sut.GetAll(context.Background())
 
 		require.Error(t, err)
 		require.Equal(t, expectedError, err)
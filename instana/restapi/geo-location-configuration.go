@@ -0,0 +1,245 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	//GeoLocationSubPath sub path of the geo location configuration of websites and mobile apps
+	GeoLocationSubPath = "geo-location"
+	//GeoMappingRulesSubPath sub path of the custom geo mapping rules of websites and mobile apps
+	GeoMappingRulesSubPath = "geo-mapping-rules"
+	//IPMaskingSubPath sub path of the IP masking configuration of websites and mobile apps
+	IPMaskingSubPath = "ip-masking"
+	//GeoMappingRulesMediaType the media type of the custom geo mapping rules
+	GeoMappingRulesMediaType = "text/csv"
+)
+
+// GeoDetailRemoval custom type for the geo location details which are removed from beacons
+type GeoDetailRemoval string
+
+// GeoDetailRemovals custom type for a slice of GeoDetailRemoval
+type GeoDetailRemovals []GeoDetailRemoval
+
+// ToStringSlice Returns the corresponding string representations
+func (removals GeoDetailRemovals) ToStringSlice() []string {
+	result := make([]string, len(removals))
+	for i, v := range removals {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//GeoDetailRemovalNoRemoval constant value for keeping all geo location details
+	GeoDetailRemovalNoRemoval = GeoDetailRemoval("NO_REMOVAL")
+	//GeoDetailRemovalRemoveCoordinates constant value for removing the coordinates
+	GeoDetailRemovalRemoveCoordinates = GeoDetailRemoval("REMOVE_COORDINATES")
+	//GeoDetailRemovalRemoveCity constant value for removing the city and the coordinates
+	GeoDetailRemovalRemoveCity = GeoDetailRemoval("REMOVE_CITY")
+	//GeoDetailRemovalRemoveAll constant value for removing all geo location details
+	GeoDetailRemovalRemoveAll = GeoDetailRemoval("REMOVE_ALL")
+)
+
+// SupportedGeoDetailRemovals list of all supported GeoDetailRemoval
+var SupportedGeoDetailRemovals = GeoDetailRemovals{GeoDetailRemovalNoRemoval, GeoDetailRemovalRemoveCoordinates, GeoDetailRemovalRemoveCity, GeoDetailRemovalRemoveAll}
+
+// GeoLocationConfiguration data structure of the geo location configuration of websites and mobile apps of the Instana API
+type GeoLocationConfiguration struct {
+	GeoDetailRemoval GeoDetailRemoval `json:"geoDetailRemoval"`
+	//GeoMappingRules the custom geo mapping rules which are managed separately as CSV. The rules are kept as is to
+	//send them back unchanged when the geo detail removal is updated
+	GeoMappingRules json.RawMessage `json:"geoMappingRules,omitempty"`
+}
+
+// IPMasking custom type for the masking of IP addresses of beacons
+type IPMasking string
+
+// IPMaskings custom type for a slice of IPMasking
+type IPMaskings []IPMasking
+
+// ToStringSlice Returns the corresponding string representations
+func (maskings IPMaskings) ToStringSlice() []string {
+	result := make([]string, len(maskings))
+	for i, v := range maskings {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//IPMaskingDefault constant value for the default IP masking
+	IPMaskingDefault = IPMasking("DEFAULT")
+	//IPMaskingStrict constant value for the strict IP masking
+	IPMaskingStrict = IPMasking("STRICT")
+	//IPMaskingRemoveAllDetails constant value for removing the IP addresses completely
+	IPMaskingRemoveAllDetails = IPMasking("REMOVE_ALL_DETAILS")
+)
+
+// SupportedIPMaskings list of all supported IPMasking
+var SupportedIPMaskings = IPMaskings{IPMaskingDefault, IPMaskingStrict, IPMaskingRemoveAllDetails}
+
+// IPMaskingConfiguration data structure of the IP masking configuration of websites and mobile apps of the Instana API
+type IPMaskingConfiguration struct {
+	IPMasking IPMasking `json:"ipMasking"`
+}
+
+const encodingApplicationJSONWithoutCharset = "application/json"
+
+// GeoLocationAndIPMaskingSettings the geo location configuration, the custom geo mapping rules and the IP masking
+// configuration of websites and mobile apps. The settings are managed through separate sub resources of the website or
+// mobile app. Settings which are nil are not synced with the Instana API.
+type GeoLocationAndIPMaskingSettings struct {
+	GeoLocation     *GeoLocationConfiguration `json:"-"`
+	GeoMappingRules *string                   `json:"-"`
+	IPMasking       *IPMaskingConfiguration   `json:"-"`
+}
+
+// Selection returns the selection of the settings which are provided
+func (s GeoLocationAndIPMaskingSettings) Selection() GeoLocationAndIPMaskingSettingsSelection {
+	return GeoLocationAndIPMaskingSettingsSelection{
+		GeoLocation:     s.GeoLocation != nil,
+		GeoMappingRules: s.GeoMappingRules != nil,
+		IPMasking:       s.IPMasking != nil,
+	}
+}
+
+// GeoLocationAndIPMaskingSettingsSelection selects the geo location and IP masking settings which are read from the
+// Instana API. Each setting is provided by a separate sub resource.
+type GeoLocationAndIPMaskingSettingsSelection struct {
+	GeoLocation     bool
+	GeoMappingRules bool
+	IPMasking       bool
+}
+
+// AllGeoLocationAndIPMaskingSettings selection of all geo location and IP masking settings
+var AllGeoLocationAndIPMaskingSettings = GeoLocationAndIPMaskingSettingsSelection{GeoLocation: true, GeoMappingRules: true, IPMasking: true}
+
+// GeoLocationAndIPMaskingSettingsReader extension of a RestResource of websites or mobile apps which reads the object
+// together with the selected geo location and IP masking settings only. Settings which are not selected are nil.
+type GeoLocationAndIPMaskingSettingsReader[T InstanaDataObject] interface {
+	GetOneWithSettings(ctx context.Context, id string, selection GeoLocationAndIPMaskingSettingsSelection) (T, error)
+}
+
+func readGeoLocationAndIPMaskingSettings(ctx context.Context, client RestClient, resourcePath string, id string, selection GeoLocationAndIPMaskingSettingsSelection) (GeoLocationAndIPMaskingSettings, error) {
+	var settings GeoLocationAndIPMaskingSettings
+	var err error
+	if selection.GeoLocation {
+		settings.GeoLocation, err = getGeoLocationConfiguration(ctx, client, resourcePath, id)
+		if err != nil {
+			return settings, err
+		}
+	}
+	if selection.GeoMappingRules {
+		settings.GeoMappingRules, err = getGeoMappingRules(ctx, client, resourcePath, id)
+		if err != nil {
+			return settings, err
+		}
+	}
+	if selection.IPMasking {
+		settings.IPMasking, err = getIPMaskingConfiguration(ctx, client, resourcePath, id)
+		if err != nil {
+			return settings, err
+		}
+	}
+	return settings, nil
+}
+
+// updateGeoLocationAndIPMaskingSettings syncs the provided settings. The geo location configuration is updated before
+// the custom geo mapping rules as the geo location configuration contains the rules as well.
+func updateGeoLocationAndIPMaskingSettings(ctx context.Context, client RestClient, resourcePath string, id string, settings GeoLocationAndIPMaskingSettings) error {
+	if settings.GeoLocation != nil {
+		if _, err := updateGeoLocationConfiguration(ctx, client, resourcePath, id, settings.GeoLocation); err != nil {
+			return err
+		}
+	}
+	if settings.GeoMappingRules != nil {
+		if _, err := updateGeoMappingRules(ctx, client, resourcePath, id, *settings.GeoMappingRules); err != nil {
+			return err
+		}
+	}
+	if settings.IPMasking != nil {
+		if _, err := updateIPMaskingConfiguration(ctx, client, resourcePath, id, settings.IPMasking); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func buildSubResourcePath(resourcePath string, id string, subPath string) string {
+	return resourcePath + "/" + id + "/" + subPath
+}
+
+func getGeoLocationConfiguration(ctx context.Context, client RestClient, resourcePath string, id string) (*GeoLocationConfiguration, error) {
+	data, err := client.GetContent(ctx, buildSubResourcePath(resourcePath, id, GeoLocationSubPath), encodingApplicationJSONWithoutCharset)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalSubResource(data, &GeoLocationConfiguration{})
+}
+
+// updateGeoLocationConfiguration updates the geo detail removal. The geo location configuration of the Instana API
+// contains the custom geo mapping rules as well. Therefore, the current rules are sent back unchanged when the given
+// configuration does not provide them.
+func updateGeoLocationConfiguration(ctx context.Context, client RestClient, resourcePath string, id string, config *GeoLocationConfiguration) (*GeoLocationConfiguration, error) {
+	request := *config
+	if request.GeoMappingRules == nil {
+		current, err := getGeoLocationConfiguration(ctx, client, resourcePath, id)
+		if err != nil {
+			return nil, err
+		}
+		request.GeoMappingRules = current.GeoMappingRules
+	}
+	return putSubResource(ctx, client, buildSubResourcePath(resourcePath, id, GeoLocationSubPath), &request, &GeoLocationConfiguration{})
+}
+
+func getGeoMappingRules(ctx context.Context, client RestClient, resourcePath string, id string) (*string, error) {
+	data, err := client.GetContent(ctx, buildSubResourcePath(resourcePath, id, GeoMappingRulesSubPath), GeoMappingRulesMediaType)
+	if err != nil {
+		return nil, err
+	}
+	rules := string(data)
+	return &rules, nil
+}
+
+func updateGeoMappingRules(ctx context.Context, client RestClient, resourcePath string, id string, rules string) (*string, error) {
+	data, err := client.PutContent(ctx, buildSubResourcePath(resourcePath, id, GeoMappingRulesSubPath), GeoMappingRulesMediaType, []byte(rules))
+	if err != nil {
+		return nil, err
+	}
+	result := string(data)
+	return &result, nil
+}
+
+func getIPMaskingConfiguration(ctx context.Context, client RestClient, resourcePath string, id string) (*IPMaskingConfiguration, error) {
+	data, err := client.GetContent(ctx, buildSubResourcePath(resourcePath, id, IPMaskingSubPath), encodingApplicationJSONWithoutCharset)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalSubResource(data, &IPMaskingConfiguration{})
+}
+
+func updateIPMaskingConfiguration(ctx context.Context, client RestClient, resourcePath string, id string, config *IPMaskingConfiguration) (*IPMaskingConfiguration, error) {
+	return putSubResource(ctx, client, buildSubResourcePath(resourcePath, id, IPMaskingSubPath), config, &IPMaskingConfiguration{})
+}
+
+func putSubResource[T any](ctx context.Context, client RestClient, path string, request T, target T) (T, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return target, fmt.Errorf("failed to marshal request of %s; %w", path, err)
+	}
+	data, err := client.PutContent(ctx, path, encodingApplicationJSONWithoutCharset, body)
+	if err != nil {
+		return target, err
+	}
+	return unmarshalSubResource(data, target)
+}
+
+func unmarshalSubResource[T any](data []byte, target T) (T, error) {
+	if err := json.Unmarshal(data, target); err != nil {
+		return target, fmt.Errorf("failed to parse json; %w", err)
+	}
+	return target, nil
+}
@@ -0,0 +1,18 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReturnSupportedGeoDetailRemovalsAsStringSlice(t *testing.T) {
+	expected := []string{"NO_REMOVAL", "REMOVE_COORDINATES", "REMOVE_CITY", "REMOVE_ALL"}
+	require.Equal(t, expected, SupportedGeoDetailRemovals.ToStringSlice())
+}
+
+func TestShouldReturnSupportedIPMaskingsAsStringSlice(t *testing.T) {
+	expected := []string{"DEFAULT", "STRICT", "REMOVE_ALL_DETAILS"}
+	require.Equal(t, expected, SupportedIPMaskings.ToStringSlice())
+}
@@ -0,0 +1,65 @@
+package restapi
+
+import "context"
+
+const (
+	//GlobalCustomPayloadConfigurationResourcePath path to the global custom payload configuration of Instana RESTful API
+	GlobalCustomPayloadConfigurationResourcePath = EventSettingsBasePath + "/custom-payload-configurations"
+	//CustomPayloadTagCatalogResourcePath path to the catalog of tags which can be used for dynamic custom payload fields
+	CustomPayloadTagCatalogResourcePath = GlobalCustomPayloadConfigurationResourcePath + "/catalog"
+	//GlobalCustomPayloadConfigurationID the fixed ID of the global custom payload configuration. The configuration exists
+	//exactly once per tenant and is not identified by an ID in the Instana API
+	GlobalCustomPayloadConfigurationID = "global"
+)
+
+// GlobalCustomPayloadConfiguration data structure of the tenant wide custom payload configuration of the Instana API.
+// The custom payload fields are added to all alerts of the tenant.
+type GlobalCustomPayloadConfiguration struct {
+	Fields []CustomPayloadField[any] `json:"fields"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *GlobalCustomPayloadConfiguration) GetIDForResourcePath() string {
+	return GlobalCustomPayloadConfigurationID
+}
+
+// GetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (c *GlobalCustomPayloadConfiguration) GetCustomerPayloadFields() []CustomPayloadField[any] {
+	return c.Fields
+}
+
+// SetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (c *GlobalCustomPayloadConfiguration) SetCustomerPayloadFields(fields []CustomPayloadField[any]) {
+	c.Fields = fields
+}
+
+// CustomPayloadTagCatalog data structure of the catalog of tags which can be used for dynamic custom payload fields
+type CustomPayloadTagCatalog struct {
+	Tags []CustomPayloadTag `json:"tags"`
+}
+
+// CustomPayloadTag data structure of a tag of the CustomPayloadTagCatalog
+type CustomPayloadTag struct {
+	Name  string `json:"name"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+}
+
+// ContainsTag checks if the catalog contains a tag with the given name
+func (c *CustomPayloadTagCatalog) ContainsTag(name string) bool {
+	for _, tag := range c.Tags {
+		if tag.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// CustomPayloadTagCatalog implementation of InstanaAPI interface
+func (api *baseInstanaAPI) CustomPayloadTagCatalog(ctx context.Context) (*CustomPayloadTagCatalog, error) {
+	catalog := &CustomPayloadTagCatalog{}
+	if err := api.getAndUnmarshal(ctx, CustomPayloadTagCatalogResourcePath, catalog); err != nil {
+		return nil, err
+	}
+	return catalog, nil
+}
@@ -0,0 +1,128 @@
+package restapi_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var globalCustomPayloadConfigurationSerialized = []byte(`{"fields":[{"type":"staticString","key":"team","value":"platform"},{"type":"dynamic","key":"stage","value":{"key":"stage","tagName":"aws.tag"}}],"lastUpdated":1700000000000}`)
+
+func makeTestGlobalCustomPayloadConfiguration() *GlobalCustomPayloadConfiguration {
+	dynamicKey := "stage"
+	return &GlobalCustomPayloadConfiguration{
+		Fields: []CustomPayloadField[any]{
+			{Type: StaticStringCustomPayloadType, Key: "team", Value: StaticStringCustomPayloadFieldValue("platform")},
+			{Type: DynamicCustomPayloadType, Key: "stage", Value: DynamicCustomPayloadFieldValue{Key: &dynamicKey, TagName: "aws.tag"}},
+		},
+	}
+}
+
+func createGlobalCustomPayloadConfigurationRestResource(client RestClient) RestResource[*GlobalCustomPayloadConfiguration] {
+	return NewSingletonRestResource(GlobalCustomPayloadConfigurationResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&GlobalCustomPayloadConfiguration{})), client)
+}
+
+func TestShouldSuccessfullyGetGlobalCustomPayloadConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), GlobalCustomPayloadConfigurationResourcePath).Times(1).Return(globalCustomPayloadConfigurationSerialized, nil)
+
+	sut := createGlobalCustomPayloadConfigurationRestResource(client)
+
+	result, err := sut.GetOne(context.Background(), GlobalCustomPayloadConfigurationID)
+
+	require.NoError(t, err)
+	require.Equal(t, makeTestGlobalCustomPayloadConfiguration(), result)
+}
+
+func TestShouldUpdateGlobalCustomPayloadConfigurationAndMapArrayResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	response := append(append([]byte("["), globalCustomPayloadConfigurationSerialized...), ']')
+	client.EXPECT().PutContent(gomock.Any(), GlobalCustomPayloadConfigurationResourcePath, "application/json; charset=utf-8", gomock.Any()).Times(1).Return(response, nil)
+
+	sut := createGlobalCustomPayloadConfigurationRestResource(client)
+
+	result, err := sut.Update(context.Background(), makeTestGlobalCustomPayloadConfiguration())
+
+	require.NoError(t, err)
+	require.Equal(t, makeTestGlobalCustomPayloadConfiguration(), result)
+}
+
+func TestShouldDeleteGlobalCustomPayloadConfigurationUsingDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().DeleteByPath(gomock.Any(), GlobalCustomPayloadConfigurationResourcePath).Times(1).Return(nil)
+
+	sut := createGlobalCustomPayloadConfigurationRestResource(client)
+
+	err := sut.Delete(context.Background(), makeTestGlobalCustomPayloadConfiguration())
+
+	require.NoError(t, err)
+}
+
+func TestShouldReturnFixedIDOfGlobalCustomPayloadConfiguration(t *testing.T) {
+	require.Equal(t, GlobalCustomPayloadConfigurationID, (&GlobalCustomPayloadConfiguration{}).GetIDForResourcePath())
+}
+
+func TestShouldGetAndSetCustomPayloadFieldsOfGlobalCustomPayloadConfiguration(t *testing.T) {
+	fields := []CustomPayloadField[any]{{Type: StaticStringCustomPayloadType, Key: "key", Value: StaticStringCustomPayloadFieldValue("value")}}
+	config := &GlobalCustomPayloadConfiguration{}
+
+	config.SetCustomerPayloadFields(fields)
+
+	require.Equal(t, fields, config.GetCustomerPayloadFields())
+}
+
+func TestShouldCheckIfCustomPayloadTagCatalogContainsTag(t *testing.T) {
+	catalog := &CustomPayloadTagCatalog{Tags: []CustomPayloadTag{{Name: "aws.tag"}, {Name: "host.name"}}}
+
+	require.True(t, catalog.ContainsTag("aws.tag"))
+	require.True(t, catalog.ContainsTag("host.name"))
+	require.False(t, catalog.ContainsTag("service.name"))
+}
+
+func TestShouldReturnCustomPayloadTagCatalog(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, CustomPayloadTagCatalogResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, []byte(`{"tagTree":[],"tags":[{"name":"aws.tag","label":"AWS Tag","type":"KEY_VALUE_PAIR"}]}`))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	api, err := NewInstanaAPI(createTestClientConfig(httpServer, testRetryConfig, DefaultThrottleConfig()))
+	require.NoError(t, err)
+
+	catalog, err := api.CustomPayloadTagCatalog(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, &CustomPayloadTagCatalog{Tags: []CustomPayloadTag{{Name: "aws.tag", Label: "AWS Tag", Type: "KEY_VALUE_PAIR"}}}, catalog)
+}
+
+func TestShouldFailToReturnCustomPayloadTagCatalogWhenResponseIsInvalid(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, CustomPayloadTagCatalogResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, []byte(`invalid`))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	api, err := NewInstanaAPI(createTestClientConfig(httpServer, testRetryConfig, DefaultThrottleConfig()))
+	require.NoError(t, err)
+
+	_, err = api.CustomPayloadTagCatalog(context.Background())
+
+	require.Error(t, err)
+}
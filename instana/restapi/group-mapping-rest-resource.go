@@ -0,0 +1,66 @@
+package restapi
+
+import "context"
+
+// NewGroupMappingRestResource creates a new REST resource for IdP group mappings. Group mappings are created using POST
+// and updated using PUT.
+func NewGroupMappingRestResource(unmarshaller JSONUnmarshaller[*GroupMapping], client RestClient) RestResource[*GroupMapping] {
+	return &groupMappingRestResource{
+		resourcePath: GroupMappingsResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type groupMappingRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*GroupMapping]
+	client       RestClient
+}
+
+func (r *groupMappingRestResource) GetAll(ctx context.Context) (*[]*GroupMapping, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshaller.UnmarshalArray(data)
+}
+
+// GetOne returns the group mapping with the given ID. The Instana API does not provide an endpoint to retrieve a single
+// group mapping. Therefore, the mapping is looked up in the list of all group mappings.
+func (r *groupMappingRestResource) GetOne(ctx context.Context, id string) (*GroupMapping, error) {
+	mappings, err := r.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, mapping := range *mappings {
+		if mapping.ID == id {
+			return mapping, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *groupMappingRestResource) Create(ctx context.Context, data *GroupMapping) (*GroupMapping, error) {
+	response, err := r.client.Post(ctx, data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	return r.unmarshaller.Unmarshal(response)
+}
+
+func (r *groupMappingRestResource) Update(ctx context.Context, data *GroupMapping) (*GroupMapping, error) {
+	response, err := r.client.Put(ctx, data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	return r.unmarshaller.Unmarshal(response)
+}
+
+func (r *groupMappingRestResource) Delete(ctx context.Context, data *GroupMapping) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
+}
+
+func (r *groupMappingRestResource) DeleteByID(ctx context.Context, id string) error {
+	return r.client.Delete(ctx, id, r.resourcePath)
+}
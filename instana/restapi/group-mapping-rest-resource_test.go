@@ -0,0 +1,165 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const groupMappingTestID = "mapping-id"
+
+var groupMappingsSerialized = []byte(`[{"id":"other","groupId":"group-id","key":"memberOf","value":"other"},{"id":"mapping-id","groupId":"group-id","key":"memberOf","value":"idp-group"}]`)
+var groupMappingSerialized = []byte(`{"id":"mapping-id","groupId":"group-id","key":"memberOf","value":"idp-group"}`)
+
+func makeTestGroupMapping() *GroupMapping {
+	return &GroupMapping{ID: groupMappingTestID, GroupID: "group-id", Key: "memberOf", Value: "idp-group"}
+}
+
+func createGroupMappingRestResource(client RestClient) RestResource[*GroupMapping] {
+	return NewGroupMappingRestResource(NewDefaultJSONUnmarshaller(&GroupMapping{}), client)
+}
+
+func TestShouldSuccessfullyGetAllGroupMappings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), GroupMappingsResourcePath).Times(1).Return(groupMappingsSerialized, nil)
+
+	sut := createGroupMappingRestResource(client)
+
+	result, err := sut.GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, *result, 2)
+	require.Equal(t, makeTestGroupMapping(), (*result)[1])
+}
+
+func TestShouldSuccessfullyGetOneGroupMappingFromListOfAllMappings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), GroupMappingsResourcePath).Times(1).Return(groupMappingsSerialized, nil)
+
+	sut := createGroupMappingRestResource(client)
+
+	result, err := sut.GetOne(context.Background(), groupMappingTestID)
+
+	require.NoError(t, err)
+	require.Equal(t, makeTestGroupMapping(), result)
+}
+
+func TestShouldReturnEntityNotFoundWhenGroupMappingDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), GroupMappingsResourcePath).Times(1).Return([]byte(`[]`), nil)
+
+	sut := createGroupMappingRestResource(client)
+
+	_, err := sut.GetOne(context.Background(), groupMappingTestID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetOneGroupMappingWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), GroupMappingsResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := createGroupMappingRestResource(client)
+
+	_, err := sut.GetOne(context.Background(), groupMappingTestID)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldSuccessfullyCreateGroupMappingUsingPOST(t *testing.T) {
+	mapping := makeTestGroupMapping()
+	mapping.ID = ""
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Post(gomock.Any(), mapping, GroupMappingsResourcePath).Times(1).Return(groupMappingSerialized, nil)
+
+	sut := createGroupMappingRestResource(client)
+
+	result, err := sut.Create(context.Background(), mapping)
+
+	require.NoError(t, err)
+	require.Equal(t, makeTestGroupMapping(), result)
+}
+
+func TestShouldFailToCreateGroupMappingWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Post(gomock.Any(), gomock.Any(), GroupMappingsResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := createGroupMappingRestResource(client)
+
+	_, err := sut.Create(context.Background(), makeTestGroupMapping())
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldSuccessfullyUpdateGroupMappingUsingPUT(t *testing.T) {
+	mapping := makeTestGroupMapping()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Put(gomock.Any(), mapping, GroupMappingsResourcePath).Times(1).Return(groupMappingSerialized, nil)
+
+	sut := createGroupMappingRestResource(client)
+
+	result, err := sut.Update(context.Background(), mapping)
+
+	require.NoError(t, err)
+	require.Equal(t, makeTestGroupMapping(), result)
+}
+
+func TestShouldFailToUpdateGroupMappingWhenResponseIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), GroupMappingsResourcePath).Times(1).Return([]byte("invalid"), nil)
+
+	sut := createGroupMappingRestResource(client)
+
+	_, err := sut.Update(context.Background(), makeTestGroupMapping())
+
+	require.Error(t, err)
+}
+
+func TestShouldSuccessfullyDeleteGroupMapping(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Delete(gomock.Any(), groupMappingTestID, GroupMappingsResourcePath).Times(1).Return(nil)
+
+	sut := createGroupMappingRestResource(client)
+
+	err := sut.Delete(context.Background(), makeTestGroupMapping())
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,35 @@
+package restapi
+
+const (
+	//GroupMappingsResourcePath path to the IdP group mappings of Instana RESTful API
+	GroupMappingsResourcePath = RBACSettingsBasePath + "/mappings"
+	//GroupMappingSettingsResourcePath path to the settings of the IdP group mappings of Instana RESTful API
+	GroupMappingSettingsResourcePath = GroupMappingsResourcePath + "/identityProvider/restrictEmptyIdpGroups"
+	//GroupMappingSettingsID the fixed ID of the IdP group mapping settings. The settings exist exactly once per tenant
+	//and are not identified by an ID in the Instana API
+	GroupMappingSettingsID = "global"
+)
+
+// GroupMapping data structure of an IdP group mapping of the Instana API. Users are added to the group with the given
+// ID when the attribute with the given key provided by the identity provider contains the given value.
+type GroupMapping struct {
+	ID      string `json:"id,omitempty"`
+	GroupID string `json:"groupId"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (m *GroupMapping) GetIDForResourcePath() string {
+	return m.ID
+}
+
+// GroupMappingSettings data structure of the tenant wide settings of the IdP group mappings of the Instana API
+type GroupMappingSettings struct {
+	RestrictEmptyIdpGroups bool `json:"restrictEmptyIdpGroups"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (s *GroupMappingSettings) GetIDForResourcePath() string {
+	return GroupMappingSettingsID
+}
@@ -0,0 +1,139 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func createGroupMappingSettingsRestResource(client RestClient) RestResource[*GroupMappingSettings] {
+	return NewSingletonRestResourceWithDefaults(GroupMappingSettingsResourcePath, NewDefaultJSONUnmarshaller(&GroupMappingSettings{}), client, &GroupMappingSettings{RestrictEmptyIdpGroups: false})
+}
+
+func TestShouldReturnFixedIDOfGroupMappingSettings(t *testing.T) {
+	require.Equal(t, GroupMappingSettingsID, (&GroupMappingSettings{}).GetIDForResourcePath())
+}
+
+func TestShouldUseIdentityProviderPathForGroupMappingSettings(t *testing.T) {
+	require.Equal(t, "/api/settings/rbac/mappings/identityProvider/restrictEmptyIdpGroups", GroupMappingSettingsResourcePath)
+}
+
+func TestShouldReturnIDOfGroupMapping(t *testing.T) {
+	require.Equal(t, "mapping-id", (&GroupMapping{ID: "mapping-id"}).GetIDForResourcePath())
+}
+
+func TestShouldSuccessfullyGetGroupMappingSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), GroupMappingSettingsResourcePath).Times(1).Return([]byte(`{"restrictEmptyIdpGroups":true}`), nil)
+
+	sut := createGroupMappingSettingsRestResource(client)
+
+	result, err := sut.GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*GroupMappingSettings{{RestrictEmptyIdpGroups: true}}, result)
+}
+
+func TestShouldReturnEntityNotFoundWhenGroupMappingSettingsAreRequestedWithOtherID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), GroupMappingSettingsResourcePath).Times(1).Return([]byte(`{"restrictEmptyIdpGroups":true}`), nil)
+
+	sut := createGroupMappingSettingsRestResource(client)
+
+	_, err := sut.GetOne(context.Background(), "other")
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetGroupMappingSettingsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), GroupMappingSettingsResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := createGroupMappingSettingsRestResource(client)
+
+	_, err := sut.GetOne(context.Background(), GroupMappingSettingsID)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldSuccessfullyCreateAndUpdateGroupMappingSettingsUsingPut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().PutContent(gomock.Any(), GroupMappingSettingsResourcePath, "application/json; charset=utf-8", []byte(`{"restrictEmptyIdpGroups":true}`)).Times(2).Return([]byte(`{"restrictEmptyIdpGroups":true}`), nil)
+
+	sut := createGroupMappingSettingsRestResource(client)
+
+	created, err := sut.Create(context.Background(), &GroupMappingSettings{RestrictEmptyIdpGroups: true})
+	require.NoError(t, err)
+	require.Equal(t, &GroupMappingSettings{RestrictEmptyIdpGroups: true}, created)
+
+	updated, err := sut.Update(context.Background(), &GroupMappingSettings{RestrictEmptyIdpGroups: true})
+	require.NoError(t, err)
+	require.Equal(t, &GroupMappingSettings{RestrictEmptyIdpGroups: true}, updated)
+}
+
+func TestShouldReturnProvidedGroupMappingSettingsWhenPutRespondsWithoutContent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().PutContent(gomock.Any(), GroupMappingSettingsResourcePath, gomock.Any(), gomock.Any()).Times(1).Return([]byte{}, nil)
+
+	sut := createGroupMappingSettingsRestResource(client)
+	settings := &GroupMappingSettings{RestrictEmptyIdpGroups: true}
+
+	result, err := sut.Update(context.Background(), settings)
+
+	require.NoError(t, err)
+	require.Same(t, settings, result)
+}
+
+func TestShouldFailToUpdateGroupMappingSettingsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().PutContent(gomock.Any(), GroupMappingSettingsResourcePath, gomock.Any(), gomock.Any()).Times(1).Return(nil, expectedError)
+
+	sut := createGroupMappingSettingsRestResource(client)
+
+	_, err := sut.Update(context.Background(), &GroupMappingSettings{RestrictEmptyIdpGroups: true})
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldDeleteGroupMappingSettingsByResettingToDefaults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().PutContent(gomock.Any(), GroupMappingSettingsResourcePath, gomock.Any(), []byte(`{"restrictEmptyIdpGroups":false}`)).Times(1).Return([]byte{}, nil)
+
+	sut := createGroupMappingSettingsRestResource(client)
+
+	err := sut.Delete(context.Background(), &GroupMappingSettings{RestrictEmptyIdpGroups: true})
+
+	require.NoError(t, err)
+}
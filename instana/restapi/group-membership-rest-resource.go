@@ -0,0 +1,244 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// NewGroupMembershipRestResource creates a new REST resource for the members of RBAC groups. The members are read from
+// the group. Changes are applied by adding the missing users to and removing the individual users from the group. Only
+// members which were previously managed are removed, so that members which were added otherwise, e.g. through group
+// mappings, invitations or the UI, are kept.
+func NewGroupMembershipRestResource(unmarshaller JSONUnmarshaller[*Group], client RestClient) RestResource[*GroupMembership] {
+	return &groupMembershipRestResource{
+		resourcePath: GroupsResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type groupMembershipRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*Group]
+	client       RestClient
+}
+
+func (r *groupMembershipRestResource) GetAll(ctx context.Context) (*[]*GroupMembership, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := r.unmarshaller.UnmarshalArray(data)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*GroupMembership, len(*groups))
+	for i, group := range *groups {
+		result[i] = r.toMembership(group)
+	}
+	return &result, nil
+}
+
+// GetOne returns the members of the group with the given ID. The email address of members for which the API does not
+// provide the email address is looked up from the users of the tenant, so that members can be matched by email address.
+func (r *groupMembershipRestResource) GetOne(ctx context.Context, id string) (*GroupMembership, error) {
+	data, err := r.client.GetOne(ctx, id, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	group, err := r.unmarshaller.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	membership := r.toMembership(group)
+	if err = r.completeEmails(ctx, membership.Members); err != nil {
+		return nil, err
+	}
+	return membership, nil
+}
+
+func (r *groupMembershipRestResource) completeEmails(ctx context.Context, members []APIMember) error {
+	var users []*User
+	for i, member := range members {
+		if member.Email != nil {
+			continue
+		}
+		if users == nil {
+			var err error
+			if users, err = r.getUsers(ctx); err != nil {
+				return err
+			}
+		}
+		for _, user := range users {
+			if user.ID == member.UserID && len(user.Email) > 0 {
+				email := user.Email
+				members[i].Email = &email
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (r *groupMembershipRestResource) getUsers(ctx context.Context) ([]*User, error) {
+	data, err := r.client.Get(ctx, UsersResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*User, 0)
+	if err = json.Unmarshal(data, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (r *groupMembershipRestResource) toMembership(group *Group) *GroupMembership {
+	members := group.Members
+	if members == nil {
+		members = []APIMember{}
+	}
+	return &GroupMembership{GroupID: group.ID, Members: members}
+}
+
+// Create adds the members of the given membership to the group
+func (r *groupMembershipRestResource) Create(ctx context.Context, data *GroupMembership) (*GroupMembership, error) {
+	return r.reconcile(ctx, data)
+}
+
+// Update adds the members of the given membership to the group and removes the previously managed members which are
+// not contained in the given membership anymore from the group
+func (r *groupMembershipRestResource) Update(ctx context.Context, data *GroupMembership) (*GroupMembership, error) {
+	return r.reconcile(ctx, data)
+}
+
+func (r *groupMembershipRestResource) reconcile(ctx context.Context, data *GroupMembership) (*GroupMembership, error) {
+	current, err := r.GetOne(ctx, data.GroupID)
+	if err != nil {
+		return data, err
+	}
+	if err = r.addMembers(ctx, data.GroupID, r.missingMembers(data.Members, current.Members)); err != nil {
+		return data, err
+	}
+	removedMembers := r.missingMembers(data.PreviousMembers, data.Members)
+	if err = r.removeMembers(ctx, data.GroupID, r.missingMembers(r.containedMembers(current.Members, removedMembers), data.Members)); err != nil {
+		return data, err
+	}
+	return r.GetOne(ctx, data.GroupID)
+}
+
+// missingMembers returns the members of source which are not contained in target
+func (r *groupMembershipRestResource) missingMembers(source []APIMember, target []APIMember) []APIMember {
+	return r.filterMembers(source, target, false)
+}
+
+// containedMembers returns the members of source which are contained in target
+func (r *groupMembershipRestResource) containedMembers(source []APIMember, target []APIMember) []APIMember {
+	return r.filterMembers(source, target, true)
+}
+
+func (r *groupMembershipRestResource) filterMembers(source []APIMember, target []APIMember, contained bool) []APIMember {
+	result := make([]APIMember, 0)
+	for _, member := range source {
+		found := false
+		for _, other := range target {
+			if member.Matches(other) {
+				found = true
+				break
+			}
+		}
+		if found == contained {
+			result = append(result, member)
+		}
+	}
+	return result
+}
+
+// addMembers adds the given members to the group in a single request. The Instana API expects the ids of the users.
+// Therefore, the ids of members which are only identified by their email address are looked up from the users of the
+// tenant.
+func (r *groupMembershipRestResource) addMembers(ctx context.Context, groupID string, members []APIMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+	userIDs, err := r.resolveUserIDs(ctx, members)
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(userIDs)
+	if err != nil {
+		return err
+	}
+	_, err = r.client.PutContent(ctx, r.resourcePath+"/"+groupID+groupUsersPathElement, encodingApplicationJSON, payload)
+	return err
+}
+
+func (r *groupMembershipRestResource) resolveUserIDs(ctx context.Context, members []APIMember) ([]string, error) {
+	var users []*User
+	userIDs := make([]string, len(members))
+	for i, member := range members {
+		if len(member.UserID) > 0 {
+			userIDs[i] = member.UserID
+			continue
+		}
+		if users == nil {
+			var err error
+			if users, err = r.getUsers(ctx); err != nil {
+				return nil, err
+			}
+		}
+		userID, err := r.findUserIDByEmail(users, member)
+		if err != nil {
+			return nil, err
+		}
+		userIDs[i] = userID
+	}
+	return userIDs, nil
+}
+
+func (r *groupMembershipRestResource) findUserIDByEmail(users []*User, member APIMember) (string, error) {
+	for _, user := range users {
+		if member.Matches(APIMember{UserID: user.ID, Email: &user.Email}) {
+			return user.ID, nil
+		}
+	}
+	email := ""
+	if member.Email != nil {
+		email = *member.Email
+	}
+	return "", fmt.Errorf("no user with email %s exists in the tenant", email)
+}
+
+func (r *groupMembershipRestResource) removeMembers(ctx context.Context, groupID string, members []APIMember) error {
+	for _, member := range members {
+		if err := r.client.Delete(ctx, member.UserID, r.groupUserPath(groupID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *groupMembershipRestResource) groupUserPath(groupID string) string {
+	return r.resourcePath + "/" + groupID + groupUserPathElement
+}
+
+// Delete removes the given members from the group. Other members of the group, e.g. users which were added through
+// group mappings or invitations in the meantime, are kept. The group itself is not deleted. Nothing needs to be done
+// when the group does not exist anymore.
+func (r *groupMembershipRestResource) Delete(ctx context.Context, data *GroupMembership) error {
+	current, err := r.GetOne(ctx, data.GroupID)
+	if errors.Is(err, ErrEntityNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return r.removeMembers(ctx, data.GroupID, r.containedMembers(current.Members, data.Members))
+}
+
+// DeleteByID does not remove any member from the group with the given ID as the members which are managed are not
+// known
+func (r *groupMembershipRestResource) DeleteByID(ctx context.Context, id string) error {
+	return r.Delete(ctx, &GroupMembership{GroupID: id, Members: []APIMember{}})
+}
@@ -0,0 +1,343 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	groupMembershipTestGroupID   = "group-id"
+	groupMembershipTestGroupPath = GroupsResourcePath + "/" + groupMembershipTestGroupID
+	groupMembershipTestUserPath  = groupMembershipTestGroupPath + "/user"
+)
+
+func createGroupMembershipRestResource(client RestClient) RestResource[*GroupMembership] {
+	return NewGroupMembershipRestResource(NewDefaultJSONUnmarshaller(&Group{}), client)
+}
+
+func groupMembershipTestEmail(email string) *string {
+	return &email
+}
+
+func TestShouldReturnGroupIDAsIDOfGroupMembership(t *testing.T) {
+	require.Equal(t, groupMembershipTestGroupID, (&GroupMembership{GroupID: groupMembershipTestGroupID}).GetIDForResourcePath())
+}
+
+func TestShouldMatchGroupMembersByUserIDOrEmail(t *testing.T) {
+	member := APIMember{UserID: "user-1", Email: groupMembershipTestEmail("user1@example.com")}
+
+	require.True(t, member.Matches(APIMember{UserID: "user-1"}))
+	require.True(t, member.Matches(APIMember{Email: groupMembershipTestEmail("USER1@example.com")}))
+	require.True(t, APIMember{Email: groupMembershipTestEmail("user1@example.com")}.Matches(member))
+	require.False(t, member.Matches(APIMember{UserID: "user-2", Email: groupMembershipTestEmail("user2@example.com")}))
+	require.False(t, APIMember{}.Matches(APIMember{}))
+}
+
+func TestShouldGetGroupMembershipFromGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(1).Return([]byte(`{"id":"group-id","name":"name","members":[{"userId":"user-1","email":"user1@example.com"}]}`), nil)
+
+	sut := createGroupMembershipRestResource(client)
+
+	result, err := sut.GetOne(context.Background(), groupMembershipTestGroupID)
+
+	require.NoError(t, err)
+	require.Equal(t, &GroupMembership{GroupID: groupMembershipTestGroupID, Members: []APIMember{{UserID: "user-1", Email: groupMembershipTestEmail("user1@example.com")}}}, result)
+}
+
+func TestShouldGetAllGroupMemberships(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), GroupsResourcePath).Times(1).Return([]byte(`[{"id":"group-1","name":"name1"},{"id":"group-2","name":"name2","members":[{"userId":"user-1"}]}]`), nil)
+
+	sut := createGroupMembershipRestResource(client)
+
+	result, err := sut.GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*GroupMembership{{GroupID: "group-1", Members: []APIMember{}}, {GroupID: "group-2", Members: []APIMember{{UserID: "user-1"}}}}, result)
+}
+
+func TestShouldFailToGetGroupMembershipWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := createGroupMembershipRestResource(client)
+
+	_, err := sut.GetOne(context.Background(), groupMembershipTestGroupID)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldCompleteEmailsOfGroupMembersFromUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(1).Return([]byte(`{"id":"group-id","members":[{"userId":"user-1","email":"user1@example.com"},{"userId":"user-2"},{"userId":"user-3"}]}`), nil)
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Times(1).Return([]byte(`[{"id":"user-1","email":"other@example.com"},{"id":"user-2","email":"user2@example.com"}]`), nil)
+
+	sut := createGroupMembershipRestResource(client)
+
+	result, err := sut.GetOne(context.Background(), groupMembershipTestGroupID)
+
+	require.NoError(t, err)
+	require.Equal(t, []APIMember{
+		{UserID: "user-1", Email: groupMembershipTestEmail("user1@example.com")},
+		{UserID: "user-2", Email: groupMembershipTestEmail("user2@example.com")},
+		{UserID: "user-3"},
+	}, result.Members)
+}
+
+func TestShouldFailToGetGroupMembershipWhenUsersCannotBeRetrieved(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(1).Return([]byte(`{"id":"group-id","members":[{"userId":"user-1"}]}`), nil)
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := createGroupMembershipRestResource(client)
+
+	_, err := sut.GetOne(context.Background(), groupMembershipTestGroupID)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldAddGroupMembersByUserIDWithoutRemovingOtherMembersWhenCreatingGroupMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	gomock.InOrder(
+		client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(1).Return([]byte(`{"id":"group-id","members":[{"userId":"user-1","email":"user1@example.com"},{"userId":"user-2","email":"user2@example.com"}]}`), nil),
+		client.EXPECT().Get(gomock.Any(), UsersResourcePath).Times(1).Return([]byte(`[{"id":"user-1","email":"user1@example.com"},{"id":"user-4","email":"USER4@example.com"}]`), nil),
+		client.EXPECT().PutContent(gomock.Any(), groupMembershipTestGroupPath+"/users", "application/json; charset=utf-8", []byte(`["user-3","user-4"]`)).Times(1).Return([]byte{}, nil),
+		client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(1).Return([]byte(`{"id":"group-id","members":[{"userId":"user-1","email":"user1@example.com"},{"userId":"user-2","email":"user2@example.com"},{"userId":"user-3","email":"user3@example.com"},{"userId":"user-4","email":"user4@example.com"}]}`), nil),
+	)
+	client.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	sut := createGroupMembershipRestResource(client)
+
+	result, err := sut.Create(context.Background(), &GroupMembership{
+		GroupID: groupMembershipTestGroupID,
+		Members: []APIMember{
+			{Email: groupMembershipTestEmail("USER1@example.com")},
+			{UserID: "user-3"},
+			{Email: groupMembershipTestEmail("user4@example.com")},
+		},
+		PreviousMembers: []APIMember{},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result.Members, 4)
+}
+
+func TestShouldAddNewMembersAndRemovePreviouslyManagedMembersIndividuallyWhenUpdatingGroupMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	gomock.InOrder(
+		client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(1).Return([]byte(`{"id":"group-id","members":[{"userId":"user-1","email":"user1@example.com"},{"userId":"user-2","email":"user2@example.com"},{"userId":"user-5","email":"user5@example.com"}]}`), nil),
+		client.EXPECT().PutContent(gomock.Any(), groupMembershipTestGroupPath+"/users", "application/json; charset=utf-8", []byte(`["user-3"]`)).Times(1).Return([]byte{}, nil),
+		client.EXPECT().Delete(gomock.Any(), "user-2", groupMembershipTestUserPath).Times(1).Return(nil),
+		client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(1).Return([]byte(`{"id":"group-id","members":[{"userId":"user-1","email":"user1@example.com"},{"userId":"user-3","email":"user3@example.com"},{"userId":"user-5","email":"user5@example.com"}]}`), nil),
+	)
+
+	sut := createGroupMembershipRestResource(client)
+
+	result, err := sut.Update(context.Background(), &GroupMembership{
+		GroupID:         groupMembershipTestGroupID,
+		Members:         []APIMember{{UserID: "user-1"}, {UserID: "user-3"}},
+		PreviousMembers: []APIMember{{UserID: "user-1"}, {Email: groupMembershipTestEmail("USER2@example.com")}},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result.Members, 3)
+}
+
+func TestShouldKeepMembersWhichAreNotManagedWhenUpdatingGroupMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(2).Return([]byte(`{"id":"group-id","members":[{"userId":"user-1","email":"user1@example.com"},{"userId":"user-2","email":"user2@example.com"}]}`), nil)
+	client.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	sut := createGroupMembershipRestResource(client)
+
+	result, err := sut.Update(context.Background(), &GroupMembership{
+		GroupID:         groupMembershipTestGroupID,
+		Members:         []APIMember{{UserID: "user-1"}},
+		PreviousMembers: []APIMember{{UserID: "user-1"}},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result.Members, 2)
+}
+
+func TestShouldNotRemoveMemberWhenReferenceChangesFromEmailToUserIDWhenUpdatingGroupMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(2).Return([]byte(`{"id":"group-id","members":[{"userId":"user-1","email":"user1@example.com"}]}`), nil)
+	client.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	sut := createGroupMembershipRestResource(client)
+
+	_, err := sut.Update(context.Background(), &GroupMembership{
+		GroupID:         groupMembershipTestGroupID,
+		Members:         []APIMember{{UserID: "user-1"}},
+		PreviousMembers: []APIMember{{Email: groupMembershipTestEmail("user1@example.com")}},
+	})
+
+	require.NoError(t, err)
+}
+
+func TestShouldNotModifyGroupWhenMembersAreUpToDate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(2).Return([]byte(`{"id":"group-id","members":[{"userId":"user-1","email":"user1@example.com"}]}`), nil)
+
+	sut := createGroupMembershipRestResource(client)
+
+	_, err := sut.Update(context.Background(), &GroupMembership{GroupID: groupMembershipTestGroupID, Members: []APIMember{{UserID: "user-1"}}})
+
+	require.NoError(t, err)
+}
+
+func TestShouldFailToCreateGroupMembershipWhenMemberCannotBeAdded(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(1).Return([]byte(`{"id":"group-id","members":[]}`), nil)
+	client.EXPECT().PutContent(gomock.Any(), groupMembershipTestGroupPath+"/users", gomock.Any(), []byte(`["user-1"]`)).Times(1).Return(nil, expectedError)
+
+	sut := createGroupMembershipRestResource(client)
+
+	_, err := sut.Create(context.Background(), &GroupMembership{GroupID: groupMembershipTestGroupID, Members: []APIMember{{UserID: "user-1"}}})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldFailToCreateGroupMembershipWhenUsersCannotBeRetrieved(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(1).Return([]byte(`{"id":"group-id","members":[]}`), nil)
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := createGroupMembershipRestResource(client)
+
+	_, err := sut.Create(context.Background(), &GroupMembership{GroupID: groupMembershipTestGroupID, Members: []APIMember{{Email: groupMembershipTestEmail("user1@example.com")}}})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldFailToCreateGroupMembershipWhenNoUserExistsForEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(1).Return([]byte(`{"id":"group-id","members":[]}`), nil)
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Times(1).Return([]byte(`[{"id":"user-2","email":"user2@example.com"}]`), nil)
+
+	sut := createGroupMembershipRestResource(client)
+
+	_, err := sut.Create(context.Background(), &GroupMembership{GroupID: groupMembershipTestGroupID, Members: []APIMember{{Email: groupMembershipTestEmail("user1@example.com")}}})
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "user1@example.com")
+}
+
+func TestShouldFailToUpdateGroupMembershipWhenMemberCannotBeRemoved(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(1).Return([]byte(`{"id":"group-id","members":[{"userId":"user-1","email":"user1@example.com"}]}`), nil)
+	client.EXPECT().Delete(gomock.Any(), "user-1", groupMembershipTestUserPath).Times(1).Return(expectedError)
+
+	sut := createGroupMembershipRestResource(client)
+
+	_, err := sut.Update(context.Background(), &GroupMembership{GroupID: groupMembershipTestGroupID, Members: []APIMember{}, PreviousMembers: []APIMember{{UserID: "user-1"}}})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldOnlyRemoveGivenMembersWhenDeletingGroupMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	email := "USER-2@example.com"
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(1).Return([]byte(`{"id":"group-id","members":[{"userId":"user-1","email":"user-1@example.com"},{"userId":"user-2","email":"user-2@example.com"},{"userId":"user-3","email":"user-3@example.com"}]}`), nil)
+	client.EXPECT().Delete(gomock.Any(), "user-1", groupMembershipTestUserPath).Times(1).Return(nil)
+	client.EXPECT().Delete(gomock.Any(), "user-2", groupMembershipTestUserPath).Times(1).Return(nil)
+	client.EXPECT().Delete(gomock.Any(), "user-3", groupMembershipTestUserPath).Times(0)
+
+	sut := createGroupMembershipRestResource(client)
+
+	err := sut.Delete(context.Background(), &GroupMembership{GroupID: groupMembershipTestGroupID, Members: []APIMember{{UserID: "user-1"}, {Email: &email}, {UserID: "user-4"}}})
+
+	require.NoError(t, err)
+}
+
+func TestShouldNotRemoveAnyMemberWhenDeletingGroupMembershipByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(1).Return([]byte(`{"id":"group-id","members":[{"userId":"user-1","email":"user1@example.com"}]}`), nil)
+	client.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	sut := createGroupMembershipRestResource(client)
+
+	err := sut.DeleteByID(context.Background(), groupMembershipTestGroupID)
+
+	require.NoError(t, err)
+}
+
+func TestShouldSucceedToDeleteGroupMembershipWhenGroupDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipTestGroupID, GroupsResourcePath).Times(1).Return(nil, ErrEntityNotFound)
+
+	sut := createGroupMembershipRestResource(client)
+
+	err := sut.DeleteByID(context.Background(), groupMembershipTestGroupID)
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,36 @@
+package restapi
+
+import "strings"
+
+const (
+	//groupUserPathElement path element to remove a single user from a group
+	groupUserPathElement = "/user"
+	//groupUsersPathElement path element to the users of a group
+	groupUsersPathElement = "/users"
+)
+
+// GroupMembership data structure for the members of an Instana RBAC group. The membership is not a dedicated entity
+// of the Instana API. It is read from the group and maintained by adding users to and removing individual users from
+// the group.
+type GroupMembership struct {
+	GroupID string
+	Members []APIMember
+	//PreviousMembers the members which were managed before the change. Only these members are removed from the group
+	//when they are not contained in Members anymore
+	PreviousMembers []APIMember
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. The ID of the group is used as the ID of the
+// membership
+func (m *GroupMembership) GetIDForResourcePath() string {
+	return m.GroupID
+}
+
+// Matches returns true when the member refers to the same user as the given member. Members are compared by user id
+// and case-insensitive by email address.
+func (m APIMember) Matches(other APIMember) bool {
+	if len(m.UserID) > 0 && m.UserID == other.UserID {
+		return true
+	}
+	return m.Email != nil && other.Email != nil && strings.EqualFold(*m.Email, *other.Email)
+}
@@ -0,0 +1,103 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	//InstanaVersionResourcePath path to the version information of the Instana backend
+	InstanaVersionResourcePath = InstanaAPIBasePath + "/instana/version"
+	//InstanaHealthResourcePath path to the health state of the Instana backend
+	InstanaHealthResourcePath = InstanaAPIBasePath + "/instana/health"
+)
+
+// VersionInfo is the representation of the version information of the Instana backend
+type VersionInfo struct {
+	Branch   string `json:"branch"`
+	Commit   string `json:"commit"`
+	ImageTag string `json:"imageTag"`
+}
+
+// HealthStateGreen the health state of a healthy Instana backend
+const HealthStateGreen = "GREEN"
+
+// HealthState is the representation of the health state of the Instana backend
+type HealthState struct {
+	Health   string   `json:"health"`
+	Messages []string `json:"messages"`
+}
+
+// BackendVersion the release version of the Instana backend
+type BackendVersion struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// ParseBackendVersion parses the image tag of the Instana backend (e.g. 3.265.377-0) into a BackendVersion. The build
+// suffix is ignored and missing minor or patch versions are considered as 0.
+func ParseBackendVersion(imageTag string) (BackendVersion, error) {
+	versionString := strings.SplitN(strings.TrimPrefix(strings.TrimSpace(imageTag), "v"), "-", 2)[0]
+	parts := strings.Split(versionString, ".")
+	if len(parts) > 3 {
+		return BackendVersion{}, fmt.Errorf("invalid Instana backend version %s", imageTag)
+	}
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return BackendVersion{}, fmt.Errorf("invalid Instana backend version %s", imageTag)
+		}
+		numbers[i] = number
+	}
+	return BackendVersion{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+// IsAtLeast checks if the version is equal to or newer than the given version
+func (v BackendVersion) IsAtLeast(other BackendVersion) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor > other.Minor
+	}
+	return v.Patch >= other.Patch
+}
+
+// String returns the string representation of the version
+func (v BackendVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Version implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Version(ctx context.Context) (*VersionInfo, error) {
+	versionInfo := &VersionInfo{}
+	if err := api.getAndUnmarshal(ctx, InstanaVersionResourcePath, versionInfo); err != nil {
+		return nil, err
+	}
+	return versionInfo, nil
+}
+
+// Health implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Health(ctx context.Context) (*HealthState, error) {
+	healthState := &HealthState{}
+	if err := api.getAndUnmarshal(ctx, InstanaHealthResourcePath, healthState); err != nil {
+		return nil, err
+	}
+	return healthState, nil
+}
+
+func (api *baseInstanaAPI) getAndUnmarshal(ctx context.Context, resourcePath string, target interface{}) error {
+	data, err := api.client.Get(ctx, resourcePath)
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("failed to parse response of %s; %w", resourcePath, err)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package restapi_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldParseBackendVersion(t *testing.T) {
+	testCases := map[string]BackendVersion{
+		"3.265.377-0": {Major: 3, Minor: 265, Patch: 377},
+		"3.265.377":   {Major: 3, Minor: 265, Patch: 377},
+		"v1.2":        {Major: 1, Minor: 2},
+		"275":         {Major: 275},
+	}
+	for imageTag, expected := range testCases {
+		t.Run(imageTag, func(t *testing.T) {
+			version, err := ParseBackendVersion(imageTag)
+
+			require.NoError(t, err)
+			require.Equal(t, expected, version)
+		})
+	}
+}
+
+func TestShouldFailToParseInvalidBackendVersion(t *testing.T) {
+	for _, imageTag := range []string{"", "latest", "3.x.1", "1.2.3.4", "3.-1.0"} {
+		t.Run(imageTag, func(t *testing.T) {
+			_, err := ParseBackendVersion(imageTag)
+
+			require.ErrorContains(t, err, "invalid Instana backend version")
+		})
+	}
+}
+
+func TestShouldCompareBackendVersions(t *testing.T) {
+	version := BackendVersion{Major: 3, Minor: 265, Patch: 377}
+
+	require.True(t, version.IsAtLeast(version))
+	require.True(t, version.IsAtLeast(BackendVersion{Major: 3, Minor: 265, Patch: 1}))
+	require.True(t, version.IsAtLeast(BackendVersion{Major: 3, Minor: 100, Patch: 999}))
+	require.True(t, version.IsAtLeast(BackendVersion{Major: 2, Minor: 999}))
+	require.False(t, version.IsAtLeast(BackendVersion{Major: 3, Minor: 265, Patch: 378}))
+	require.False(t, version.IsAtLeast(BackendVersion{Major: 3, Minor: 266}))
+	require.False(t, version.IsAtLeast(BackendVersion{Major: 4}))
+	require.Equal(t, "3.265.377", version.String())
+}
+
+func TestShouldReturnVersionAndHealthStateOfInstanaBackend(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, InstanaVersionResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, []byte(`{"branch":"release-265","commit":"abc","imageTag":"3.265.377-0"}`))
+	})
+	httpServer.AddRoute(http.MethodGet, InstanaHealthResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, []byte(`{"health":"RED","messages":["No data being processed"]}`))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	api, err := NewInstanaAPI(createTestClientConfig(httpServer, testRetryConfig, DefaultThrottleConfig()))
+	require.NoError(t, err)
+
+	versionInfo, err := api.Version(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &VersionInfo{Branch: "release-265", Commit: "abc", ImageTag: "3.265.377-0"}, versionInfo)
+
+	healthState, err := api.Health(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &HealthState{Health: "RED", Messages: []string{"No data being processed"}}, healthState)
+}
+
+func TestShouldFailToReturnVersionOfInstanaBackendWhenResponseIsInvalid(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, InstanaVersionResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, []byte(`invalid`))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	api, err := NewInstanaAPI(createTestClientConfig(httpServer, testRetryConfig, DefaultThrottleConfig()))
+	require.NoError(t, err)
+
+	_, err = api.Version(context.Background())
+
+	require.ErrorContains(t, err, "failed to parse response of "+InstanaVersionResourcePath)
+}
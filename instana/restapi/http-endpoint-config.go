@@ -0,0 +1,58 @@
+package restapi
+
+// HTTPEndpointConfigResourcePath path to HTTP endpoint config resource of Instana RESTful API
+const HTTPEndpointConfigResourcePath = ApplicationMonitoringSettingsBasePath + "/http-endpoint"
+
+// HTTPEndpointConfig data structure of the HTTP endpoint configuration of a service of the Instana API
+type HTTPEndpointConfig struct {
+	ServiceID                                      string             `json:"serviceId"`
+	EndpointNameByFirstPathSegmentRuleEnabled      bool               `json:"endpointNameByFirstPathSegmentRuleEnabled"`
+	EndpointNameByCollectedPathTemplateRuleEnabled bool               `json:"endpointNameByCollectedPathTemplateRuleEnabled"`
+	Rules                                          []HTTPEndpointRule `json:"rules"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. HTTP endpoint configs are identified by the
+// ID of the service
+func (c *HTTPEndpointConfig) GetIDForResourcePath() string {
+	return c.ServiceID
+}
+
+// HTTPEndpointRule data structure of a rule of a HTTP endpoint configuration of the Instana API
+type HTTPEndpointRule struct {
+	Enabled      bool                          `json:"enabled"`
+	PathSegments []HTTPPathSegmentMatchingRule `json:"pathSegments"`
+	TestCases    []string                      `json:"testCases"`
+}
+
+// HTTPPathSegmentMatchingRule data structure of a path segment of a HTTP endpoint rule of the Instana API
+type HTTPPathSegmentMatchingRule struct {
+	Type HTTPPathSegmentMatchingType `json:"type"`
+	Name *string                     `json:"name,omitempty"`
+}
+
+// HTTPPathSegmentMatchingType custom type for the type of a HTTP path segment matching rule
+type HTTPPathSegmentMatchingType string
+
+// HTTPPathSegmentMatchingTypes custom type for a slice of HTTPPathSegmentMatchingType
+type HTTPPathSegmentMatchingTypes []HTTPPathSegmentMatchingType
+
+// ToStringSlice Returns the corresponding string representations
+func (types HTTPPathSegmentMatchingTypes) ToStringSlice() []string {
+	result := make([]string, len(types))
+	for i, v := range types {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//HTTPPathSegmentMatchingTypeFixed constant value for the FIXED HTTPPathSegmentMatchingType
+	HTTPPathSegmentMatchingTypeFixed = HTTPPathSegmentMatchingType("FIXED")
+	//HTTPPathSegmentMatchingTypeParameter constant value for the PARAMETER HTTPPathSegmentMatchingType
+	HTTPPathSegmentMatchingTypeParameter = HTTPPathSegmentMatchingType("PARAMETER")
+	//HTTPPathSegmentMatchingTypeMatchAll constant value for the MATCH_ALL HTTPPathSegmentMatchingType
+	HTTPPathSegmentMatchingTypeMatchAll = HTTPPathSegmentMatchingType("MATCH_ALL")
+)
+
+// SupportedHTTPPathSegmentMatchingTypes list of all supported HTTPPathSegmentMatchingType
+var SupportedHTTPPathSegmentMatchingTypes = HTTPPathSegmentMatchingTypes{HTTPPathSegmentMatchingTypeFixed, HTTPPathSegmentMatchingTypeParameter, HTTPPathSegmentMatchingTypeMatchAll}
@@ -1,18 +1,20 @@
 package restapi
 
+import "context"
+
 // InstanaDataObject is a marker interface for any data object provided by any resource of the Instana REST API
 type InstanaDataObject interface {
 	GetIDForResourcePath() string
 }
 
-// RestResource interface definition of a instana REST resource.
+// RestResource interface definition of a instana REST resource. All operations are bound to the provided context.
 type RestResource[T InstanaDataObject] interface {
-	GetAll() (*[]T, error)
-	GetOne(id string) (T, error)
-	Create(data T) (T, error)
-	Update(data T) (T, error)
-	Delete(data T) error
-	DeleteByID(id string) error
+	GetAll(ctx context.Context) (*[]T, error)
+	GetOne(ctx context.Context, id string) (T, error)
+	Create(ctx context.Context, data T) (T, error)
+	Update(ctx context.Context, data T) (T, error)
+	Delete(ctx context.Context, data T) error
+	DeleteByID(ctx context.Context, id string) error
 }
 
 // DataFilterFunc function definition for filtering data received from Instana API
@@ -21,9 +23,9 @@ type DataFilterFunc func(o InstanaDataObject) bool
 // ReadOnlyRestResource interface definition for a read only REST resource. The resource at instana might
 // implement more methods but the implementation of the provider is limited to read only.
 type ReadOnlyRestResource[T InstanaDataObject] interface {
-	GetAll() (*[]T, error)
-	GetByQuery(queryParams map[string]string) (*[]T, error)
-	GetOne(id string) (T, error)
+	GetAll(ctx context.Context) (*[]T, error)
+	GetByQuery(ctx context.Context, queryParams map[string]string) (*[]T, error)
+	GetOne(ctx context.Context, id string) (T, error)
 }
 
 // JSONUnmarshaller interface definition for unmarshalling that unmarshalls JSON to go data structures
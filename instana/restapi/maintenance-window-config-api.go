@@ -0,0 +1,89 @@
+package restapi
+
+// MaintenanceWindowConfigResourcePath path to maintenance window config resource of Instana RESTful API
+const MaintenanceWindowConfigResourcePath = SettingsBasePath + "/v2/maintenance"
+
+// MaintenanceWindowSchedulingType custom type for the type of the scheduling of a maintenance window
+type MaintenanceWindowSchedulingType string
+
+// MaintenanceWindowSchedulingTypes custom type for a slice of MaintenanceWindowSchedulingType
+type MaintenanceWindowSchedulingTypes []MaintenanceWindowSchedulingType
+
+// ToStringSlice Returns the corresponding string representations
+func (types MaintenanceWindowSchedulingTypes) ToStringSlice() []string {
+	result := make([]string, len(types))
+	for i, v := range types {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//MaintenanceWindowSchedulingTypeOneTime constant value for a maintenance window which takes place only once
+	MaintenanceWindowSchedulingTypeOneTime = MaintenanceWindowSchedulingType("ONE_TIME")
+	//MaintenanceWindowSchedulingTypeRecurrent constant value for a maintenance window which is repeated according to a recurrence rule
+	MaintenanceWindowSchedulingTypeRecurrent = MaintenanceWindowSchedulingType("RECURRENT")
+)
+
+// SupportedMaintenanceWindowSchedulingTypes list of all supported MaintenanceWindowSchedulingType
+var SupportedMaintenanceWindowSchedulingTypes = MaintenanceWindowSchedulingTypes{MaintenanceWindowSchedulingTypeOneTime, MaintenanceWindowSchedulingTypeRecurrent}
+
+// MaintenanceWindowDurationUnit custom type for the unit of the duration of a maintenance window
+type MaintenanceWindowDurationUnit string
+
+// MaintenanceWindowDurationUnits custom type for a slice of MaintenanceWindowDurationUnit
+type MaintenanceWindowDurationUnits []MaintenanceWindowDurationUnit
+
+// ToStringSlice Returns the corresponding string representations
+func (units MaintenanceWindowDurationUnits) ToStringSlice() []string {
+	result := make([]string, len(units))
+	for i, v := range units {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//MaintenanceWindowDurationUnitMinutes constant value for the duration unit MINUTES
+	MaintenanceWindowDurationUnitMinutes = MaintenanceWindowDurationUnit("MINUTES")
+	//MaintenanceWindowDurationUnitHours constant value for the duration unit HOURS
+	MaintenanceWindowDurationUnitHours = MaintenanceWindowDurationUnit("HOURS")
+	//MaintenanceWindowDurationUnitDays constant value for the duration unit DAYS
+	MaintenanceWindowDurationUnitDays = MaintenanceWindowDurationUnit("DAYS")
+)
+
+// SupportedMaintenanceWindowDurationUnits list of all supported MaintenanceWindowDurationUnit
+var SupportedMaintenanceWindowDurationUnits = MaintenanceWindowDurationUnits{MaintenanceWindowDurationUnitMinutes, MaintenanceWindowDurationUnitHours, MaintenanceWindowDurationUnitDays}
+
+// MaintenanceWindowDuration represents the duration of a maintenance window
+type MaintenanceWindowDuration struct {
+	Amount int64                         `json:"amount"`
+	Unit   MaintenanceWindowDurationUnit `json:"unit"`
+}
+
+// MaintenanceWindowScheduling represents the scheduling of a maintenance window. Start is a unix timestamp in
+// milliseconds. RRule and TimezoneID are only supported for recurrent maintenance windows.
+type MaintenanceWindowScheduling struct {
+	Type       MaintenanceWindowSchedulingType `json:"type"`
+	Start      int64                           `json:"start"`
+	Duration   MaintenanceWindowDuration       `json:"duration"`
+	RRule      *string                         `json:"rrule,omitempty"`
+	TimezoneID *string                         `json:"timezoneId,omitempty"`
+}
+
+// MaintenanceWindowConfig represents the REST resource of maintenance window configurations at Instana
+type MaintenanceWindowConfig struct {
+	ID                         string                      `json:"id"`
+	Name                       string                      `json:"name"`
+	Query                      string                      `json:"query"`
+	TagFilterExpressionEnabled bool                        `json:"tagFilterExpressionEnabled"`
+	TagFilterExpression        *TagFilter                  `json:"tagFilterExpression"`
+	Scheduling                 MaintenanceWindowScheduling `json:"scheduling"`
+	Paused                     bool                        `json:"paused"`
+	State                      string                      `json:"state,omitempty"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *MaintenanceWindowConfig) GetIDForResourcePath() string {
+	return c.ID
+}
@@ -0,0 +1,98 @@
+package restapi
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	maintenanceWindowPauseOperation  = "pause"
+	maintenanceWindowResumeOperation = "resume"
+)
+
+// NewMaintenanceWindowConfigRestResource creates a new REST resource for maintenance window configs. Create and update
+// are implemented using PUT. The paused flag is applied through the dedicated pause and resume endpoints when the
+// maintenance window returned by Instana is not in the requested state.
+func NewMaintenanceWindowConfigRestResource(unmarshaller JSONUnmarshaller[*MaintenanceWindowConfig], client RestClient) RestResource[*MaintenanceWindowConfig] {
+	return &maintenanceWindowConfigRestResource{
+		resourcePath: MaintenanceWindowConfigResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type maintenanceWindowConfigRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*MaintenanceWindowConfig]
+	client       RestClient
+}
+
+func (r *maintenanceWindowConfigRestResource) GetAll(ctx context.Context) (*[]*MaintenanceWindowConfig, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.unmarshaller.UnmarshalArray(data)
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (r *maintenanceWindowConfigRestResource) GetOne(ctx context.Context, id string) (*MaintenanceWindowConfig, error) {
+	data, err := r.client.GetOne(ctx, id, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshaller.Unmarshal(data)
+}
+
+func (r *maintenanceWindowConfigRestResource) Create(ctx context.Context, data *MaintenanceWindowConfig) (*MaintenanceWindowConfig, error) {
+	return r.upsert(ctx, data)
+}
+
+func (r *maintenanceWindowConfigRestResource) Update(ctx context.Context, data *MaintenanceWindowConfig) (*MaintenanceWindowConfig, error) {
+	return r.upsert(ctx, data)
+}
+
+// upsert stores the maintenance window and reads it again as Instana does not return the maintenance window when it
+// cannot be scheduled immediately
+func (r *maintenanceWindowConfigRestResource) upsert(ctx context.Context, data *MaintenanceWindowConfig) (*MaintenanceWindowConfig, error) {
+	_, err := r.client.Put(ctx, data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	result, err := r.GetOne(ctx, data.GetIDForResourcePath())
+	if err != nil {
+		return data, err
+	}
+	if result.Paused != data.Paused {
+		return r.setPaused(ctx, data.GetIDForResourcePath(), data.Paused)
+	}
+	return result, nil
+}
+
+// setPaused pauses or resumes the maintenance window. The maintenance window is read again when Instana does not return
+// it in the response
+func (r *maintenanceWindowConfigRestResource) setPaused(ctx context.Context, id string, paused bool) (*MaintenanceWindowConfig, error) {
+	operation := maintenanceWindowResumeOperation
+	if paused {
+		operation = maintenanceWindowPauseOperation
+	}
+	response, err := r.client.PutByPath(ctx, fmt.Sprintf("%s/%s/%s", r.resourcePath, id, operation))
+	if err != nil {
+		return nil, err
+	}
+	if len(response) == 0 {
+		return r.GetOne(ctx, id)
+	}
+	return r.unmarshaller.Unmarshal(response)
+}
+
+func (r *maintenanceWindowConfigRestResource) Delete(ctx context.Context, data *MaintenanceWindowConfig) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
+}
+
+func (r *maintenanceWindowConfigRestResource) DeleteByID(ctx context.Context, id string) error {
+	return r.client.Delete(ctx, id, r.resourcePath)
+}
@@ -0,0 +1,245 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const maintenanceWindowConfigID = "maintenance-window-id"
+
+var maintenanceWindowConfigSerialized = []byte("serialized")
+var maintenanceWindowConfigPauseStateSerialized = []byte("pause-state-serialized")
+
+func makeMaintenanceWindowConfig(paused bool) *MaintenanceWindowConfig {
+	return &MaintenanceWindowConfig{
+		ID:                         maintenanceWindowConfigID,
+		Name:                       "name",
+		TagFilterExpressionEnabled: true,
+		TagFilterExpression:        NewStringTagFilter(TagFilterEntityNotApplicable, "entity.name", EqualsOperator, "foo"),
+		Scheduling: MaintenanceWindowScheduling{
+			Type:     MaintenanceWindowSchedulingTypeOneTime,
+			Start:    1683864000000,
+			Duration: MaintenanceWindowDuration{Amount: 2, Unit: MaintenanceWindowDurationUnitHours},
+		},
+		Paused: paused,
+	}
+}
+
+func TestShouldSuccessfullyGetAllMaintenanceWindowConfigs(t *testing.T) {
+	expectedResult := []*MaintenanceWindowConfig{makeMaintenanceWindowConfig(false), makeMaintenanceWindowConfig(true)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+
+	client.EXPECT().Get(gomock.Any(), MaintenanceWindowConfigResourcePath).Times(1).Return(maintenanceWindowConfigSerialized, nil)
+	unmarshaller.EXPECT().UnmarshalArray(maintenanceWindowConfigSerialized).Times(1).Return(&expectedResult, nil)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResult, result)
+}
+
+func TestShouldFailToGetAllMaintenanceWindowConfigsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+
+	client.EXPECT().Get(gomock.Any(), MaintenanceWindowConfigResourcePath).Times(1).Return(nil, expectedError)
+	unmarshaller.EXPECT().UnmarshalArray(gomock.Any()).Times(0)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetAll(context.Background())
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldSuccessfullyGetOneMaintenanceWindowConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+	config := makeMaintenanceWindowConfig(false)
+
+	client.EXPECT().GetOne(gomock.Any(), maintenanceWindowConfigID, MaintenanceWindowConfigResourcePath).Times(1).Return(maintenanceWindowConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(maintenanceWindowConfigSerialized).Times(1).Return(config, nil)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetOne(context.Background(), maintenanceWindowConfigID)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldFailToGetOneMaintenanceWindowConfigWhenClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().GetOne(gomock.Any(), maintenanceWindowConfigID, MaintenanceWindowConfigResourcePath).Times(1).Return(nil, expectedError)
+	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(context.Background(), maintenanceWindowConfigID)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldCreateMaintenanceWindowConfigWithoutChangingPauseStateWhenPauseStateMatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+	config := makeMaintenanceWindowConfig(false)
+
+	client.EXPECT().Put(gomock.Any(), config, MaintenanceWindowConfigResourcePath).Times(1).Return(maintenanceWindowConfigSerialized, nil)
+	client.EXPECT().GetOne(gomock.Any(), maintenanceWindowConfigID, MaintenanceWindowConfigResourcePath).Times(1).Return(maintenanceWindowConfigSerialized, nil)
+	client.EXPECT().PutByPath(gomock.Any(), gomock.Any()).Times(0)
+	unmarshaller.EXPECT().Unmarshal(maintenanceWindowConfigSerialized).Times(1).Return(config, nil)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Create(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldPauseMaintenanceWindowConfigWhenCreatedMaintenanceWindowIsNotPaused(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+	config := makeMaintenanceWindowConfig(true)
+	pausedConfig := makeMaintenanceWindowConfig(true)
+
+	client.EXPECT().Put(gomock.Any(), config, MaintenanceWindowConfigResourcePath).Times(1).Return(maintenanceWindowConfigSerialized, nil)
+	client.EXPECT().GetOne(gomock.Any(), maintenanceWindowConfigID, MaintenanceWindowConfigResourcePath).Times(1).Return(maintenanceWindowConfigSerialized, nil)
+	client.EXPECT().PutByPath(gomock.Any(), MaintenanceWindowConfigResourcePath+"/"+maintenanceWindowConfigID+"/pause").Times(1).Return(maintenanceWindowConfigPauseStateSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(maintenanceWindowConfigSerialized).Times(1).Return(makeMaintenanceWindowConfig(false), nil)
+	unmarshaller.EXPECT().Unmarshal(maintenanceWindowConfigPauseStateSerialized).Times(1).Return(pausedConfig, nil)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Create(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, pausedConfig, result)
+}
+
+func TestShouldResumeMaintenanceWindowConfigWhenUpdatedMaintenanceWindowIsPaused(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+	config := makeMaintenanceWindowConfig(false)
+	resumedConfig := makeMaintenanceWindowConfig(false)
+
+	client.EXPECT().Put(gomock.Any(), config, MaintenanceWindowConfigResourcePath).Times(1).Return(maintenanceWindowConfigSerialized, nil)
+	client.EXPECT().GetOne(gomock.Any(), maintenanceWindowConfigID, MaintenanceWindowConfigResourcePath).Times(1).Return(maintenanceWindowConfigSerialized, nil)
+	client.EXPECT().PutByPath(gomock.Any(), MaintenanceWindowConfigResourcePath+"/"+maintenanceWindowConfigID+"/resume").Times(1).Return(maintenanceWindowConfigPauseStateSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(maintenanceWindowConfigSerialized).Times(1).Return(makeMaintenanceWindowConfig(true), nil)
+	unmarshaller.EXPECT().Unmarshal(maintenanceWindowConfigPauseStateSerialized).Times(1).Return(resumedConfig, nil)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Update(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, resumedConfig, result)
+}
+
+func TestShouldReadMaintenanceWindowConfigAgainWhenPauseRequestReturnsEmptyResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+	config := makeMaintenanceWindowConfig(true)
+	pausedConfig := makeMaintenanceWindowConfig(true)
+
+	client.EXPECT().Put(gomock.Any(), config, MaintenanceWindowConfigResourcePath).Times(1).Return(maintenanceWindowConfigSerialized, nil)
+	client.EXPECT().GetOne(gomock.Any(), maintenanceWindowConfigID, MaintenanceWindowConfigResourcePath).Times(2).Return(maintenanceWindowConfigSerialized, nil)
+	client.EXPECT().PutByPath(gomock.Any(), MaintenanceWindowConfigResourcePath+"/"+maintenanceWindowConfigID+"/pause").Times(1).Return([]byte{}, nil)
+	gomock.InOrder(
+		unmarshaller.EXPECT().Unmarshal(maintenanceWindowConfigSerialized).Times(1).Return(makeMaintenanceWindowConfig(false), nil),
+		unmarshaller.EXPECT().Unmarshal(maintenanceWindowConfigSerialized).Times(1).Return(pausedConfig, nil),
+	)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Update(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, pausedConfig, result)
+}
+
+func TestShouldFailToUpdateMaintenanceWindowConfigWhenPutRequestFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+	config := makeMaintenanceWindowConfig(false)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Put(gomock.Any(), config, MaintenanceWindowConfigResourcePath).Times(1).Return(nil, expectedError)
+	client.EXPECT().GetOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, client)
+
+	_, err := sut.Update(context.Background(), config)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToUpdateMaintenanceWindowConfigWhenPauseRequestFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+	config := makeMaintenanceWindowConfig(true)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Put(gomock.Any(), config, MaintenanceWindowConfigResourcePath).Times(1).Return(maintenanceWindowConfigSerialized, nil)
+	client.EXPECT().GetOne(gomock.Any(), maintenanceWindowConfigID, MaintenanceWindowConfigResourcePath).Times(1).Return(maintenanceWindowConfigSerialized, nil)
+	client.EXPECT().PutByPath(gomock.Any(), MaintenanceWindowConfigResourcePath+"/"+maintenanceWindowConfigID+"/pause").Times(1).Return(nil, expectedError)
+	unmarshaller.EXPECT().Unmarshal(maintenanceWindowConfigSerialized).Times(1).Return(makeMaintenanceWindowConfig(false), nil)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, client)
+
+	_, err := sut.Update(context.Background(), config)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldSuccessfullyDeleteMaintenanceWindowConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+
+	client.EXPECT().Delete(gomock.Any(), maintenanceWindowConfigID, MaintenanceWindowConfigResourcePath).Times(2).Return(nil)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, client)
+
+	require.NoError(t, sut.Delete(context.Background(), makeMaintenanceWindowConfig(false)))
+	require.NoError(t, sut.DeleteByID(context.Background(), maintenanceWindowConfigID))
+}
@@ -0,0 +1,66 @@
+package restapi
+
+import "context"
+
+// NewManualServiceConfigRestResource creates a new REST resource for manual service configs. Manual service configs are
+// created using POST and updated using PUT.
+func NewManualServiceConfigRestResource(unmarshaller JSONUnmarshaller[*ManualServiceConfig], client RestClient) RestResource[*ManualServiceConfig] {
+	return &manualServiceConfigRestResource{
+		resourcePath: ManualServiceConfigResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type manualServiceConfigRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*ManualServiceConfig]
+	client       RestClient
+}
+
+func (r *manualServiceConfigRestResource) GetAll(ctx context.Context) (*[]*ManualServiceConfig, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshaller.UnmarshalArray(data)
+}
+
+// GetOne returns the manual service config with the given ID. The Instana API does not provide an endpoint to retrieve
+// a single manual service config. Therefore, the config is looked up in the list of all manual service configs.
+func (r *manualServiceConfigRestResource) GetOne(ctx context.Context, id string) (*ManualServiceConfig, error) {
+	configs, err := r.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range *configs {
+		if config.ID == id {
+			return config, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *manualServiceConfigRestResource) Create(ctx context.Context, data *ManualServiceConfig) (*ManualServiceConfig, error) {
+	response, err := r.client.Post(ctx, data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	return r.unmarshaller.Unmarshal(response)
+}
+
+func (r *manualServiceConfigRestResource) Update(ctx context.Context, data *ManualServiceConfig) (*ManualServiceConfig, error) {
+	response, err := r.client.Put(ctx, data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	return r.unmarshaller.Unmarshal(response)
+}
+
+func (r *manualServiceConfigRestResource) Delete(ctx context.Context, data *ManualServiceConfig) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
+}
+
+func (r *manualServiceConfigRestResource) DeleteByID(ctx context.Context, id string) error {
+	return r.client.Delete(ctx, id, r.resourcePath)
+}
@@ -0,0 +1,174 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const manualServiceConfigID = "manual-service-config-id"
+
+var manualServiceConfigsSerialized = []byte(`[{"id":"other","enabled":true,"existingServiceId":"service-id","tagFilterExpression":{"type":"TAG_FILTER","name":"service.name","operator":"EQUALS","entity":"DESTINATION","value":"other"}},{"id":"manual-service-config-id","description":"description","enabled":true,"unmonitoredServiceName":"redis","tagFilterExpression":{"type":"TAG_FILTER","name":"call.database.connection","operator":"EQUALS","entity":"DESTINATION","stringValue":"redis:6379","value":"redis:6379"}}]`)
+var manualServiceConfigSerialized = []byte(`{"id":"manual-service-config-id","description":"description","enabled":true,"unmonitoredServiceName":"redis","tagFilterExpression":{"type":"TAG_FILTER","name":"call.database.connection","operator":"EQUALS","entity":"DESTINATION","stringValue":"redis:6379","value":"redis:6379"}}`)
+
+func makeTestManualServiceConfig() *ManualServiceConfig {
+	unmonitoredServiceName := "redis"
+	return &ManualServiceConfig{
+		ID:                     manualServiceConfigID,
+		Description:            "description",
+		Enabled:                true,
+		UnmonitoredServiceName: &unmonitoredServiceName,
+		TagFilterExpression:    NewStringTagFilter(TagFilterEntityDestination, "call.database.connection", EqualsOperator, "redis:6379"),
+	}
+}
+
+func TestShouldSuccessfullyGetAllManualServiceConfigs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), ManualServiceConfigResourcePath).Times(1).Return(manualServiceConfigsSerialized, nil)
+
+	sut := NewManualServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ManualServiceConfig{}), client)
+
+	result, err := sut.GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, *result, 2)
+	require.Equal(t, "other", (*result)[0].ID)
+	require.Equal(t, makeTestManualServiceConfig(), (*result)[1])
+}
+
+func TestShouldSuccessfullyGetOneManualServiceConfigFromListOfAllConfigs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), ManualServiceConfigResourcePath).Times(1).Return(manualServiceConfigsSerialized, nil)
+
+	sut := NewManualServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ManualServiceConfig{}), client)
+
+	result, err := sut.GetOne(context.Background(), manualServiceConfigID)
+
+	require.NoError(t, err)
+	require.Equal(t, makeTestManualServiceConfig(), result)
+}
+
+func TestShouldReturnEntityNotFoundWhenManualServiceConfigDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), ManualServiceConfigResourcePath).Times(1).Return([]byte(`[]`), nil)
+
+	sut := NewManualServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ManualServiceConfig{}), client)
+
+	_, err := sut.GetOne(context.Background(), manualServiceConfigID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetOneManualServiceConfigWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), ManualServiceConfigResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewManualServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ManualServiceConfig{}), client)
+
+	_, err := sut.GetOne(context.Background(), manualServiceConfigID)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldSuccessfullyCreateManualServiceConfigUsingPOST(t *testing.T) {
+	config := makeTestManualServiceConfig()
+	config.ID = ""
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Post(gomock.Any(), config, ManualServiceConfigResourcePath).Times(1).Return(manualServiceConfigSerialized, nil)
+
+	sut := NewManualServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ManualServiceConfig{}), client)
+
+	result, err := sut.Create(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, makeTestManualServiceConfig(), result)
+}
+
+func TestShouldFailToCreateManualServiceConfigWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+	config := makeTestManualServiceConfig()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Post(gomock.Any(), config, ManualServiceConfigResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewManualServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ManualServiceConfig{}), client)
+
+	_, err := sut.Create(context.Background(), config)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldSuccessfullyUpdateManualServiceConfigUsingPUT(t *testing.T) {
+	config := makeTestManualServiceConfig()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Put(gomock.Any(), config, ManualServiceConfigResourcePath).Times(1).Return(manualServiceConfigSerialized, nil)
+
+	sut := NewManualServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ManualServiceConfig{}), client)
+
+	result, err := sut.Update(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, makeTestManualServiceConfig(), result)
+}
+
+func TestShouldFailToUpdateManualServiceConfigWhenResponseIsNotValid(t *testing.T) {
+	config := makeTestManualServiceConfig()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Put(gomock.Any(), config, ManualServiceConfigResourcePath).Times(1).Return([]byte("invalid"), nil)
+
+	sut := NewManualServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ManualServiceConfig{}), client)
+
+	_, err := sut.Update(context.Background(), config)
+
+	require.Error(t, err)
+}
+
+func TestShouldSuccessfullyDeleteManualServiceConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Delete(gomock.Any(), manualServiceConfigID, ManualServiceConfigResourcePath).Times(1).Return(nil)
+
+	sut := NewManualServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ManualServiceConfig{}), client)
+
+	err := sut.Delete(context.Background(), makeTestManualServiceConfig())
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,20 @@
+package restapi
+
+// ManualServiceConfigResourcePath path to manual service config resource of Instana RESTful API
+const ManualServiceConfigResourcePath = ApplicationMonitoringSettingsBasePath + "/manual-service"
+
+// ManualServiceConfig data structure of a manual service configuration of the Instana API. Calls matching the tag
+// filter expression are either mapped to an existing service or to a new service for an unmonitored component
+type ManualServiceConfig struct {
+	ID                     string     `json:"id,omitempty"`
+	Description            string     `json:"description,omitempty"`
+	Enabled                bool       `json:"enabled"`
+	ExistingServiceID      *string    `json:"existingServiceId,omitempty"`
+	UnmonitoredServiceName *string    `json:"unmonitoredServiceName,omitempty"`
+	TagFilterExpression    *TagFilter `json:"tagFilterExpression"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *ManualServiceConfig) GetIDForResourcePath() string {
+	return c.ID
+}
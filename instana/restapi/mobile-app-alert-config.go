@@ -0,0 +1,36 @@
+package restapi
+
+// MobileAppAlertConfigResourcePath path to mobile app alert config resource of Instana RESTful API
+const MobileAppAlertConfigResourcePath = EventSettingsBasePath + "/mobile-app-alert-configs"
+
+// MobileAppAlertConfig is the representation of a mobile app alert configuration in Instana
+type MobileAppAlertConfig struct {
+	ID                    string                    `json:"id"`
+	Name                  string                    `json:"name"`
+	Description           string                    `json:"description"`
+	Severity              int                       `json:"severity"`
+	Triggering            bool                      `json:"triggering"`
+	MobileAppID           string                    `json:"mobileAppId"`
+	TagFilterExpression   *TagFilter                `json:"tagFilterExpression"`
+	AlertChannelIDs       []string                  `json:"alertChannelIds"`
+	Granularity           Granularity               `json:"granularity"`
+	CustomerPayloadFields []CustomPayloadField[any] `json:"customPayloadFields"`
+	Rule                  MobileAppAlertRule        `json:"rule"`
+	Threshold             Threshold                 `json:"threshold"`
+	TimeThreshold         WebsiteTimeThreshold      `json:"timeThreshold"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *MobileAppAlertConfig) GetIDForResourcePath() string {
+	return r.ID
+}
+
+// GetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (r *MobileAppAlertConfig) GetCustomerPayloadFields() []CustomPayloadField[any] {
+	return r.CustomerPayloadFields
+}
+
+// SetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (r *MobileAppAlertConfig) SetCustomerPayloadFields(fields []CustomPayloadField[any]) {
+	r.CustomerPayloadFields = fields
+}
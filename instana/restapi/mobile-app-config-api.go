@@ -0,0 +1,16 @@
+package restapi
+
+// MobileAppConfigResourcePath path to mobile app config resource of Instana RESTful API
+const MobileAppConfigResourcePath = MobileAppMonitoringResourcePath + "/config"
+
+// MobileAppConfig data structure of a Mobile App Configuration of the Instana API
+type MobileAppConfig struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	GeoLocationAndIPMaskingSettings
+}
+
+// GetIDForResourcePath implemention of the interface InstanaDataObject
+func (r *MobileAppConfig) GetIDForResourcePath() string {
+	return r.ID
+}
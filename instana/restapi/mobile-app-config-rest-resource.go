@@ -0,0 +1,94 @@
+package restapi
+
+import "context"
+
+// NewMobileAppConfigRestResource creates a new REST resource for the mobile app config
+func NewMobileAppConfigRestResource(unmarshaller JSONUnmarshaller[*MobileAppConfig], client RestClient) RestResource[*MobileAppConfig] {
+	return &mobileAppConfigRestResource{
+		resourcePath: MobileAppConfigResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type mobileAppConfigRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*MobileAppConfig]
+	client       RestClient
+}
+
+func (r *mobileAppConfigRestResource) GetAll(ctx context.Context) (*[]*MobileAppConfig, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.unmarshaller.UnmarshalArray(data)
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// GetOne returns the mobile app with the given ID including all of its geo location and IP masking settings
+func (r *mobileAppConfigRestResource) GetOne(ctx context.Context, id string) (*MobileAppConfig, error) {
+	return r.GetOneWithSettings(ctx, id, AllGeoLocationAndIPMaskingSettings)
+}
+
+// GetOneWithSettings returns the mobile app with the given ID including the selected geo location and IP masking
+// settings only. The Instana API does not provide an endpoint to retrieve a single mobile app. Therefore, the mobile
+// app is looked up in the list of all mobile apps. Each selected setting requires an additional request to the Instana
+// API.
+func (r *mobileAppConfigRestResource) GetOneWithSettings(ctx context.Context, id string, selection GeoLocationAndIPMaskingSettingsSelection) (*MobileAppConfig, error) {
+	objects, err := r.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, object := range *objects {
+		if object.ID == id {
+			object.GeoLocationAndIPMaskingSettings, err = readGeoLocationAndIPMaskingSettings(ctx, r.client, r.resourcePath, id, selection)
+			if err != nil {
+				return nil, err
+			}
+			return object, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *mobileAppConfigRestResource) Create(ctx context.Context, data *MobileAppConfig) (*MobileAppConfig, error) {
+	response, err := r.client.PostByQuery(ctx, r.resourcePath, map[string]string{"name": data.Name})
+	if err != nil {
+		return data, err
+	}
+	created, err := r.unmarshaller.Unmarshal(response)
+	if err != nil {
+		return data, err
+	}
+	if err := updateGeoLocationAndIPMaskingSettings(ctx, r.client, r.resourcePath, created.ID, data.GeoLocationAndIPMaskingSettings); err != nil {
+		return created, err
+	}
+	return r.GetOneWithSettings(ctx, created.ID, data.Selection())
+}
+
+func (r *mobileAppConfigRestResource) Update(ctx context.Context, data *MobileAppConfig) (*MobileAppConfig, error) {
+	response, err := r.client.PutByQuery(ctx, r.resourcePath, data.GetIDForResourcePath(), map[string]string{"name": data.Name})
+	if err != nil {
+		return data, err
+	}
+	_, err = r.unmarshaller.Unmarshal(response)
+	if err != nil {
+		return data, err
+	}
+	if err := updateGeoLocationAndIPMaskingSettings(ctx, r.client, r.resourcePath, data.GetIDForResourcePath(), data.GeoLocationAndIPMaskingSettings); err != nil {
+		return data, err
+	}
+	return r.GetOneWithSettings(ctx, data.GetIDForResourcePath(), data.Selection())
+}
+
+func (r *mobileAppConfigRestResource) Delete(ctx context.Context, data *MobileAppConfig) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
+}
+
+func (r *mobileAppConfigRestResource) DeleteByID(ctx context.Context, id string) error {
+	return r.client.Delete(ctx, id, r.resourcePath)
+}
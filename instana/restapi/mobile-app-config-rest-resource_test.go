@@ -0,0 +1,304 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const mobileAppConfigID = "mobile-app-id"
+const mobileAppConfigName = "mobile-app-name"
+const mobileAppGeoMappingRules = "10.0.0.0/8,EU,Europe,DE,Germany"
+const mobileAppConfigPath = MobileAppConfigResourcePath + "/" + mobileAppConfigID
+const mobileAppGeoLocationPath = mobileAppConfigPath + "/geo-location"
+const mobileAppGeoMappingRulesPath = mobileAppConfigPath + "/geo-mapping-rules"
+const mobileAppIPMaskingPath = mobileAppConfigPath + "/ip-masking"
+
+var mobileAppConfigSerialized = []byte("serialized")
+var mobileAppConfigListSerialized = []byte("list-serialized")
+
+func makeTestMobileAppConfig() *MobileAppConfig {
+	return &MobileAppConfig{
+		ID:   mobileAppConfigID,
+		Name: mobileAppConfigName,
+	}
+}
+
+func expectMobileAppSubResourcesToBeRead(client *mocks.MockRestClient) {
+	client.EXPECT().GetContent(gomock.Any(), mobileAppGeoLocationPath, "application/json").Times(1).Return([]byte(`{"geoDetailRemoval":"REMOVE_CITY","geoMappingRules":[{"cidr":"10.0.0.0/8"}]}`), nil)
+	client.EXPECT().GetContent(gomock.Any(), mobileAppGeoMappingRulesPath, "text/csv").Times(1).Return([]byte(mobileAppGeoMappingRules), nil)
+	client.EXPECT().GetContent(gomock.Any(), mobileAppIPMaskingPath, "application/json").Times(1).Return([]byte(`{"ipMasking":"STRICT"}`), nil)
+}
+
+func expectMobileAppToBeListed(client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller[*MobileAppConfig]) {
+	client.EXPECT().Get(gomock.Any(), MobileAppConfigResourcePath).Times(1).Return(mobileAppConfigListSerialized, nil)
+	unmarshaller.EXPECT().UnmarshalArray(mobileAppConfigListSerialized).Times(1).Return(&[]*MobileAppConfig{{ID: "other", Name: "other"}, makeTestMobileAppConfig()}, nil)
+}
+
+func requireMobileAppConfigWithSubResources(t *testing.T, result *MobileAppConfig) {
+	require.Equal(t, mobileAppConfigID, result.ID)
+	require.Equal(t, mobileAppConfigName, result.Name)
+	require.Equal(t, GeoDetailRemovalRemoveCity, result.GeoLocation.GeoDetailRemoval)
+	require.Equal(t, mobileAppGeoMappingRules, *result.GeoMappingRules)
+	require.Equal(t, IPMaskingStrict, result.IPMasking.IPMasking)
+}
+
+func TestShouldSuccessfullyGetAllMobileAppConfigs(t *testing.T) {
+	expectedResult := []*MobileAppConfig{makeTestMobileAppConfig()}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppConfig](ctrl)
+
+	client.EXPECT().Get(gomock.Any(), MobileAppConfigResourcePath).Times(1).Return(mobileAppConfigListSerialized, nil)
+	unmarshaller.EXPECT().UnmarshalArray(mobileAppConfigListSerialized).Times(1).Return(&expectedResult, nil)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResult, result)
+}
+
+func TestShouldFailToGetAllMobileAppConfigsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppConfig](ctrl)
+
+	client.EXPECT().Get(gomock.Any(), MobileAppConfigResourcePath).Times(1).Return(nil, expectedError)
+	unmarshaller.EXPECT().UnmarshalArray(gomock.Any()).Times(0)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetAll(context.Background())
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldSuccessfullyGetOneMobileAppConfigIncludingSubResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppConfig](ctrl)
+
+	expectMobileAppToBeListed(client, unmarshaller)
+	expectMobileAppSubResourcesToBeRead(client)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetOne(context.Background(), mobileAppConfigID)
+
+	require.NoError(t, err)
+	requireMobileAppConfigWithSubResources(t, result)
+}
+
+func TestShouldReturnEntityNotFoundWhenMobileAppConfigIsNotAvailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppConfig](ctrl)
+
+	client.EXPECT().Get(gomock.Any(), MobileAppConfigResourcePath).Times(1).Return(mobileAppConfigListSerialized, nil)
+	unmarshaller.EXPECT().UnmarshalArray(mobileAppConfigListSerialized).Times(1).Return(&[]*MobileAppConfig{{ID: "other", Name: "other"}}, nil)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(context.Background(), mobileAppConfigID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetOneMobileAppConfigWhenSubResourceCannotBeRead(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppConfig](ctrl)
+
+	expectMobileAppToBeListed(client, unmarshaller)
+	client.EXPECT().GetContent(gomock.Any(), mobileAppGeoLocationPath, "application/json").Times(1).Return(nil, expectedError)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(context.Background(), mobileAppConfigID)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToGetOneMobileAppConfigWhenSubResourceCannotBeParsed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppConfig](ctrl)
+
+	expectMobileAppToBeListed(client, unmarshaller)
+	client.EXPECT().GetContent(gomock.Any(), mobileAppGeoLocationPath, "application/json").Times(1).Return([]byte("invalid"), nil)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(context.Background(), mobileAppConfigID)
+
+	require.ErrorContains(t, err, "failed to parse json")
+}
+
+func TestShouldReadOnlySelectedSubResourcesOfMobileAppConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppConfig](ctrl)
+
+	expectMobileAppToBeListed(client, unmarshaller)
+	client.EXPECT().GetContent(gomock.Any(), mobileAppGeoMappingRulesPath, "text/csv").Times(1).Return([]byte(mobileAppGeoMappingRules), nil)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client).(GeoLocationAndIPMaskingSettingsReader[*MobileAppConfig])
+
+	result, err := sut.GetOneWithSettings(context.Background(), mobileAppConfigID, GeoLocationAndIPMaskingSettingsSelection{GeoMappingRules: true})
+
+	require.NoError(t, err)
+	rules := mobileAppGeoMappingRules
+	require.Equal(t, GeoLocationAndIPMaskingSettings{GeoMappingRules: &rules}, result.GeoLocationAndIPMaskingSettings)
+}
+
+func TestShouldCreateMobileAppConfigAndOnlySyncProvidedSubResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppConfig](ctrl)
+	request := &MobileAppConfig{Name: mobileAppConfigName}
+	request.IPMasking = &IPMaskingConfiguration{IPMasking: IPMaskingStrict}
+
+	gomock.InOrder(
+		client.EXPECT().PostByQuery(gomock.Any(), MobileAppConfigResourcePath, map[string]string{"name": mobileAppConfigName}).Times(1).Return(mobileAppConfigSerialized, nil),
+		unmarshaller.EXPECT().Unmarshal(mobileAppConfigSerialized).Times(1).Return(makeTestMobileAppConfig(), nil),
+		client.EXPECT().PutContent(gomock.Any(), mobileAppIPMaskingPath, "application/json", []byte(`{"ipMasking":"STRICT"}`)).Times(1).Return([]byte(`{"ipMasking":"STRICT"}`), nil),
+	)
+	expectMobileAppToBeListed(client, unmarshaller)
+	client.EXPECT().GetContent(gomock.Any(), mobileAppIPMaskingPath, "application/json").Times(1).Return([]byte(`{"ipMasking":"STRICT"}`), nil)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Create(context.Background(), request)
+
+	require.NoError(t, err)
+	require.Equal(t, mobileAppConfigID, result.ID)
+	require.Equal(t, GeoLocationAndIPMaskingSettings{IPMasking: &IPMaskingConfiguration{IPMasking: IPMaskingStrict}}, result.GeoLocationAndIPMaskingSettings)
+}
+
+func TestShouldFailToCreateMobileAppConfigWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppConfig](ctrl)
+
+	client.EXPECT().PostByQuery(gomock.Any(), MobileAppConfigResourcePath, map[string]string{"name": mobileAppConfigName}).Times(1).Return(nil, expectedError)
+	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	_, err := sut.Create(context.Background(), &MobileAppConfig{Name: mobileAppConfigName})
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldReturnCreatedMobileAppConfigWhenSyncOfSubResourcesFailsAfterCreation(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppConfig](ctrl)
+	request := &MobileAppConfig{Name: mobileAppConfigName}
+	request.IPMasking = &IPMaskingConfiguration{IPMasking: IPMaskingStrict}
+
+	gomock.InOrder(
+		client.EXPECT().PostByQuery(gomock.Any(), MobileAppConfigResourcePath, map[string]string{"name": mobileAppConfigName}).Times(1).Return(mobileAppConfigSerialized, nil),
+		unmarshaller.EXPECT().Unmarshal(mobileAppConfigSerialized).Times(1).Return(makeTestMobileAppConfig(), nil),
+		client.EXPECT().PutContent(gomock.Any(), mobileAppIPMaskingPath, "application/json", gomock.Any()).Times(1).Return(nil, expectedError),
+	)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Create(context.Background(), request)
+
+	require.ErrorIs(t, err, expectedError)
+	require.Equal(t, mobileAppConfigID, result.ID)
+}
+
+func TestShouldUpdateMobileAppConfigAndSyncAllSubResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppConfig](ctrl)
+	rules := mobileAppGeoMappingRules
+	request := makeTestMobileAppConfig()
+	request.GeoLocation = &GeoLocationConfiguration{GeoDetailRemoval: GeoDetailRemovalRemoveCity}
+	request.GeoMappingRules = &rules
+	request.IPMasking = &IPMaskingConfiguration{IPMasking: IPMaskingStrict}
+
+	gomock.InOrder(
+		client.EXPECT().PutByQuery(gomock.Any(), MobileAppConfigResourcePath, mobileAppConfigID, map[string]string{"name": mobileAppConfigName}).Times(1).Return(mobileAppConfigSerialized, nil),
+		unmarshaller.EXPECT().Unmarshal(mobileAppConfigSerialized).Times(1).Return(makeTestMobileAppConfig(), nil),
+		client.EXPECT().GetContent(gomock.Any(), mobileAppGeoLocationPath, "application/json").Times(1).Return([]byte(`{"geoDetailRemoval":"NO_REMOVAL","geoMappingRules":[{"cidr":"10.0.0.0/8"}]}`), nil),
+		client.EXPECT().PutContent(gomock.Any(), mobileAppGeoLocationPath, "application/json", []byte(`{"geoDetailRemoval":"REMOVE_CITY","geoMappingRules":[{"cidr":"10.0.0.0/8"}]}`)).Times(1).Return([]byte(`{"geoDetailRemoval":"REMOVE_CITY"}`), nil),
+		client.EXPECT().PutContent(gomock.Any(), mobileAppGeoMappingRulesPath, "text/csv", []byte(mobileAppGeoMappingRules)).Times(1).Return([]byte(mobileAppGeoMappingRules), nil),
+		client.EXPECT().PutContent(gomock.Any(), mobileAppIPMaskingPath, "application/json", []byte(`{"ipMasking":"STRICT"}`)).Times(1).Return([]byte(`{"ipMasking":"STRICT"}`), nil),
+	)
+	expectMobileAppToBeListed(client, unmarshaller)
+	expectMobileAppSubResourcesToBeRead(client)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Update(context.Background(), request)
+
+	require.NoError(t, err)
+	requireMobileAppConfigWithSubResources(t, result)
+}
+
+func TestShouldFailToUpdateMobileAppConfigWhenSubResourceCannotBeUpdated(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppConfig](ctrl)
+	request := makeTestMobileAppConfig()
+	request.IPMasking = &IPMaskingConfiguration{IPMasking: IPMaskingStrict}
+
+	client.EXPECT().PutByQuery(gomock.Any(), MobileAppConfigResourcePath, mobileAppConfigID, map[string]string{"name": mobileAppConfigName}).Times(1).Return(mobileAppConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(mobileAppConfigSerialized).Times(1).Return(makeTestMobileAppConfig(), nil)
+	client.EXPECT().PutContent(gomock.Any(), mobileAppIPMaskingPath, "application/json", gomock.Any()).Times(1).Return(nil, expectedError)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	_, err := sut.Update(context.Background(), request)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldDeleteMobileAppConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MobileAppConfig](ctrl)
+
+	client.EXPECT().Delete(gomock.Any(), mobileAppConfigID, MobileAppConfigResourcePath).Times(1).Return(nil)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	err := sut.Delete(context.Background(), makeTestMobileAppConfig())
+
+	require.NoError(t, err)
+}
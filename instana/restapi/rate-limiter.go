@@ -0,0 +1,151 @@
+package restapi
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	rateLimitRemainingHeader = "X-RateLimit-Remaining"
+	rateLimitResetHeader     = "X-RateLimit-Reset"
+
+	//minRateLimiterRateFactor the lower bound of the effective rate in relation to the configured rate when the limiter slows down
+	minRateLimiterRateFactor = 0.05
+	//rateLimiterRecoveryFactor the share of the configured rate which is restored after each successful request
+	rateLimiterRecoveryFactor = 0.1
+)
+
+// newRateLimiter creates a new token bucket rate limiter with the given number of requests per second. The burst size
+// equals the number of requests per second but is at least one. The limiter adapts its rate automatically based on the
+// responses of the Instana API. Pauses requested by the API are limited to maxPause. Returns nil when requestsPerSecond is
+// not positive which means that requests are not limited.
+func newRateLimiter(requestsPerSecond float64, maxPause time.Duration) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	burst := math.Max(1, math.Floor(requestsPerSecond))
+	return &rateLimiter{
+		maxRate:  requestsPerSecond,
+		rate:     requestsPerSecond,
+		burst:    burst,
+		tokens:   burst,
+		last:     time.Now(),
+		maxPause: maxPause,
+	}
+}
+
+type rateLimiter struct {
+	mu          sync.Mutex
+	maxRate     float64
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	maxPause    time.Duration
+}
+
+// Wait blocks until a token is available or the context is done
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancelReservation()
+		return ctx.Err()
+	}
+}
+
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+	l.tokens--
+
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	if pause := l.pausedUntil.Sub(now); pause > delay {
+		delay = pause
+	}
+	return delay
+}
+
+func (l *rateLimiter) cancelReservation() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = math.Min(l.tokens+1, l.burst)
+}
+
+func (l *rateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last).Seconds()
+	if elapsed > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+elapsed*l.rate)
+		l.last = now
+	}
+}
+
+// Adapt adjusts the rate of the limiter based on the response of the Instana API. The rate is halved and the limiter
+// is paused for the duration requested by the Retry-After header when the request was rejected due to rate limiting.
+// When the API provides rate limit headers the rate is reduced so that the remaining quota lasts until the quota is
+// reset. Otherwise, the rate recovers step by step to the configured rate.
+func (l *rateLimiter) Adapt(statusCode int, header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+	minRate := l.maxRate * minRateLimiterRateFactor
+	if statusCode == http.StatusTooManyRequests {
+		l.rate = math.Max(minRate, l.rate/2)
+		l.tokens = math.Min(l.tokens, 0)
+		if wait, ok := parseRetryAfter(header.Get(retryAfterHeader)); ok {
+			l.pauseUntil(now.Add(wait), now)
+		}
+		return
+	}
+	if remaining, reset, ok := parseRateLimitHeaders(header); ok {
+		untilReset := reset.Sub(now).Seconds()
+		if remaining <= 0 {
+			l.pauseUntil(reset, now)
+		} else if untilReset > 0 {
+			l.rate = math.Max(minRate, math.Min(l.maxRate, float64(remaining)/untilReset))
+		}
+		return
+	}
+	l.rate = math.Min(l.maxRate, l.rate+l.maxRate*rateLimiterRecoveryFactor)
+}
+
+func (l *rateLimiter) pauseUntil(until time.Time, now time.Time) {
+	if until.Sub(now) > l.maxPause {
+		until = now.Add(l.maxPause)
+	}
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+func parseRateLimitHeaders(header http.Header) (int, time.Time, bool) {
+	remaining, err := strconv.Atoi(header.Get(rateLimitRemainingHeader))
+	if err != nil {
+		return 0, time.Time{}, false
+	}
+	reset, err := strconv.ParseInt(header.Get(rateLimitResetHeader), 10, 64)
+	if err != nil {
+		return 0, time.Time{}, false
+	}
+	return remaining, time.Unix(reset, 0), true
+}
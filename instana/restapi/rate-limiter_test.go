@@ -0,0 +1,134 @@
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testMaxPause = 30 * time.Second
+
+func TestShouldNotCreateRateLimiterWhenRequestsPerSecondIsNotPositive(t *testing.T) {
+	require.Nil(t, newRateLimiter(0, testMaxPause))
+	require.Nil(t, newRateLimiter(-1, testMaxPause))
+}
+
+func TestShouldCreateRateLimiterWithBurstOfAtLeastOne(t *testing.T) {
+	require.Equal(t, float64(1), newRateLimiter(0.5, testMaxPause).burst)
+	require.Equal(t, float64(5), newRateLimiter(5.7, testMaxPause).burst)
+}
+
+func TestShouldAllowBurstOfRequestsWithoutWaitingAndDelayFurtherRequests(t *testing.T) {
+	sut := newRateLimiter(5, testMaxPause)
+
+	for i := 0; i < 5; i++ {
+		require.Equal(t, time.Duration(0), sut.reserve())
+	}
+	delay := sut.reserve()
+
+	require.Greater(t, delay, 150*time.Millisecond)
+	require.LessOrEqual(t, delay, 200*time.Millisecond)
+}
+
+func TestShouldWaitForNextTokenOfRateLimiter(t *testing.T) {
+	sut := newRateLimiter(20, testMaxPause)
+	sut.tokens = 0
+
+	start := time.Now()
+	err := sut.Wait(context.Background())
+
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
+
+func TestShouldAbortWaitingForRateLimiterWhenContextIsDoneAndReturnToken(t *testing.T) {
+	sut := newRateLimiter(1, testMaxPause)
+	sut.tokens = 0
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := sut.Wait(ctx)
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Greater(t, sut.tokens, -0.5)
+}
+
+func TestShouldHalveRateOfRateLimiterWhenRequestWasRejectedDueToRateLimiting(t *testing.T) {
+	sut := newRateLimiter(10, testMaxPause)
+
+	sut.Adapt(http.StatusTooManyRequests, http.Header{})
+	require.Equal(t, float64(5), sut.rate)
+	require.LessOrEqual(t, sut.tokens, float64(0))
+
+	for i := 0; i < 10; i++ {
+		sut.Adapt(http.StatusTooManyRequests, http.Header{})
+	}
+	require.Equal(t, 10*minRateLimiterRateFactor, sut.rate)
+}
+
+func TestShouldPauseRateLimiterWhenRequestWasRejectedWithRetryAfterHeader(t *testing.T) {
+	sut := newRateLimiter(10, testMaxPause)
+	header := http.Header{}
+	header.Set(retryAfterHeader, "2")
+
+	sut.Adapt(http.StatusTooManyRequests, header)
+
+	require.Greater(t, sut.reserve(), time.Second)
+}
+
+func TestShouldPauseRateLimiterUntilResetWhenQuotaIsExhausted(t *testing.T) {
+	sut := newRateLimiter(10, testMaxPause)
+
+	sut.Adapt(http.StatusOK, createRateLimitHeaders(0, time.Now().Add(5*time.Second)))
+
+	require.Greater(t, sut.reserve(), 3*time.Second)
+}
+
+func TestShouldLimitPauseOfRateLimiterWhenResetIsFarInTheFuture(t *testing.T) {
+	sut := newRateLimiter(10, testMaxPause)
+
+	sut.Adapt(http.StatusOK, createRateLimitHeaders(0, time.Now().Add(time.Hour)))
+
+	require.LessOrEqual(t, sut.reserve(), testMaxPause)
+}
+
+func TestShouldReduceRateOfRateLimiterSoThatRemainingQuotaLastsUntilReset(t *testing.T) {
+	sut := newRateLimiter(10, testMaxPause)
+
+	sut.Adapt(http.StatusOK, createRateLimitHeaders(100, time.Now().Add(50*time.Second)))
+
+	require.InDelta(t, 2, sut.rate, 0.1)
+}
+
+func TestShouldNotIncreaseRateOfRateLimiterAboveConfiguredRateWhenQuotaIsHigh(t *testing.T) {
+	sut := newRateLimiter(10, testMaxPause)
+
+	sut.Adapt(http.StatusOK, createRateLimitHeaders(100000, time.Now().Add(50*time.Second)))
+
+	require.Equal(t, float64(10), sut.rate)
+}
+
+func TestShouldRecoverRateOfRateLimiterAfterSuccessfulRequests(t *testing.T) {
+	sut := newRateLimiter(10, testMaxPause)
+	sut.Adapt(http.StatusTooManyRequests, http.Header{})
+	require.Equal(t, float64(5), sut.rate)
+
+	sut.Adapt(http.StatusOK, http.Header{})
+	require.Equal(t, float64(6), sut.rate)
+
+	for i := 0; i < 10; i++ {
+		sut.Adapt(http.StatusOK, http.Header{})
+	}
+	require.Equal(t, float64(10), sut.rate)
+}
+
+func createRateLimitHeaders(remaining int, reset time.Time) http.Header {
+	header := http.Header{}
+	header.Set(rateLimitRemainingHeader, strconv.Itoa(remaining))
+	header.Set(rateLimitResetHeader, strconv.FormatInt(reset.Unix(), 10))
+	return header
+}
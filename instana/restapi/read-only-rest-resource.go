@@ -1,6 +1,10 @@
 package restapi
 
-import "github.com/gessnerfl/terraform-provider-instana/utils"
+import (
+	"context"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
 
 // NewReadOnlyRestResource creates a new instance of ReadOnlyRestResource
 func NewReadOnlyRestResource[T InstanaDataObject](resourcePath string, unmarshaller JSONUnmarshaller[T], client RestClient) ReadOnlyRestResource[T] {
@@ -17,8 +21,8 @@ type readOnlyRestResource[T InstanaDataObject] struct {
 	client       RestClient
 }
 
-func (r *readOnlyRestResource[T]) GetAll() (*[]T, error) {
-	data, err := r.client.Get(r.resourcePath)
+func (r *readOnlyRestResource[T]) GetAll(ctx context.Context) (*[]T, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
 	if err != nil {
 		return nil, err
 	}
@@ -29,8 +33,8 @@ func (r *readOnlyRestResource[T]) GetAll() (*[]T, error) {
 	return objects, nil
 }
 
-func (r *readOnlyRestResource[T]) GetByQuery(queryParams map[string]string) (*[]T, error) {
-	data, err := r.client.GetByQuery(r.resourcePath, queryParams)
+func (r *readOnlyRestResource[T]) GetByQuery(ctx context.Context, queryParams map[string]string) (*[]T, error) {
+	data, err := r.client.GetByQuery(ctx, r.resourcePath, queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -41,8 +45,8 @@ func (r *readOnlyRestResource[T]) GetByQuery(queryParams map[string]string) (*[]
 	return objects, nil
 }
 
-func (r *readOnlyRestResource[T]) GetOne(id string) (T, error) {
-	data, err := r.client.GetOne(id, r.resourcePath)
+func (r *readOnlyRestResource[T]) GetOne(ctx context.Context, id string) (T, error) {
+	data, err := r.client.GetOne(ctx, id, r.resourcePath)
 	if err != nil {
 		return utils.GetZeroValue[T](), err
 	}
@@ -0,0 +1,36 @@
+package restapi
+
+// ReleasesResourcePath path to releases
+const ReleasesResourcePath = InstanaAPIBasePath + "/releases"
+
+// Release is the representation of a release (deployment marker) in Instana
+type Release struct {
+	ID           string               `json:"id"`
+	Name         string               `json:"name"`
+	Start        int64                `json:"start"`
+	Applications []ReleaseApplication `json:"applications"`
+	Services     []ReleaseService     `json:"services"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *Release) GetIDForResourcePath() string {
+	return r.ID
+}
+
+// ReleaseApplication is the representation of an application a release is scoped to. The application is identified
+// by its name
+type ReleaseApplication struct {
+	Name string `json:"name"`
+}
+
+// ReleaseService is the representation of a service a release is scoped to. The service is identified by its name and
+// can optionally be limited to the given applications
+type ReleaseService struct {
+	Name     string                  `json:"name"`
+	ScopedTo *ReleaseServiceScopedTo `json:"scopedTo,omitempty"`
+}
+
+// ReleaseServiceScopedTo is the representation of the applications a service of a release is limited to
+type ReleaseServiceScopedTo struct {
+	Applications []ReleaseApplication `json:"applications"`
+}
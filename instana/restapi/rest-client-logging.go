@@ -0,0 +1,103 @@
+package restapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+const redactedValue = "***REDACTED***"
+
+// sensitiveHeaders headers which are never logged in plain text
+var sensitiveHeaders = map[string]bool{
+	"authorization": true,
+	"cookie":        true,
+	"set-cookie":    true,
+}
+
+// sensitiveJSONFields JSON fields of request and response bodies which are never logged in plain text. Keys are
+// compared case-insensitive
+var sensitiveJSONFields = map[string]bool{
+	"apikey":              true,
+	"token":               true,
+	"routingkey":          true,
+	"accessgrantingtoken": true,
+	"credentialvalue":     true,
+}
+
+const (
+	securedJSONField = "secured"
+	valueJSONField   = "value"
+)
+
+// redactHeaders returns a copy of the given headers as a map of comma separated values where the values of sensitive
+// headers are redacted
+func redactHeaders(header http.Header) map[string]string {
+	result := make(map[string]string, len(header))
+	for key, values := range header {
+		if sensitiveHeaders[strings.ToLower(key)] {
+			result[key] = redactedValue
+		} else {
+			result[key] = strings.Join(values, ", ")
+		}
+	}
+	return result
+}
+
+// redactBody returns the string representation of the given body where sensitive fields of JSON documents are
+// redacted. Sensitive fields are the fields listed in sensitiveJSONFields as well as the value of objects which are
+// marked as secured (e.g. secured fields of automation actions). Bodies which are not valid JSON are returned as is.
+func redactBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+	var document interface{}
+	if err := json.Unmarshal(body, &document); err != nil {
+		return string(body)
+	}
+	redacted, err := json.Marshal(redactJSONValue(document))
+	if err != nil {
+		return redactedValue
+	}
+	return string(redacted)
+}
+
+func redactJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		secured, _ := v[securedJSONField].(bool)
+		for key, child := range v {
+			if sensitiveJSONFields[strings.ToLower(key)] || (secured && key == valueJSONField) {
+				if child != nil {
+					v[key] = redactedValue
+				}
+			} else {
+				v[key] = redactJSONValue(child)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = redactJSONValue(child)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// marshalRequestBody returns the redacted JSON representation of the body of a request. Raw content is redacted as is
+func marshalRequestBody(body interface{}) string {
+	if body == nil {
+		return ""
+	}
+	if content, ok := body.([]byte); ok {
+		return redactBody(content)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return ""
+	}
+	return redactBody(data)
+}
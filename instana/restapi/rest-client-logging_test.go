@@ -0,0 +1,68 @@
+package restapi
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldRedactSensitiveHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "apiToken secret-token")
+	header.Set("Cookie", "session=secret")
+	header.Add("Accept", "application/json")
+	header.Add("Accept", "text/plain")
+
+	result := redactHeaders(header)
+
+	require.Equal(t, map[string]string{
+		"Authorization": redactedValue,
+		"Cookie":        redactedValue,
+		"Accept":        "application/json, text/plain",
+	}, result)
+}
+
+func TestShouldRedactSensitiveFieldsOfJSONBody(t *testing.T) {
+	body := `{"id":"id1","apiKey":"secret1","nested":{"Token":"secret2"},"list":[{"routingKey":"secret3"},{"accessGrantingToken":"secret4"},{"credentialValue":"secret5"}]}`
+
+	result := redactBody([]byte(body))
+
+	require.JSONEq(t, `{"id":"id1","apiKey":"***REDACTED***","nested":{"Token":"***REDACTED***"},"list":[{"routingKey":"***REDACTED***"},{"accessGrantingToken":"***REDACTED***"},{"credentialValue":"***REDACTED***"}]}`, result)
+}
+
+func TestShouldRedactValueOfSecuredFieldsOfJSONBody(t *testing.T) {
+	body := `{"fields":[{"name":"password","secured":true,"value":"secret"},{"name":"user","secured":false,"value":"admin"}]}`
+
+	result := redactBody([]byte(body))
+
+	require.JSONEq(t, `{"fields":[{"name":"password","secured":true,"value":"***REDACTED***"},{"name":"user","secured":false,"value":"admin"}]}`, result)
+}
+
+func TestShouldNotRedactNullValuesOfSensitiveFields(t *testing.T) {
+	result := redactBody([]byte(`{"apiKey":null}`))
+
+	require.JSONEq(t, `{"apiKey":null}`, result)
+}
+
+func TestShouldReturnBodyAsIsWhenBodyIsNotJSON(t *testing.T) {
+	require.Equal(t, "plain text", redactBody([]byte("plain text")))
+	require.Equal(t, "", redactBody([]byte("  ")))
+	require.Equal(t, "", redactBody(nil))
+}
+
+func TestShouldMarshalAndRedactRequestBody(t *testing.T) {
+	body := struct {
+		ID     string `json:"id"`
+		APIKey string `json:"apiKey"`
+	}{ID: "id1", APIKey: "secret"}
+
+	require.JSONEq(t, `{"id":"id1","apiKey":"***REDACTED***"}`, marshalRequestBody(body))
+	require.Equal(t, "", marshalRequestBody(nil))
+	require.Equal(t, "", marshalRequestBody(make(chan int)))
+}
+
+func TestShouldRedactRawRequestBody(t *testing.T) {
+	require.JSONEq(t, `{"token":"***REDACTED***"}`, marshalRequestBody([]byte(`{"token":"secret"}`)))
+	require.Equal(t, "10.0.0.0/8,EU,Europe", marshalRequestBody([]byte("10.0.0.0/8,EU,Europe")))
+}
@@ -0,0 +1,99 @@
+package restapi
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// TLSConfig the TLS configuration of the Instana REST API client. Certificates and keys are provided PEM encoded.
+type TLSConfig struct {
+	//SkipVerification if set to true the server certificate is not verified
+	SkipVerification bool
+	//CACertificates PEM encoded CA certificates which are trusted in addition to the system certificate pool
+	CACertificates string
+	//ClientCertificate PEM encoded client certificate used for mutual TLS authentication
+	ClientCertificate string
+	//ClientKey PEM encoded private key of the client certificate
+	ClientKey string
+}
+
+const defaultEndpointScheme = "https"
+
+// parseEndpoint converts the configured endpoint into the base URL of the Instana API. The endpoint is either the DNS
+// name of the Instana backend (optionally including the port) or a full URL including scheme, port and base path.
+// When no scheme is provided, https is used.
+func parseEndpoint(endpoint string) (string, error) {
+	endpoint = strings.TrimSpace(endpoint)
+	if !strings.Contains(endpoint, "://") {
+		endpoint = defaultEndpointScheme + "://" + endpoint
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid Instana endpoint %s; %w", endpoint, err)
+	}
+	if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+		return "", fmt.Errorf("invalid Instana endpoint %s; scheme must be either http or https", endpoint)
+	}
+	if endpointURL.Host == "" {
+		return "", fmt.Errorf("invalid Instana endpoint %s; host is missing", endpoint)
+	}
+	if endpointURL.RawQuery != "" || endpointURL.Fragment != "" {
+		return "", fmt.Errorf("invalid Instana endpoint %s; query parameters and fragments are not supported", endpoint)
+	}
+	return fmt.Sprintf("%s://%s%s", endpointURL.Scheme, endpointURL.Host, strings.TrimSuffix(endpointURL.EscapedPath(), "/")), nil
+}
+
+// createTransport creates the HTTP transport of the client based on the default transport of go. The proxy is taken
+// from the environment unless an explicit proxy URL is provided.
+func createTransport(proxyURL string, tlsConfig TLSConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if proxyURL != "" {
+		parsedProxyURL, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL %s; %w", proxyURL, err)
+		}
+		if parsedProxyURL.Scheme == "" || parsedProxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %s; scheme and host are required", proxyURL)
+		}
+		transport.Proxy = http.ProxyURL(parsedProxyURL)
+	}
+	clientTLSConfig, err := createTLSClientConfig(tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = clientTLSConfig
+	return transport, nil
+}
+
+func createTLSClientConfig(config TLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.SkipVerification, //nolint:gosec
+	}
+	if config.CACertificates != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM([]byte(config.CACertificates)) {
+			return nil, errors.New("invalid CA certificates; no PEM encoded certificate found")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+	if config.ClientCertificate != "" || config.ClientKey != "" {
+		if config.ClientCertificate == "" || config.ClientKey == "" {
+			return nil, errors.New("invalid client certificate configuration; client certificate and client key must be provided together")
+		}
+		certificate, err := tls.X509KeyPair([]byte(config.ClientCertificate), []byte(config.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate; %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
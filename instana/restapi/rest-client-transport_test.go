@@ -0,0 +1,122 @@
+package restapi_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+const testBasePath = "/instana"
+
+func TestShouldUseSchemePortAndBasePathOfEndpoint(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodGet, testBasePath+testPath)
+	defer httpServer.Close()
+
+	config := createTestClientConfig(httpServer, testRetryConfig, DefaultThrottleConfig())
+	config.Endpoint = fmt.Sprintf("https://localhost:%d%s/", httpServer.GetPort(), testBasePath)
+	restClient := createSutWithClientConfig(config)
+
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessResponseData(response, err, t)
+	require.Equal(t, 1, httpServer.GetCallCount(http.MethodGet, testBasePath+testPath))
+}
+
+func TestShouldSendRequestsThroughConfiguredProxy(t *testing.T) {
+	var requestedURL string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedURL = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(testData))
+	}))
+	defer proxy.Close()
+
+	restClient := createSutWithClientConfig(ClientConfig{
+		APIToken: "api-token",
+		Endpoint: "http://instana.example.com:8080" + testBasePath,
+		ProxyURL: proxy.URL,
+		Retry:    testRetryConfig,
+		Throttle: DefaultThrottleConfig(),
+	})
+
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessResponseData(response, err, t)
+	require.Equal(t, "http://instana.example.com:8080"+testBasePath+testPath, requestedURL)
+}
+
+func TestShouldFailToCreateClientWhenEndpointIsInvalid(t *testing.T) {
+	for _, endpoint := range []string{"ftp://instana.example.com", "https://", "https://instana.example.com?foo=bar", "https://instana.example.com#foo"} {
+		t.Run(endpoint, func(t *testing.T) {
+			_, err := NewClient(ClientConfig{Endpoint: endpoint})
+
+			require.ErrorContains(t, err, "invalid Instana endpoint")
+		})
+	}
+}
+
+func TestShouldFailToCreateClientWhenProxyURLIsInvalid(t *testing.T) {
+	_, err := NewClient(ClientConfig{Endpoint: "instana.example.com", ProxyURL: "proxy.example.com:3128"})
+
+	require.ErrorContains(t, err, "invalid proxy URL")
+}
+
+func TestShouldCreateClientWithCACertificatesAndClientCertificate(t *testing.T) {
+	_, err := NewClient(ClientConfig{
+		Endpoint: "instana.example.com",
+		TLS: TLSConfig{
+			CACertificates:    readTestFile(t, "test-server.pem"),
+			ClientCertificate: readTestFile(t, "test-server.pem"),
+			ClientKey:         readTestFile(t, "test-server.key"),
+		},
+	})
+
+	require.NoError(t, err)
+}
+
+func TestShouldFailToCreateClientWhenCACertificatesAreInvalid(t *testing.T) {
+	_, err := NewClient(ClientConfig{Endpoint: "instana.example.com", TLS: TLSConfig{CACertificates: "invalid"}})
+
+	require.ErrorContains(t, err, "invalid CA certificates")
+}
+
+func TestShouldFailToCreateClientWhenClientCertificateIsProvidedWithoutKey(t *testing.T) {
+	_, err := NewClient(ClientConfig{Endpoint: "instana.example.com", TLS: TLSConfig{ClientCertificate: readTestFile(t, "test-server.pem")}})
+
+	require.ErrorContains(t, err, "client certificate and client key must be provided together")
+}
+
+func TestShouldFailToCreateClientWhenClientCertificateIsInvalid(t *testing.T) {
+	_, err := NewClient(ClientConfig{Endpoint: "instana.example.com", TLS: TLSConfig{ClientCertificate: "invalid", ClientKey: readTestFile(t, "test-server.key")}})
+
+	require.ErrorContains(t, err, "invalid client certificate")
+}
+
+func TestShouldFailToCallServerWithUntrustedCertificateWhenTLSVerificationIsEnabled(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodGet, testPath)
+	defer httpServer.Close()
+
+	config := createTestClientConfig(httpServer, testRetryConfig, DefaultThrottleConfig())
+	config.TLS = TLSConfig{}
+	restClient := createSutWithClientConfig(config)
+
+	_, err := restClient.Get(context.Background(), testPath)
+
+	require.Error(t, err)
+	require.Equal(t, 0, httpServer.GetCallCount(http.MethodGet, testPath))
+}
+
+func readTestFile(t *testing.T, fileName string) string {
+	rootFolder, err := testutils.GetRootFolder()
+	require.NoError(t, err)
+	content, err := os.ReadFile(rootFolder + "/testutils/" + fileName)
+	require.NoError(t, err)
+	return string(content)
+}
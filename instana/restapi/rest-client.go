@@ -1,244 +1,547 @@
 package restapi
 
 import (
-	"bufio"
+	"bytes"
 	"context"
-	"crypto/tls"
 	"errors"
 	"fmt"
-	"log"
-	"os"
-	"path/filepath"
-	"runtime"
+	"io"
+	"math/rand"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	resty "gopkg.in/resty.v1"
 )
 
 // ErrEntityNotFound error message which is returned when the entity cannot be found at the server
 var ErrEntityNotFound = errors.New("failed to get resource from Instana API. 404 - Resource not found")
 
+// ErrUnauthorized error message which is returned when the Instana API rejects the API token
+var ErrUnauthorized = errors.New("failed to authenticate at Instana API. 401 - Unauthorized")
+
 const contentTypeHeader = "Content-Type"
+const acceptHeader = "Accept"
 const encodingApplicationJSON = "application/json; charset=utf-8"
+const userAgentHeader = "User-Agent"
+const authorizationHeaderName = "Authorization"
+
+const (
+	logFieldHTTPMethod          = "http_method"
+	logFieldHTTPURL             = "http_url"
+	logFieldHTTPStatusCode      = "http_status_code"
+	logFieldHTTPRequestHeaders  = "http_request_headers"
+	logFieldHTTPRequestBody     = "http_request_body"
+	logFieldHTTPResponseHeaders = "http_response_headers"
+	logFieldHTTPResponseBody    = "http_response_body"
+	logFieldDuration            = "duration_ms"
+	logFieldAttempt             = "attempt"
+	logFieldRetry               = "retry"
+	logFieldMaxRetries          = "max_retries"
+	logFieldRetryWait           = "retry_wait"
+	logFieldError               = "error"
+)
 
-// RestClient interface to access REST resources of the Instana API
+// RestClient interface to access REST resources of the Instana API. All requests are bound to the provided context. When
+// the context is cancelled or its deadline is exceeded, requests waiting for throttling are dropped and in-flight requests
+// are aborted.
 type RestClient interface {
-	Get(resourcePath string) ([]byte, error)
-	GetOne(id string, resourcePath string) ([]byte, error)
-	Post(data InstanaDataObject, resourcePath string) ([]byte, error)
-	PostWithID(data InstanaDataObject, resourcePath string) ([]byte, error)
-	Put(data InstanaDataObject, resourcePath string) ([]byte, error)
-	Delete(resourceID string, resourceBasePath string) error
-	GetByQuery(resourcePath string, queryParams map[string]string) ([]byte, error)
-	PostByQuery(resourcePath string, queryParams map[string]string) ([]byte, error)
-	PutByQuery(resourcePath string, is string, queryParams map[string]string) ([]byte, error)
+	Get(ctx context.Context, resourcePath string) ([]byte, error)
+	GetOne(ctx context.Context, id string, resourcePath string) ([]byte, error)
+	Post(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error)
+	PostWithID(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error)
+	Put(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error)
+	Delete(ctx context.Context, resourceID string, resourceBasePath string) error
+	DeleteByPath(ctx context.Context, resourcePath string) error
+	DeleteByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) error
+	GetByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) ([]byte, error)
+	PostByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) ([]byte, error)
+	PutByQuery(ctx context.Context, resourcePath string, id string, queryParams map[string]string) ([]byte, error)
+	PutByPath(ctx context.Context, resourcePath string) ([]byte, error)
+	GetContent(ctx context.Context, resourcePath string, mediaType string) ([]byte, error)
+	PostContent(ctx context.Context, resourcePath string, mediaType string, content []byte) ([]byte, error)
+	PutContent(ctx context.Context, resourcePath string, mediaType string, content []byte) ([]byte, error)
+	PutMultipartForm(ctx context.Context, resourcePath string, fields map[string]string, fileField string, fileName string, fileContent []byte) ([]byte, error)
 }
 
-type apiRequest struct {
-	method          string
-	url             string
-	request         resty.Request
-	responseChannel chan *apiResponse
-	ctx             context.Context
+// DefaultRetryableStatusCodes the HTTP status codes which are considered as transient API failures by default
+var DefaultRetryableStatusCodes = []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+
+const (
+	//DefaultMaxRetries the default number of retries for requests failing due to transient API failures
+	DefaultMaxRetries = 3
+	//DefaultMaxRetryWait the default maximum time to wait between two attempts of a request
+	DefaultMaxRetryWait = 30 * time.Second
+
+	retryBaseWait        = 500 * time.Millisecond
+	maxRetryBackoffShift = 16
+	retryAfterHeader     = "Retry-After"
+)
+
+// RetryConfig defines how the RestClient retries requests which failed due to transient API failures
+type RetryConfig struct {
+	//MaxRetries the maximum number of retries of a single request. 0 disables retries
+	MaxRetries int
+	//MaxWait the upper limit of the time to wait between two attempts, including waits requested by the Retry-After header
+	MaxWait time.Duration
+	//RetryableStatusCodes the HTTP status codes which are considered as transient API failures
+	RetryableStatusCodes []int
 }
 
-type apiResponse struct {
-	data []byte
-	err  error
+const (
+	//DefaultMaxRequestsPerSecond the default number of requests per second which are sent to the Instana API
+	DefaultMaxRequestsPerSecond = 25
+	//DefaultMaxWriteRequestsPerSecond the default number of write requests per second which are sent to the Instana API
+	DefaultMaxWriteRequestsPerSecond = 5
+	//DefaultMaxThrottlePause the default maximum time requests are paused when requested by the Instana API
+	DefaultMaxThrottlePause = 60 * time.Second
+)
+
+// ThrottleConfig defines the request rates of the RestClient. Write requests are limited by both budgets. A value of
+// zero or less disables the corresponding limit.
+type ThrottleConfig struct {
+	//MaxRequestsPerSecond the maximum number of requests per second
+	MaxRequestsPerSecond float64
+	//MaxWriteRequestsPerSecond the maximum number of write requests (POST, PUT, DELETE) per second
+	MaxWriteRequestsPerSecond float64
+	//MaxPause the upper limit of the time requests are paused when the Instana API reports that the rate limit is exceeded
+	MaxPause time.Duration
 }
 
-// NewClient creates a new instance of the Instana REST API client
-func NewClient(apiToken string, host string, skipTlsVerification bool) RestClient {
-	restyClient := resty.New()
-	if skipTlsVerification {
-		restyClient.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true}) //nolint:gosec
+// DefaultThrottleConfig returns the ThrottleConfig which is used when no explicit configuration is provided
+func DefaultThrottleConfig() ThrottleConfig {
+	return ThrottleConfig{
+		MaxRequestsPerSecond:      DefaultMaxRequestsPerSecond,
+		MaxWriteRequestsPerSecond: DefaultMaxWriteRequestsPerSecond,
+		MaxPause:                  DefaultMaxThrottlePause,
 	}
+}
 
-	throttleRate := time.Second / 5 //5 write requests per second
-	throttledRequests := make(chan *apiRequest, 1000)
-	client := &restClientImpl{
-		apiToken:          apiToken,
-		host:              host,
-		restyClient:       restyClient,
-		throttledRequests: throttledRequests,
-		throttleRate:      throttleRate,
+// DefaultRetryConfig returns the RetryConfig which is used when no explicit configuration is provided
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries:           DefaultMaxRetries,
+		MaxWait:              DefaultMaxRetryWait,
+		RetryableStatusCodes: DefaultRetryableStatusCodes,
+	}
+}
+
+// ClientConfig the configuration of the Instana REST API client
+type ClientConfig struct {
+	//APIToken the API token used to authenticate at the Instana API when no Authenticator is provided
+	APIToken string
+	//Authenticator provides the credentials used to authenticate at the Instana API. Optional, takes precedence over APIToken
+	Authenticator Authenticator
+	//Endpoint the Instana endpoint. Either the DNS name of the Instana backend or a full URL including scheme, port and base path
+	Endpoint string
+	//UserAgent the value of the User-Agent header sent with each request
+	UserAgent string
+	//ProxyURL the URL of the HTTP proxy. When empty, the proxy is determined from the environment (HTTP_PROXY, HTTPS_PROXY, NO_PROXY)
+	ProxyURL string
+	//TLS the TLS configuration of the client
+	TLS TLSConfig
+	//Retry the retry configuration of the client
+	Retry RetryConfig
+	//Throttle the throttling configuration of the client
+	Throttle ThrottleConfig
+}
+
+// NewClient creates a new instance of the Instana REST API client. An error is returned when the endpoint, the proxy URL
+// or the TLS configuration is invalid
+func NewClient(config ClientConfig) (RestClient, error) {
+	baseURL, err := parseEndpoint(config.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	transport, err := createTransport(config.ProxyURL, config.TLS)
+	if err != nil {
+		return nil, err
+	}
+	restyClient := resty.New()
+	restyClient.SetTransport(transport)
+	authenticator := config.Authenticator
+	if authenticator == nil {
+		authenticator = NewTokenAuthenticator(AuthorizationSchemeAPIToken, NewStaticTokenSource(config.APIToken))
 	}
 
-	go client.processThrottledRequests()
-	return client
+	return &restClientImpl{
+		authenticator:      authenticator,
+		baseURL:            baseURL,
+		userAgent:          config.UserAgent,
+		restyClient:        restyClient,
+		requestRateLimiter: newRateLimiter(config.Throttle.MaxRequestsPerSecond, config.Throttle.MaxPause),
+		writeRateLimiter:   newRateLimiter(config.Throttle.MaxWriteRequestsPerSecond, config.Throttle.MaxPause),
+		retryConfig:        config.Retry,
+	}, nil
 }
 
 type restClientImpl struct {
-	apiToken          string
-	host              string
-	restyClient       *resty.Client
-	throttledRequests chan *apiRequest
-	throttleRate      time.Duration
+	authenticator      Authenticator
+	baseURL            string
+	userAgent          string
+	restyClient        *resty.Client
+	requestRateLimiter *rateLimiter
+	writeRateLimiter   *rateLimiter
+	retryConfig        RetryConfig
 }
 
 var emptyResponse = make([]byte, 0)
 
 // Get request data via HTTP GET for the given resourcePath
-func (client *restClientImpl) Get(resourcePath string) ([]byte, error) {
+func (client *restClientImpl) Get(ctx context.Context, resourcePath string) ([]byte, error) {
 	url := client.buildURL(resourcePath)
-	req := client.createRequest()
-	return client.executeRequest(resty.MethodGet, url, req)
+	req := client.createRequest(ctx)
+	return client.executeRequest(resty.MethodGet, url, req, true)
 }
 
 // Get request data via HTTP GET for the given resourcePath and query parameters
-func (client *restClientImpl) GetByQuery(resourcePath string, queryParams map[string]string) ([]byte, error) {
+func (client *restClientImpl) GetByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) ([]byte, error) {
 	url := client.buildURL(resourcePath)
-	req := client.createRequest()
+	req := client.createRequest(ctx)
 	client.appendQueryParameters(req, queryParams)
-	return client.executeRequest(resty.MethodGet, url, req)
+	return client.executeRequest(resty.MethodGet, url, req, true)
 }
 
 // GetOne request the resource with the given ID
-func (client *restClientImpl) GetOne(id string, resourcePath string) ([]byte, error) {
+func (client *restClientImpl) GetOne(ctx context.Context, id string, resourcePath string) ([]byte, error) {
 	url := client.buildResourceURL(resourcePath, id)
-	req := client.createRequest()
-	return client.executeRequest(resty.MethodGet, url, req)
+	req := client.createRequest(ctx)
+	return client.executeRequest(resty.MethodGet, url, req, true)
 }
 
 // Post executes a HTTP PUT request to create or update the given resource
-func (client *restClientImpl) Post(data InstanaDataObject, resourcePath string) ([]byte, error) {
+func (client *restClientImpl) Post(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error) {
 	url := client.buildURL(resourcePath)
-	req := client.createRequest().SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
-	return client.executeRequestWithThrottling(resty.MethodPost, url, req)
+	req := client.createRequest(ctx).SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
+	return client.executeRequest(resty.MethodPost, url, req, false)
 }
 
-// PostWithID executes a HTTP PUT request to create or update the given resource using the ID from the InstanaDataObject in the resource path
-func (client *restClientImpl) PostWithID(data InstanaDataObject, resourcePath string) ([]byte, error) {
+// PostWithID executes a HTTP POST request to update the given resource using the ID from the InstanaDataObject in the resource path. As the ID is part of the path the request is considered as idempotent
+func (client *restClientImpl) PostWithID(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error) {
 	url := client.buildResourceURL(resourcePath, data.GetIDForResourcePath())
-	req := client.createRequest().SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
-	return client.executeRequestWithThrottling(resty.MethodPost, url, req)
+	req := client.createRequest(ctx).SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
+	return client.executeRequest(resty.MethodPost, url, req, true)
 }
 
 // Put executes a HTTP PUT request to create or update the given resource
-func (client *restClientImpl) Put(data InstanaDataObject, resourcePath string) ([]byte, error) {
+func (client *restClientImpl) Put(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error) {
 	url := client.buildResourceURL(resourcePath, data.GetIDForResourcePath())
-	req := client.createRequest().SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
-	return client.executeRequestWithThrottling(resty.MethodPut, url, req)
+	req := client.createRequest(ctx).SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
+	return client.executeRequest(resty.MethodPut, url, req, true)
 }
 
 // Delete executes a HTTP DELETE request to delete the resource with the given ID
-func (client *restClientImpl) Delete(resourceID string, resourceBasePath string) error {
+func (client *restClientImpl) Delete(ctx context.Context, resourceID string, resourceBasePath string) error {
 	url := client.buildResourceURL(resourceBasePath, resourceID)
-	req := client.createRequest()
-	_, err := client.executeRequestWithThrottling(resty.MethodDelete, url, req)
+	req := client.createRequest(ctx)
+	_, err := client.executeRequest(resty.MethodDelete, url, req, true)
+	return err
+}
+
+// DeleteByPath executes a HTTP DELETE request for the given resourcePath without appending a resource ID
+func (client *restClientImpl) DeleteByPath(ctx context.Context, resourcePath string) error {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest(ctx)
+	_, err := client.executeRequest(resty.MethodDelete, url, req, true)
+	return err
+}
+
+// DeleteByQuery executes a HTTP DELETE request for the given resourcePath identifying the resource by the given query parameters
+func (client *restClientImpl) DeleteByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) error {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest(ctx)
+	client.appendQueryParameters(req, queryParams)
+	_, err := client.executeRequest(resty.MethodDelete, url, req, true)
 	return err
 }
 
 // PostByQuery executes a HTTP POST request to create the resource by providing the data a query parameters
-func (client *restClientImpl) PostByQuery(resourcePath string, queryParams map[string]string) ([]byte, error) {
+func (client *restClientImpl) PostByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) ([]byte, error) {
 	url := client.buildURL(resourcePath)
-	req := client.createRequest()
+	req := client.createRequest(ctx)
 	client.appendQueryParameters(req, queryParams)
-	return client.executeRequest(resty.MethodPost, url, req)
+	return client.executeRequest(resty.MethodPost, url, req, false)
 }
 
 // PutByQuery executes a HTTP PUT request to update the resource with the given ID by providing the data a query parameters
-func (client *restClientImpl) PutByQuery(resourcePath string, id string, queryParams map[string]string) ([]byte, error) {
+func (client *restClientImpl) PutByQuery(ctx context.Context, resourcePath string, id string, queryParams map[string]string) ([]byte, error) {
 	url := client.buildResourceURL(resourcePath, id)
-	req := client.createRequest()
+	req := client.createRequest(ctx)
 	client.appendQueryParameters(req, queryParams)
-	return client.executeRequest(resty.MethodPut, url, req)
+	return client.executeRequest(resty.MethodPut, url, req, true)
+}
+
+// PutByPath executes a HTTP PUT request without body for the given resourcePath without appending a resource ID
+func (client *restClientImpl) PutByPath(ctx context.Context, resourcePath string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest(ctx)
+	return client.executeRequest(resty.MethodPut, url, req, true)
+}
+
+// GetContent request data via HTTP GET for the given resourcePath accepting the given media type, e.g. text/csv
+func (client *restClientImpl) GetContent(ctx context.Context, resourcePath string, mediaType string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest(ctx).SetHeader(acceptHeader, mediaType)
+	return client.executeRequest(resty.MethodGet, url, req, true)
+}
+
+// PostContent executes a HTTP POST request for the given resourcePath sending the given content as is using the given media type
+func (client *restClientImpl) PostContent(ctx context.Context, resourcePath string, mediaType string, content []byte) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest(ctx).SetHeader(acceptHeader, mediaType).SetHeader(contentTypeHeader, mediaType).SetBody(content)
+	return client.executeRequest(resty.MethodPost, url, req, false)
 }
 
-func (client *restClientImpl) createRequest() *resty.Request {
-	//get path to root directory from runtime executor
-	_, b, _, _ := runtime.Caller(0)
-	basepath := filepath.Join(filepath.Dir(b), "../..")
+// PutContent executes a HTTP PUT request for the given resourcePath sending the given content as is using the given media type
+func (client *restClientImpl) PutContent(ctx context.Context, resourcePath string, mediaType string, content []byte) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest(ctx).SetHeader(acceptHeader, mediaType).SetHeader(contentTypeHeader, mediaType).SetBody(content)
+	return client.executeRequest(resty.MethodPut, url, req, true)
+}
 
-	//open CHANGELOG.md from root directory (only file storing updated version number)
-	terraformProviderVersion := ""
-	file, err := os.Open(basepath + "/CHANGELOG.md")
+// PutMultipartForm executes a HTTP PUT request for the given resourcePath sending the given fields and file as multipart form
+func (client *restClientImpl) PutMultipartForm(ctx context.Context, resourcePath string, fields map[string]string, fileField string, fileName string, fileContent []byte) ([]byte, error) {
+	body, contentType, err := createMultipartForm(fields, fileField, fileName, fileContent)
 	if err != nil {
-		log.Println("Error: couldn't open file", basepath+"/CHANGELOG.md", err)
-		return client.restyClient.R().SetHeader("Accept", "application/json").SetHeader("Authorization", fmt.Sprintf("apiToken %s", client.apiToken)).SetHeader("user-agent", terraformProviderVersion)
+		return emptyResponse, err
 	}
-	defer file.Close()
+	url := client.buildURL(resourcePath)
+	req := client.createRequest(ctx).SetHeader(contentTypeHeader, contentType).SetBody(body)
+	return client.executeRequest(resty.MethodPut, url, req, true)
+}
 
-	//read lines from CHANGELOG.md until first line starting with ##
-	scanner := bufio.NewScanner(file)
-	for !strings.Contains(scanner.Text(), "##") {
-		scanner.Scan()
+// createMultipartForm creates the body of a multipart form. The body is created upfront instead of streaming the
+// file so that the request can be retried
+func createMultipartForm(fields map[string]string, fileField string, fileName string, fileContent []byte) ([]byte, string, error) {
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := writer.WriteField(key, fields[key]); err != nil {
+			return nil, "", fmt.Errorf("failed to create multipart form; %w", err)
+		}
+	}
+	part, err := writer.CreateFormFile(fileField, fileName)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create multipart form; %w", err)
 	}
-	if err := scanner.Err(); err != nil {
-		log.Println("Error: Couldn't scan file", err)
-	} else {
-		//Read version number from first line  of CHANGELOG.md starting with ##
-		terraformProviderVersion = scanner.Text()
-		terraformProviderVersion = strings.Split(terraformProviderVersion, "]")[0]
-		terraformProviderVersion = strings.Split(terraformProviderVersion, "[")[1]
+	if _, err = part.Write(fileContent); err != nil {
+		return nil, "", fmt.Errorf("failed to create multipart form; %w", err)
 	}
+	if err = writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to create multipart form; %w", err)
+	}
+	return buffer.Bytes(), writer.FormDataContentType(), nil
+}
 
-	//return client with headers needed for every call
-	return client.restyClient.R().SetHeader("Accept", "application/json").SetHeader("Authorization", fmt.Sprintf("apiToken %s", client.apiToken)).SetHeader("user-agent", terraformProviderVersion)
+func (client *restClientImpl) createRequest(ctx context.Context) *resty.Request {
+	return client.restyClient.R().SetContext(ctx).SetHeader(acceptHeader, "application/json").SetHeader(userAgentHeader, client.userAgent)
+}
+
+func (client *restClientImpl) executeRequest(method string, url string, req *resty.Request, idempotent bool) ([]byte, error) {
+	ctx := tflog.SetField(tflog.SetField(req.Context(), logFieldHTTPMethod, method), logFieldHTTPURL, url)
+	limiters := client.getRateLimiters(method)
+	credentialsRefreshed := false
+	for attempt := 0; ; {
+		authorizationHeader, err := client.authenticator.AuthorizationHeader(ctx)
+		if err != nil {
+			return emptyResponse, fmt.Errorf("failed to get credentials for Instana API; %w", err)
+		}
+		req.SetHeader(authorizationHeaderName, authorizationHeader)
+		err = client.waitForRateLimiters(ctx, limiters)
+		if err != nil {
+			return emptyResponse, err
+		}
+		tflog.Debug(ctx, "Sending request to Instana API", map[string]interface{}{logFieldAttempt: attempt + 1})
+		tflog.Trace(ctx, "Instana API request details", map[string]interface{}{
+			logFieldHTTPRequestHeaders: redactHeaders(req.Header),
+			logFieldHTTPRequestBody:    marshalRequestBody(req.Body),
+		})
+		start := time.Now()
+		resp, err := req.Execute(method, url)
+		duration := time.Since(start)
+		if err != nil {
+			tflog.Debug(ctx, "Request to Instana API failed", map[string]interface{}{logFieldDuration: duration.Milliseconds(), logFieldError: err.Error()})
+			if ctx.Err() != nil {
+				return emptyResponse, fmt.Errorf("HTTP %s request to Instana API aborted; %w", method, ctx.Err())
+			}
+			if idempotent && attempt < client.retryConfig.MaxRetries && isTransientNetworkError(err) {
+				wait := client.calculateRetryWait(attempt, "")
+				attempt++
+				tflog.Warn(ctx, "Request to Instana API failed with transient network error; retry request", map[string]interface{}{
+					logFieldError:      err.Error(),
+					logFieldRetry:      attempt,
+					logFieldMaxRetries: client.retryConfig.MaxRetries,
+					logFieldRetryWait:  wait.String(),
+				})
+				if err = sleepWithContext(ctx, wait); err != nil {
+					return emptyResponse, fmt.Errorf("HTTP %s request to Instana API aborted; %w", method, err)
+				}
+				continue
+			}
+			if resp == nil {
+				return emptyResponse, fmt.Errorf("failed to send HTTP %s request to Instana API; %s", method, err)
+			}
+			return emptyResponse, fmt.Errorf("failed to send HTTP %s request to Instana API; status code = %d; status message = %s; %s", method, resp.StatusCode(), resp.Status(), err)
+		}
+		statusCode := resp.StatusCode()
+		tflog.Debug(ctx, "Received response from Instana API", map[string]interface{}{
+			logFieldHTTPStatusCode: statusCode,
+			logFieldDuration:       duration.Milliseconds(),
+			logFieldAttempt:        attempt + 1,
+		})
+		tflog.Trace(ctx, "Instana API response details", map[string]interface{}{
+			logFieldHTTPResponseHeaders: redactHeaders(resp.Header()),
+			logFieldHTTPResponseBody:    redactBody(resp.Body()),
+		})
+		for _, limiter := range limiters {
+			limiter.Adapt(statusCode, resp.Header())
+		}
+		if statusCode == http.StatusUnauthorized && !credentialsRefreshed {
+			credentialsRefreshed = true
+			refreshed, err := client.authenticator.Refresh(ctx, authorizationHeader)
+			if err != nil {
+				return emptyResponse, fmt.Errorf("failed to refresh credentials for Instana API; %w", err)
+			}
+			if refreshed {
+				tflog.Info(ctx, "Request was rejected by Instana API; repeat request with refreshed credentials")
+				continue
+			}
+		}
+		if attempt < client.retryConfig.MaxRetries && client.isRetryable(statusCode, idempotent) {
+			wait := client.calculateRetryWait(attempt, resp.Header().Get(retryAfterHeader))
+			attempt++
+			tflog.Warn(ctx, "Request to Instana API failed with transient error; retry request", map[string]interface{}{
+				logFieldHTTPStatusCode: statusCode,
+				logFieldRetry:          attempt,
+				logFieldMaxRetries:     client.retryConfig.MaxRetries,
+				logFieldRetryWait:      wait.String(),
+			})
+			if err = sleepWithContext(ctx, wait); err != nil {
+				return emptyResponse, fmt.Errorf("HTTP %s request to Instana API aborted; %w", method, err)
+			}
+			continue
+		}
+		if statusCode == http.StatusNotFound {
+			return emptyResponse, ErrEntityNotFound
+		}
+		if statusCode == http.StatusUnauthorized {
+			return emptyResponse, ErrUnauthorized
+		}
+		if statusCode < 200 || statusCode >= 300 {
+			return emptyResponse, fmt.Errorf("failed to send HTTP %s request to Instana API; status code = %d; status message = %s\nBody: %s", method, statusCode, resp.Status(), redactBody(resp.Body()))
+		}
+		return resp.Body(), nil
+	}
 }
 
-func (client *restClientImpl) executeRequestWithThrottling(method string, url string, req *resty.Request) ([]byte, error) {
-	responseChannel := make(chan *apiResponse)
-	ctx, cancel := context.WithCancel(context.Background())
-	defer close(responseChannel)
-	defer cancel()
+// getRateLimiters returns the rate limiters which apply to requests with the given HTTP method. All requests are limited
+// by the overall request limiter. Write requests are additionally limited by the write rate limiter.
+func (client *restClientImpl) getRateLimiters(method string) []*rateLimiter {
+	limiters := make([]*rateLimiter, 0, 2)
+	if client.requestRateLimiter != nil {
+		limiters = append(limiters, client.requestRateLimiter)
+	}
+	if method != resty.MethodGet && client.writeRateLimiter != nil {
+		limiters = append(limiters, client.writeRateLimiter)
+	}
+	return limiters
+}
 
-	client.throttledRequests <- &apiRequest{
-		method:          method,
-		url:             url,
-		request:         *req,
-		ctx:             ctx,
-		responseChannel: responseChannel,
+// waitForRateLimiters waits until all rate limiters grant the request. The request is dropped when the context is
+// cancelled or its deadline is exceeded before. The time spent in the throttling queue is therefore bound by the
+// timeout of the terraform operation.
+func (client *restClientImpl) waitForRateLimiters(ctx context.Context, limiters []*rateLimiter) error {
+	for _, limiter := range limiters {
+		if err := limiter.Wait(ctx); err != nil {
+			return fmt.Errorf("API request dropped while waiting for throttling; %w", err)
+		}
 	}
+	return nil
+}
 
+func sleepWithContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
 	select {
-	case r := <-responseChannel:
-		return r.data, r.err
-	case <-time.After(30 * time.Second):
-		return nil, errors.New("API request timed out")
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
 
-func (client *restClientImpl) processThrottledRequests() {
-	throttle := time.NewTicker(client.throttleRate).C
-	for req := range client.throttledRequests {
-		<-throttle
-		go client.handleThrottledAPIRequest(req)
+// isRetryable checks if a request which failed with the given status code can be retried. Non-idempotent requests
+// (e.g. POST requests creating new resources) are only retried when the API rejected the request due to rate limiting
+// as in this case it is guaranteed that the request was not processed.
+func (client *restClientImpl) isRetryable(statusCode int, idempotent bool) bool {
+	if !idempotent && statusCode != http.StatusTooManyRequests {
+		return false
+	}
+	for _, code := range client.retryConfig.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
 	}
+	return false
 }
 
-func (client *restClientImpl) handleThrottledAPIRequest(req *apiRequest) {
-	data, err := client.executeRequest(req.method, req.url, &req.request)
-	responseMessage := &apiResponse{
-		data: data,
-		err:  err,
-	}
-	select {
-	case <-req.ctx.Done():
-		return
-	default:
-		req.responseChannel <- responseMessage
+// isTransientNetworkError checks if the request failed due to a network failure which might not occur again, i.e. the
+// connection was reset or closed unexpectedly or the request timed out
+func isTransientNetworkError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
 	}
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET)
 }
 
-func (client *restClientImpl) executeRequest(method string, url string, req *resty.Request) ([]byte, error) {
-	log.Printf("[DEBUG] Call %s %s\n", method, url)
-	resp, err := req.Execute(method, url)
-	if err != nil {
-		if resp == nil {
-			return emptyResponse, fmt.Errorf("failed to send HTTP %s request to Instana API; %s", method, err)
+// calculateRetryWait calculates the time to wait before the next attempt. The Retry-After header is honored when
+// provided by the API. Otherwise, an exponential backoff with full jitter is applied. In both cases the wait time
+// is limited by the configured maximum wait time.
+func (client *restClientImpl) calculateRetryWait(attempt int, retryAfter string) time.Duration {
+	maxWait := client.retryConfig.MaxWait
+	if wait, ok := parseRetryAfter(retryAfter); ok {
+		if wait > maxWait {
+			return maxWait
 		}
-		return emptyResponse, fmt.Errorf("failed to send HTTP %s request to Instana API; status code = %d; status message = %s; Headers %s, %s", method, resp.StatusCode(), resp.Status(), resp.Header(), err)
+		return wait
 	}
-	statusCode := resp.StatusCode()
-	if statusCode == 404 {
-		return emptyResponse, ErrEntityNotFound
+	backoff := maxWait
+	if attempt < maxRetryBackoffShift && retryBaseWait<<attempt < maxWait {
+		backoff = retryBaseWait << attempt
 	}
-	if statusCode < 200 || statusCode >= 300 {
-		return emptyResponse, fmt.Errorf("failed to send HTTP %s request to Instana API; status code = %d; status message = %s; Headers %s\nBody: %s", method, statusCode, resp.Status(), resp.Header(), resp.Body())
+	if backoff <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(backoff)) + 1) //nolint:gosec
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+		return 0, true
 	}
-	return resp.Body(), nil
+	return 0, false
 }
 
 func (client *restClientImpl) appendQueryParameters(req *resty.Request, queryParams map[string]string) {
@@ -257,5 +560,5 @@ func (client *restClientImpl) buildResourceURL(resourceBasePath string, id strin
 }
 
 func (client *restClientImpl) buildURL(resourcePath string) string {
-	return fmt.Sprintf("https://%s%s", client.host, resourcePath)
+	return client.baseURL + resourcePath
 }
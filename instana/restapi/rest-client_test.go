@@ -1,10 +1,17 @@
 package restapi_test
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
@@ -15,13 +22,44 @@ const testPath = "/test"
 const testID = "test-1234"
 const testData = "testData"
 const testPathWithID = testPath + "/" + testID
+const testUserAgent = "terraform-provider-instana/1.2.3 (terraform 1.5.7)"
+
+var testRetryConfig = RetryConfig{
+	MaxRetries:           3,
+	MaxWait:              10 * time.Millisecond,
+	RetryableStatusCodes: DefaultRetryableStatusCodes,
+}
+
+var testThrottleConfig = ThrottleConfig{
+	MaxRequestsPerSecond:      DefaultMaxRequestsPerSecond,
+	MaxWriteRequestsPerSecond: DefaultMaxWriteRequestsPerSecond,
+	MaxPause:                  10 * time.Millisecond,
+}
 
 func TestShouldReturnDataForSuccessfulGetRequest(t *testing.T) {
 	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodGet, testPath)
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	response, err := restClient.Get(testPath)
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldSendApiTokenAndUserAgentWithEachRequest(t *testing.T) {
+	httpServer := doSetupAndStartHttpServer(http.MethodGet, testPath, http.StatusOK, func(r *http.Request) error {
+		if r.Header.Get("Authorization") != "apiToken api-token" {
+			return fmt.Errorf("unexpected Authorization header %s", r.Header.Get("Authorization"))
+		}
+		if r.Header.Get("User-Agent") != testUserAgent {
+			return fmt.Errorf("unexpected User-Agent header %s", r.Header.Get("User-Agent"))
+		}
+		return nil
+	})
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.Get(context.Background(), testPath)
 
 	verifySuccessResponseData(response, err, t)
 }
@@ -32,27 +70,117 @@ func TestShouldReturnErrorMessageForGetRequestWhenStatusIsNotASuccessStatusAndNo
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	_, err := restClient.Get(testPath)
+	_, err := restClient.Get(context.Background(), testPath)
 
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
 
+func TestShouldRedactSensitiveDataAndOmitHeadersInErrorMessageWhenStatusIsNotASuccessStatus(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPost, testPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Internal-Header", "internal-value")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors":["invalid configuration"],"apiKey":"secret-api-key"}`))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.Post(context.Background(), testDataObject{id: testID}, testPath)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "status code = 400")
+	require.Contains(t, err.Error(), "invalid configuration")
+	require.Contains(t, err.Error(), "***REDACTED***")
+	require.NotContains(t, err.Error(), "secret-api-key")
+	require.NotContains(t, err.Error(), "internal-value")
+}
+
 func TestShouldReturnNotFoundErrorMessageForGetRequestWhenStatusIsNotEntityNotFound(t *testing.T) {
 	httpServer := setupAndStartHttpServer(http.MethodGet, testPath, http.StatusNotFound)
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	data, err := restClient.Get(testPath)
+	data, err := restClient.Get(context.Background(), testPath)
 
 	verifyNotFoundResponse(data, err, t)
 }
 
+func TestShouldReturnUnauthorizedErrorForGetRequestWhenStatusIsUnauthorized(t *testing.T) {
+	httpServer := setupAndStartHttpServer(http.MethodGet, testPath, http.StatusUnauthorized)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.Get(context.Background(), testPath)
+
+	require.ErrorIs(t, err, ErrUnauthorized)
+}
+
+func TestShouldRepeatRequestWithRefreshedCredentialsWhenStatusIsUnauthorized(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("token-1"), 0600))
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPost, testPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			require.NoError(t, os.WriteFile(tokenFile, []byte("token-2"), 0600))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(testData))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	config := createTestClientConfig(httpServer, RetryConfig{MaxRetries: 0}, DefaultThrottleConfig())
+	config.Authenticator = NewTokenAuthenticator(AuthorizationSchemeBearer, NewFileTokenSource(tokenFile))
+	restClient := createSutWithClientConfig(config)
+	response, err := restClient.Post(context.Background(), testDataObject{id: testID}, testPath)
+
+	verifySuccessResponseData(response, err, t)
+	require.Equal(t, 2, httpServer.GetCallCount(http.MethodPost, testPath))
+}
+
+func TestShouldReturnUnauthorizedErrorWhenRefreshedCredentialsAreRejected(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("token-1"), 0600))
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, testPath, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, os.WriteFile(tokenFile, []byte(r.Header.Get("Authorization")+"-next"), 0600))
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	config := createTestClientConfig(httpServer, testRetryConfig, DefaultThrottleConfig())
+	config.Authenticator = NewTokenAuthenticator(AuthorizationSchemeAPIToken, NewFileTokenSource(tokenFile))
+	restClient := createSutWithClientConfig(config)
+	_, err := restClient.Get(context.Background(), testPath)
+
+	require.ErrorIs(t, err, ErrUnauthorized)
+	require.Equal(t, 2, httpServer.GetCallCount(http.MethodGet, testPath))
+}
+
+func TestShouldFailRequestWhenCredentialsCannotBeProvided(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodGet, testPath)
+	defer httpServer.Close()
+
+	config := createTestClientConfig(httpServer, testRetryConfig, DefaultThrottleConfig())
+	config.Authenticator = NewTokenAuthenticator(AuthorizationSchemeAPIToken, NewFileTokenSource("/not/existing/token"))
+	restClient := createSutWithClientConfig(config)
+	_, err := restClient.Get(context.Background(), testPath)
+
+	require.ErrorContains(t, err, "failed to get credentials for Instana API")
+	require.Equal(t, 0, httpServer.GetCallCount(http.MethodGet, testPath))
+}
+
 func TestShouldReturnDataForSuccessfulGetOneRequest(t *testing.T) {
 	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodGet, testPathWithID)
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	response, err := restClient.GetOne(testID, testPath)
+	response, err := restClient.GetOne(context.Background(), testID, testPath)
 
 	verifySuccessResponseData(response, err, t)
 }
@@ -62,7 +190,7 @@ func TestShouldReturnDataForSuccessfulGetOneRequestWhenResourcePathEndsWithASlas
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	response, err := restClient.GetOne(testID, testPath+"/")
+	response, err := restClient.GetOne(context.Background(), testID, testPath+"/")
 
 	verifySuccessResponseData(response, err, t)
 }
@@ -73,7 +201,7 @@ func TestShouldReturnErrorMessageForGetOneRequestWhenStatusIsNotASuccessStatusAn
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	_, err := restClient.GetOne(testID, testPath)
+	_, err := restClient.GetOne(context.Background(), testID, testPath)
 
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
@@ -83,7 +211,7 @@ func TestShouldReturnNotFoundErrorMessageForGetOneRequestWhenStatusIsNotEntityNo
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	data, err := restClient.GetOne(testID, testPath)
+	data, err := restClient.GetOne(context.Background(), testID, testPath)
 
 	verifyNotFoundResponse(data, err, t)
 }
@@ -93,7 +221,7 @@ func TestShouldReturnDataForSuccessfulPostRequest(t *testing.T) {
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	response, err := restClient.Post(testDataObject{id: testID}, testPath)
+	response, err := restClient.Post(context.Background(), testDataObject{id: testID}, testPath)
 
 	verifySuccessResponseData(response, err, t)
 }
@@ -104,7 +232,7 @@ func TestShouldReturnErrorMessageForPostRequestWhenStatusIsNotASuccessStatusAndN
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	_, err := restClient.Post(testDataObject{id: testID}, testPath)
+	_, err := restClient.Post(context.Background(), testDataObject{id: testID}, testPath)
 
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
@@ -114,7 +242,7 @@ func TestShouldReturnDataForSuccessfulPostWithIDRequest(t *testing.T) {
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	response, err := restClient.PostWithID(testDataObject{id: testID}, testPath)
+	response, err := restClient.PostWithID(context.Background(), testDataObject{id: testID}, testPath)
 
 	verifySuccessResponseData(response, err, t)
 }
@@ -125,7 +253,7 @@ func TestShouldReturnErrorMessageForPostWithIDRequestWhenStatusIsNotASuccessStat
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	_, err := restClient.PostWithID(testDataObject{id: testID}, testPath)
+	_, err := restClient.PostWithID(context.Background(), testDataObject{id: testID}, testPath)
 
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
@@ -135,7 +263,7 @@ func TestShouldReturnDataForSuccessfulPutRequest(t *testing.T) {
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	response, err := restClient.Put(testDataObject{id: testID}, testPath)
+	response, err := restClient.Put(context.Background(), testDataObject{id: testID}, testPath)
 
 	verifySuccessResponseData(response, err, t)
 }
@@ -146,7 +274,7 @@ func TestShouldReturnErrorMessageForPutRequestWhenStatusIsNotASuccessStatusAndNo
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	_, err := restClient.Put(testDataObject{id: testID}, testPath)
+	_, err := restClient.Put(context.Background(), testDataObject{id: testID}, testPath)
 
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
@@ -169,7 +297,7 @@ func shouldReturnDataForSuccessfulPostByQueryRequest(t *testing.T, queryParamete
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	response, err := restClient.PostByQuery(testPath, queryParameters)
+	response, err := restClient.PostByQuery(context.Background(), testPath, queryParameters)
 
 	verifySuccessResponseData(response, err, t)
 }
@@ -184,7 +312,7 @@ func TestShouldReturnErrorMessageForPostByQueryRequestWhenStatusIsNotASuccessSta
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	_, err := restClient.PostByQuery(testPath, queryParameters)
+	_, err := restClient.PostByQuery(context.Background(), testPath, queryParameters)
 
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
@@ -207,7 +335,7 @@ func shouldReturnDataForSuccessfulPutByQueryRequest(t *testing.T, queryParameter
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	response, err := restClient.PutByQuery(testPath, testID, queryParameters)
+	response, err := restClient.PutByQuery(context.Background(), testPath, testID, queryParameters)
 
 	verifySuccessResponseData(response, err, t)
 }
@@ -222,7 +350,7 @@ func TestShouldReturnEntityNotFoundErrorForPutByQueryRequestWhenStatusIsNotFound
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	_, err := restClient.PutByQuery(testPath, testID, queryParameters)
+	_, err := restClient.PutByQuery(context.Background(), testPath, testID, queryParameters)
 
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
@@ -237,7 +365,7 @@ func TestShouldReturnErrorMessageForPutByQueryRequestWhenStatusIsNotASuccessStat
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	_, err := restClient.PutByQuery(testPath, testID, queryParameters)
+	_, err := restClient.PutByQuery(context.Background(), testPath, testID, queryParameters)
 
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
@@ -256,7 +384,7 @@ func TestShouldReturnNothingForSuccessfulDeleteRequest(t *testing.T) {
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	err := restClient.Delete(testID, testPath)
+	err := restClient.Delete(context.Background(), testID, testPath)
 
 	require.Nil(t, err)
 }
@@ -267,11 +395,590 @@ func TestShouldReturnErrorMessageForDeleteRequestWhenStatusIsNotASuccessStatusAn
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	err := restClient.Delete(testID, testPath)
+	err := restClient.Delete(context.Background(), testID, testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnDataForSuccessfulPutByPathRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPut, testPath)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PutByPath(context.Background(), testPath)
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPutByPathRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPut, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PutByPath(context.Background(), testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnNothingForSuccessfulDeleteByPathRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodDelete, testPath)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteByPath(context.Background(), testPath)
+
+	require.Nil(t, err)
+}
+
+func TestShouldReturnErrorMessageForDeleteByPathRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodDelete, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteByPath(context.Background(), testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnNothingForSuccessfulDeleteByQueryRequest(t *testing.T) {
+	queryParameters := map[string]string{"email": "user@example.com"}
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodDelete, testPath, queryParameters, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteByQuery(context.Background(), testPath, queryParameters)
+
+	require.Nil(t, err)
+}
+
+func TestShouldReturnErrorMessageForDeleteByQueryRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	queryParameters := map[string]string{"email": "user@example.com"}
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodDelete, testPath, queryParameters, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteByQuery(context.Background(), testPath, queryParameters)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnDataForSuccessfulPostContentRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPost, testPath)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PostContent(context.Background(), testPath, "application/json", []byte(`[]`))
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldNotRetryPostContentRequestWhenStatusCodeIsRetryableButRequestMightHaveBeenProcessed(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithStatusCodeSequence(http.MethodPost, testPath, nil, http.StatusServiceUnavailable, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PostContent(context.Background(), testPath, "application/json", []byte(`[]`))
+
+	verifyFailedCallWithStatusCodeIsResponse(err, http.StatusServiceUnavailable, t)
+	require.Equal(t, 1, httpServer.GetCallCount(http.MethodPost, testPath))
+}
+
+func TestShouldRetryGetRequestWhenStatusCodeIsRetryable(t *testing.T) {
+	for _, statusCode := range DefaultRetryableStatusCodes {
+		t.Run(fmt.Sprintf("status code %d", statusCode), func(t *testing.T) {
+			httpServer := setupAndStartHttpServerWithStatusCodeSequence(http.MethodGet, testPath, nil, statusCode, statusCode, http.StatusOK)
+			defer httpServer.Close()
+
+			restClient := createSut(httpServer)
+			response, err := restClient.Get(context.Background(), testPath)
+
+			verifySuccessResponseData(response, err, t)
+			require.Equal(t, 3, httpServer.GetCallCount(http.MethodGet, testPath))
+		})
+	}
+}
+
+func TestShouldRetryPutRequestWhenStatusCodeIsRetryable(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithStatusCodeSequence(http.MethodPut, testPathWithID, nil, http.StatusBadGateway, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.Put(context.Background(), testDataObject{id: testID}, testPath)
+
+	verifySuccessResponseData(response, err, t)
+	require.Equal(t, 2, httpServer.GetCallCount(http.MethodPut, testPathWithID))
+}
+
+func TestShouldRetryPostWithIDRequestWhenStatusCodeIsRetryable(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithStatusCodeSequence(http.MethodPost, testPathWithID, nil, http.StatusGatewayTimeout, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PostWithID(context.Background(), testDataObject{id: testID}, testPath)
+
+	verifySuccessResponseData(response, err, t)
+	require.Equal(t, 2, httpServer.GetCallCount(http.MethodPost, testPathWithID))
+}
+
+func TestShouldRetryDeleteRequestWhenStatusCodeIsRetryable(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithStatusCodeSequence(http.MethodDelete, testPathWithID, nil, http.StatusServiceUnavailable, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.Delete(context.Background(), testID, testPath)
+
+	require.NoError(t, err)
+	require.Equal(t, 2, httpServer.GetCallCount(http.MethodDelete, testPathWithID))
+}
+
+func TestShouldRetryPostRequestWhenRequestWasRejectedDueToRateLimiting(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithStatusCodeSequence(http.MethodPost, testPath, nil, http.StatusTooManyRequests, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.Post(context.Background(), testDataObject{id: testID}, testPath)
+
+	verifySuccessResponseData(response, err, t)
+	require.Equal(t, 2, httpServer.GetCallCount(http.MethodPost, testPath))
+}
+
+func TestShouldNotRetryPostRequestWhenStatusCodeIsRetryableButRequestMightHaveBeenProcessed(t *testing.T) {
+	for _, statusCode := range []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		t.Run(fmt.Sprintf("status code %d", statusCode), func(t *testing.T) {
+			httpServer := setupAndStartHttpServerWithStatusCodeSequence(http.MethodPost, testPath, nil, statusCode, http.StatusOK)
+			defer httpServer.Close()
+
+			restClient := createSut(httpServer)
+			_, err := restClient.Post(context.Background(), testDataObject{id: testID}, testPath)
+
+			verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+			require.Equal(t, 1, httpServer.GetCallCount(http.MethodPost, testPath))
+		})
+	}
+}
+
+func TestShouldNotRetryPostByQueryRequestWhenStatusCodeIsRetryableButRequestMightHaveBeenProcessed(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithStatusCodeSequence(http.MethodPost, testPath, nil, http.StatusServiceUnavailable, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PostByQuery(context.Background(), testPath, map[string]string{})
+
+	verifyFailedCallWithStatusCodeIsResponse(err, http.StatusServiceUnavailable, t)
+	require.Equal(t, 1, httpServer.GetCallCount(http.MethodPost, testPath))
+}
+
+func TestShouldNotRetryRequestWhenStatusCodeIsNotRetryable(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithStatusCodeSequence(http.MethodGet, testPath, nil, http.StatusInternalServerError, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.Get(context.Background(), testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, http.StatusInternalServerError, t)
+	require.Equal(t, 1, httpServer.GetCallCount(http.MethodGet, testPath))
+}
+
+func TestShouldRetryRequestWhenStatusCodeIsConfiguredAsRetryable(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithStatusCodeSequence(http.MethodGet, testPath, nil, http.StatusInternalServerError, http.StatusOK)
+	defer httpServer.Close()
+
+	retryConfig := testRetryConfig
+	retryConfig.RetryableStatusCodes = []int{http.StatusInternalServerError}
+	restClient := createSutWithRetryConfig(httpServer, retryConfig)
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessResponseData(response, err, t)
+	require.Equal(t, 2, httpServer.GetCallCount(http.MethodGet, testPath))
+}
+
+func TestShouldReturnErrorWhenAllRetriesFailed(t *testing.T) {
+	httpServer := setupAndStartHttpServer(http.MethodGet, testPath, http.StatusServiceUnavailable)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.Get(context.Background(), testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, http.StatusServiceUnavailable, t)
+	require.Equal(t, testRetryConfig.MaxRetries+1, httpServer.GetCallCount(http.MethodGet, testPath))
+}
+
+func TestShouldNotRetryRequestWhenRetriesAreDisabled(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithStatusCodeSequence(http.MethodGet, testPath, nil, http.StatusServiceUnavailable, http.StatusOK)
+	defer httpServer.Close()
+
+	retryConfig := testRetryConfig
+	retryConfig.MaxRetries = 0
+	restClient := createSutWithRetryConfig(httpServer, retryConfig)
+	_, err := restClient.Get(context.Background(), testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, http.StatusServiceUnavailable, t)
+	require.Equal(t, 1, httpServer.GetCallCount(http.MethodGet, testPath))
+}
+
+func TestShouldRetryIdempotentRequestWhenConnectionIsClosedUnexpectedly(t *testing.T) {
+	httpServer, callCount := setupAndStartHttpServerClosingConnections(2)
+	defer httpServer.Close()
+
+	restClient := createSutForHttpServerClosingConnections(httpServer)
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessResponseData(response, err, t)
+	require.Equal(t, int32(3), callCount.Load())
+}
+
+func TestShouldReturnErrorWhenAllRetriesFailedDueToNetworkErrors(t *testing.T) {
+	httpServer, callCount := setupAndStartHttpServerClosingConnections(testRetryConfig.MaxRetries + 1)
+	defer httpServer.Close()
+
+	restClient := createSutForHttpServerClosingConnections(httpServer)
+	_, err := restClient.Put(context.Background(), testDataObject{id: testID}, testPath)
+
+	require.ErrorContains(t, err, "failed to send HTTP PUT request to Instana API")
+	require.Equal(t, int32(testRetryConfig.MaxRetries+1), callCount.Load())
+}
+
+func TestShouldNotRetryNonIdempotentRequestWhenConnectionIsClosedUnexpectedly(t *testing.T) {
+	httpServer, callCount := setupAndStartHttpServerClosingConnections(1)
+	defer httpServer.Close()
+
+	restClient := createSutForHttpServerClosingConnections(httpServer)
+	_, err := restClient.Post(context.Background(), testDataObject{id: testID}, testPath)
+
+	require.ErrorContains(t, err, "failed to send HTTP POST request to Instana API")
+	require.Equal(t, int32(1), callCount.Load())
+}
+
+func TestShouldHonorRetryAfterHeaderWhenRetryingRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithStatusCodeSequence(http.MethodGet, testPath, map[string]string{"Retry-After": "1"}, http.StatusTooManyRequests, http.StatusOK)
+	defer httpServer.Close()
+
+	retryConfig := testRetryConfig
+	retryConfig.MaxWait = 5 * time.Second
+	restClient := createSutWithRetryConfig(httpServer, retryConfig)
+	start := time.Now()
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessResponseData(response, err, t)
+	require.GreaterOrEqual(t, time.Since(start), time.Second)
+	require.Equal(t, 2, httpServer.GetCallCount(http.MethodGet, testPath))
+}
+
+func TestShouldLimitRetryAfterHeaderByMaxWaitWhenRetryingRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithStatusCodeSequence(http.MethodGet, testPath, map[string]string{"Retry-After": "3600"}, http.StatusTooManyRequests, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	start := time.Now()
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessResponseData(response, err, t)
+	require.Less(t, time.Since(start), 5*time.Second)
+	require.Equal(t, 2, httpServer.GetCallCount(http.MethodGet, testPath))
+}
+
+func TestShouldLimitPauseRequestedByRateLimitingByMaxThrottlePauseIndependentOfMaxRetryWait(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithStatusCodeSequence(http.MethodGet, testPath, map[string]string{"Retry-After": "3600"}, http.StatusTooManyRequests, http.StatusOK)
+	defer httpServer.Close()
+
+	throttleConfig := testThrottleConfig
+	throttleConfig.MaxPause = time.Second
+	restClient := createSutWithThrottleConfig(httpServer, throttleConfig)
+	start := time.Now()
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessResponseData(response, err, t)
+	require.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+	require.Less(t, time.Since(start), 5*time.Second)
+	require.Equal(t, 2, httpServer.GetCallCount(http.MethodGet, testPath))
+}
+
+func TestShouldThrottleWriteRequestsByWriteRequestBudget(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPut, testPathWithID)
+	defer httpServer.Close()
+
+	restClient := createSutWithThrottleConfig(httpServer, ThrottleConfig{MaxRequestsPerSecond: 100, MaxWriteRequestsPerSecond: 2})
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		_, err := restClient.Put(context.Background(), testDataObject{id: testID}, testPath)
+		require.NoError(t, err)
+	}
+
+	require.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+}
+
+func TestShouldNotThrottleReadRequestsByWriteRequestBudget(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodGet, testPath)
+	defer httpServer.Close()
+
+	restClient := createSutWithThrottleConfig(httpServer, ThrottleConfig{MaxRequestsPerSecond: 100, MaxWriteRequestsPerSecond: 1})
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		_, err := restClient.Get(context.Background(), testPath)
+		require.NoError(t, err)
+	}
+
+	require.Less(t, time.Since(start), 900*time.Millisecond)
+}
+
+func TestShouldThrottleReadRequestsByOverallRequestBudget(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodGet, testPath)
+	defer httpServer.Close()
+
+	restClient := createSutWithThrottleConfig(httpServer, ThrottleConfig{MaxRequestsPerSecond: 2, MaxWriteRequestsPerSecond: 0})
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		_, err := restClient.Get(context.Background(), testPath)
+		require.NoError(t, err)
+	}
+
+	require.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+}
+
+func TestShouldNotThrottleRequestsWhenThrottlingIsDisabled(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPut, testPathWithID)
+	defer httpServer.Close()
+
+	restClient := createSutWithThrottleConfig(httpServer, ThrottleConfig{})
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		_, err := restClient.Put(context.Background(), testDataObject{id: testID}, testPath)
+		require.NoError(t, err)
+	}
+
+	require.Less(t, time.Since(start), 900*time.Millisecond)
+}
+
+func TestShouldDropThrottledRequestWhenContextIsCancelledWhileWaitingInThrottlingQueue(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPut, testPathWithID)
+	defer httpServer.Close()
+
+	restClient := createSutWithThrottleConfig(httpServer, ThrottleConfig{MaxWriteRequestsPerSecond: 0.1})
+	_, err := restClient.Put(context.Background(), testDataObject{id: testID}, testPath)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	_, err = restClient.Put(ctx, testDataObject{id: testID}, testPath)
+
+	require.ErrorIs(t, err, context.Canceled)
+	require.Less(t, time.Since(start), time.Second)
+	require.Equal(t, 1, httpServer.GetCallCount(http.MethodPut, testPathWithID))
+}
+
+func TestShouldNotSendRequestWhenContextIsAlreadyCancelled(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodGet, testPath)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := restClient.Get(ctx, testPath)
+
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 0, httpServer.GetCallCount(http.MethodGet, testPath))
+}
+
+func TestShouldAbortInFlightRequestWhenContextDeadlineIsExceeded(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, testPath, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := restClient.Get(ctx, testPath)
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 2*time.Second)
+}
+
+func TestShouldAbortRetriesWhenContextIsCancelled(t *testing.T) {
+	httpServer := setupAndStartHttpServer(http.MethodGet, testPath, http.StatusServiceUnavailable)
+	defer httpServer.Close()
+
+	retryConfig := testRetryConfig
+	retryConfig.MaxWait = 10 * time.Second
+	restClient := createSutWithRetryConfig(httpServer, retryConfig)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := restClient.Get(ctx, testPath)
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 2*time.Second)
+}
+
+func TestShouldReturnDataForSuccessfulGetContentRequest(t *testing.T) {
+	httpServer := doSetupAndStartHttpServer(http.MethodGet, testPath, http.StatusOK, func(r *http.Request) error {
+		if r.Header.Get("Accept") != "text/csv" {
+			return fmt.Errorf("unexpected Accept header %s", r.Header.Get("Accept"))
+		}
+		return nil
+	})
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.GetContent(context.Background(), testPath, "text/csv")
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForGetContentRequestWhenStatusIsNotASuccessStatus(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodGet, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.GetContent(context.Background(), testPath, "text/csv")
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldSendContentAsIsForSuccessfulPutContentRequest(t *testing.T) {
+	content := "10.0.0.0/8,EU,Europe"
+	httpServer := doSetupAndStartHttpServer(http.MethodPut, testPath, http.StatusOK, func(r *http.Request) error {
+		if r.Header.Get("Content-Type") != "text/csv" {
+			return fmt.Errorf("unexpected Content-Type header %s", r.Header.Get("Content-Type"))
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return err
+		}
+		if string(body) != content {
+			return fmt.Errorf("unexpected body %s", string(body))
+		}
+		return nil
+	})
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PutContent(context.Background(), testPath, "text/csv", []byte(content))
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPutContentRequestWhenStatusIsNotASuccessStatus(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPut, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PutContent(context.Background(), testPath, "text/csv", []byte("content"))
 
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
 
+func TestShouldSendFieldsAndFileForSuccessfulPutMultipartFormRequest(t *testing.T) {
+	httpServer := doSetupAndStartHttpServer(http.MethodPut, testPath, http.StatusOK, func(r *http.Request) error {
+		if err := r.ParseMultipartForm(1024); err != nil {
+			return err
+		}
+		if r.FormValue("url") != "https://example.com/app.js" {
+			return fmt.Errorf("unexpected url field %s", r.FormValue("url"))
+		}
+		file, header, err := r.FormFile("sourceMap")
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		content, err := io.ReadAll(file)
+		if err != nil {
+			return err
+		}
+		if header.Filename != "app.js.map" || string(content) != "source-map" {
+			return fmt.Errorf("unexpected file %s with content %s", header.Filename, string(content))
+		}
+		return nil
+	})
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PutMultipartForm(context.Background(), testPath, map[string]string{"url": "https://example.com/app.js"}, "sourceMap", "app.js.map", []byte("source-map"))
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPutMultipartFormRequestWhenStatusIsNotASuccessStatus(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPut, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PutMultipartForm(context.Background(), testPath, map[string]string{}, "sourceMap", "app.js.map", []byte("source-map"))
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func setupAndStartHttpServerWithStatusCodeSequence(httpMethod string, fullPath string, headers map[string]string, statusCodes ...int) testutils.TestHTTPServer {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(httpMethod, fullPath, func(w http.ResponseWriter, r *http.Request) {
+		callIndex := httpServer.GetCallCount(httpMethod, fullPath) - 1
+		statusCode := statusCodes[len(statusCodes)-1]
+		if callIndex < len(statusCodes) {
+			statusCode = statusCodes[callIndex]
+		}
+		if statusCode >= 300 {
+			for k, v := range headers {
+				w.Header().Set(k, v)
+			}
+		}
+		w.WriteHeader(statusCode)
+		_, err := w.Write([]byte(testData))
+		if err != nil {
+			fmt.Printf("failed to write response; %s\n", err)
+		}
+	})
+	httpServer.Start()
+	return httpServer
+}
+
+// setupAndStartHttpServerClosingConnections starts a HTTP/1.1 server which closes the connection of the given number of
+// first requests without sending a response
+func setupAndStartHttpServerClosingConnections(numberOfClosedConnections int) (*httptest.Server, *atomic.Int32) {
+	callCount := &atomic.Int32{}
+	httpServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if int(callCount.Add(1)) <= numberOfClosedConnections {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				fmt.Printf("failed to hijack connection; %s\n", err)
+				return
+			}
+			_ = conn.Close()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(testData))
+		if err != nil {
+			fmt.Printf("failed to write response; %s\n", err)
+		}
+	}))
+	return httpServer, callCount
+}
+
+func createSutForHttpServerClosingConnections(httpServer *httptest.Server) RestClient {
+	return createSutWithClientConfig(ClientConfig{
+		APIToken:  "api-token",
+		Endpoint:  httpServer.Listener.Addr().String(),
+		UserAgent: testUserAgent,
+		TLS:       TLSConfig{SkipVerification: true},
+		Retry:     testRetryConfig,
+		Throttle:  testThrottleConfig,
+	})
+}
+
 func setupAndStartHttpServerWithOKResponseCode(httpMethod string, fullPath string) testutils.TestHTTPServer {
 	return setupAndStartHttpServer(httpMethod, fullPath, 200)
 }
@@ -320,7 +1027,34 @@ func doSetupAndStartHttpServer(httpMethod string, fullPath string, statusCode in
 }
 
 func createSut(httpServer testutils.TestHTTPServer) RestClient {
-	return NewClient("api-token", fmt.Sprintf("localhost:%d", httpServer.GetPort()), true)
+	return createSutWithRetryConfig(httpServer, testRetryConfig)
+}
+
+func createSutWithRetryConfig(httpServer testutils.TestHTTPServer, retryConfig RetryConfig) RestClient {
+	return createSutWithClientConfig(createTestClientConfig(httpServer, retryConfig, testThrottleConfig))
+}
+
+func createSutWithThrottleConfig(httpServer testutils.TestHTTPServer, throttleConfig ThrottleConfig) RestClient {
+	return createSutWithClientConfig(createTestClientConfig(httpServer, testRetryConfig, throttleConfig))
+}
+
+func createTestClientConfig(httpServer testutils.TestHTTPServer, retryConfig RetryConfig, throttleConfig ThrottleConfig) ClientConfig {
+	return ClientConfig{
+		APIToken:  "api-token",
+		Endpoint:  fmt.Sprintf("localhost:%d", httpServer.GetPort()),
+		UserAgent: testUserAgent,
+		TLS:       TLSConfig{SkipVerification: true},
+		Retry:     retryConfig,
+		Throttle:  throttleConfig,
+	}
+}
+
+func createSutWithClientConfig(config ClientConfig) RestClient {
+	client, err := NewClient(config)
+	if err != nil {
+		panic(err)
+	}
+	return client
 }
 
 func verifyNotFoundResponse(data []byte, err error, t *testing.T) {
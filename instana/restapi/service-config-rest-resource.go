@@ -0,0 +1,130 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// NewServiceConfigRestResource creates a new REST resource for service configs. Service configs are created using POST
+// and updated using PUT. The position of a service config is applied through the order endpoint when it is provided.
+func NewServiceConfigRestResource(unmarshaller JSONUnmarshaller[*ServiceConfig], client RestClient) RestResource[*ServiceConfig] {
+	return &serviceConfigRestResource{
+		resourcePath: ServiceConfigResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type serviceConfigRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*ServiceConfig]
+	client       RestClient
+}
+
+// GetAll returns all service configs in the order in which they are evaluated by Instana
+func (r *serviceConfigRestResource) GetAll(ctx context.Context) (*[]*ServiceConfig, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	configs, err := r.unmarshaller.UnmarshalArray(data)
+	if err != nil {
+		return nil, err
+	}
+	for i, config := range *configs {
+		position := i
+		config.Position = &position
+	}
+	return configs, nil
+}
+
+// GetOne returns the service config with the given ID. The config is looked up in the list of all service configs as
+// the position of the config is only available from the ordered list.
+func (r *serviceConfigRestResource) GetOne(ctx context.Context, id string) (*ServiceConfig, error) {
+	configs, err := r.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range *configs {
+		if config.ID == id {
+			return config, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *serviceConfigRestResource) Create(ctx context.Context, data *ServiceConfig) (*ServiceConfig, error) {
+	response, err := r.client.Post(ctx, data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	created, err := r.unmarshaller.Unmarshal(response)
+	if err != nil {
+		return data, err
+	}
+	result, err := r.applyPosition(ctx, created.ID, data.Position)
+	if err != nil {
+		return created, err
+	}
+	return result, nil
+}
+
+func (r *serviceConfigRestResource) Update(ctx context.Context, data *ServiceConfig) (*ServiceConfig, error) {
+	_, err := r.client.Put(ctx, data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	return r.applyPosition(ctx, data.ID, data.Position)
+}
+
+// applyPosition moves the service config with the given ID to the requested position and returns the service config
+// including its actual position. The order is only changed when a position is requested and the service config is not
+// at this position yet. Positions beyond the end of the list are rejected.
+func (r *serviceConfigRestResource) applyPosition(ctx context.Context, id string, position *int) (*ServiceConfig, error) {
+	configs, err := r.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(*configs))
+	var current *ServiceConfig
+	for _, config := range *configs {
+		if config.ID == id {
+			current = config
+		} else {
+			ids = append(ids, config.ID)
+		}
+	}
+	if current == nil {
+		return nil, ErrEntityNotFound
+	}
+	if position == nil {
+		return current, nil
+	}
+	target := *position
+	if target < 0 || target > len(ids) {
+		return nil, fmt.Errorf("position %d of service config %s is out of range; the position must be between 1 and %d", target+1, id, len(ids)+1)
+	}
+	if target == *current.Position {
+		return current, nil
+	}
+
+	ids = append(ids[:target], append([]string{id}, ids[target:]...)...)
+	order, err := json.Marshal(ids)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.client.PutContent(ctx, ServiceConfigOrderResourcePath, encodingApplicationJSON, order); err != nil {
+		return nil, err
+	}
+	current.Position = &target
+	return current, nil
+}
+
+func (r *serviceConfigRestResource) Delete(ctx context.Context, data *ServiceConfig) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
+}
+
+func (r *serviceConfigRestResource) DeleteByID(ctx context.Context, id string) error {
+	return r.client.Delete(ctx, id, r.resourcePath)
+}
@@ -0,0 +1,267 @@
+package restapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const serviceConfigID = "service-config-id"
+
+var serviceConfigsSerialized = []byte(`[{"id":"other-1","name":"other-1","label":"{docker.container.name}","enabled":true,"matchSpecification":[]},{"id":"service-config-id","name":"name","comment":"comment","label":"{gce.zone}-{jvm.args.abc}","enabled":true,"matchSpecification":[{"key":"gce.zone","value":".*"}]},{"id":"other-2","name":"other-2","label":"{docker.container.name}","enabled":true,"matchSpecification":[]}]`)
+var serviceConfigSerialized = []byte(`{"id":"service-config-id","name":"name","comment":"comment","label":"{gce.zone}-{jvm.args.abc}","enabled":true,"matchSpecification":[{"key":"gce.zone","value":".*"}]}`)
+
+func makeTestServiceConfig(position *int) *ServiceConfig {
+	comment := "comment"
+	return &ServiceConfig{
+		ID:                 serviceConfigID,
+		Name:               "name",
+		Comment:            &comment,
+		Label:              "{gce.zone}-{jvm.args.abc}",
+		Enabled:            true,
+		MatchSpecification: []ServiceMatchingRule{{Key: "gce.zone", Value: ".*"}},
+		Position:           position,
+	}
+}
+
+func intPtr(value int) *int {
+	return &value
+}
+
+func newServiceConfigRestResource(client RestClient) RestResource[*ServiceConfig] {
+	return NewServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ServiceConfig{}), client)
+}
+
+func TestShouldSuccessfullyGetAllServiceConfigsIncludingTheirPosition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), ServiceConfigResourcePath).Times(1).Return(serviceConfigsSerialized, nil)
+
+	result, err := newServiceConfigRestResource(client).GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, *result, 3)
+	for i, config := range *result {
+		require.Equal(t, i, *config.Position)
+	}
+}
+
+func TestShouldSuccessfullyGetOneServiceConfigIncludingItsPosition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), ServiceConfigResourcePath).Times(1).Return(serviceConfigsSerialized, nil)
+
+	result, err := newServiceConfigRestResource(client).GetOne(context.Background(), serviceConfigID)
+
+	require.NoError(t, err)
+	require.Equal(t, makeTestServiceConfig(intPtr(1)), result)
+}
+
+func TestShouldReturnEntityNotFoundWhenServiceConfigDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), ServiceConfigResourcePath).Times(1).Return([]byte(`[]`), nil)
+
+	_, err := newServiceConfigRestResource(client).GetOne(context.Background(), serviceConfigID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetOneServiceConfigWhenResponseIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), ServiceConfigResourcePath).Times(1).Return([]byte("invalid"), nil)
+
+	_, err := newServiceConfigRestResource(client).GetOne(context.Background(), serviceConfigID)
+
+	require.Error(t, err)
+}
+
+func TestShouldCreateServiceConfigWithoutChangingTheOrderWhenNoPositionIsRequested(t *testing.T) {
+	config := makeTestServiceConfig(nil)
+	config.ID = ""
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Post(gomock.Any(), config, ServiceConfigResourcePath).Times(1).Return(serviceConfigSerialized, nil)
+	client.EXPECT().Get(gomock.Any(), ServiceConfigResourcePath).Times(1).Return(serviceConfigsSerialized, nil)
+	client.EXPECT().PutContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	result, err := newServiceConfigRestResource(client).Create(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, makeTestServiceConfig(intPtr(1)), result)
+}
+
+func TestShouldCreateServiceConfigAndMoveItToTheRequestedPosition(t *testing.T) {
+	config := makeTestServiceConfig(intPtr(0))
+	config.ID = ""
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Post(gomock.Any(), config, ServiceConfigResourcePath).Times(1).Return(serviceConfigSerialized, nil)
+	client.EXPECT().Get(gomock.Any(), ServiceConfigResourcePath).Times(1).Return(serviceConfigsSerialized, nil)
+	client.EXPECT().PutContent(gomock.Any(), ServiceConfigOrderResourcePath, gomock.Any(), []byte(`["service-config-id","other-1","other-2"]`)).Times(1).Return([]byte{}, nil)
+
+	result, err := newServiceConfigRestResource(client).Create(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, makeTestServiceConfig(intPtr(0)), result)
+}
+
+func TestShouldFailToCreateServiceConfigWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+	config := makeTestServiceConfig(nil)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Post(gomock.Any(), config, ServiceConfigResourcePath).Times(1).Return(nil, expectedError)
+
+	_, err := newServiceConfigRestResource(client).Create(context.Background(), config)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldReturnCreatedServiceConfigWhenRequestedPositionIsOutOfRange(t *testing.T) {
+	config := makeTestServiceConfig(intPtr(3))
+	config.ID = ""
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Post(gomock.Any(), config, ServiceConfigResourcePath).Times(1).Return(serviceConfigSerialized, nil)
+	client.EXPECT().Get(gomock.Any(), ServiceConfigResourcePath).Times(1).Return(serviceConfigsSerialized, nil)
+	client.EXPECT().PutContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	result, err := newServiceConfigRestResource(client).Create(context.Background(), config)
+
+	require.ErrorContains(t, err, "position 4 of service config service-config-id is out of range")
+	require.Equal(t, makeTestServiceConfig(nil), result)
+}
+
+func TestShouldUpdateServiceConfigAndMoveItToTheEnd(t *testing.T) {
+	config := makeTestServiceConfig(intPtr(2))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Put(gomock.Any(), config, ServiceConfigResourcePath).Times(1).Return(serviceConfigSerialized, nil)
+	client.EXPECT().Get(gomock.Any(), ServiceConfigResourcePath).Times(1).Return(serviceConfigsSerialized, nil)
+	client.EXPECT().PutContent(gomock.Any(), ServiceConfigOrderResourcePath, gomock.Any(), []byte(`["other-1","other-2","service-config-id"]`)).Times(1).Return([]byte{}, nil)
+
+	result, err := newServiceConfigRestResource(client).Update(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, makeTestServiceConfig(intPtr(2)), result)
+}
+
+func TestShouldFailToUpdateServiceConfigWhenRequestedPositionIsOutOfRange(t *testing.T) {
+	config := makeTestServiceConfig(intPtr(10))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Put(gomock.Any(), config, ServiceConfigResourcePath).Times(1).Return(serviceConfigSerialized, nil)
+	client.EXPECT().Get(gomock.Any(), ServiceConfigResourcePath).Times(1).Return(serviceConfigsSerialized, nil)
+	client.EXPECT().PutContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := newServiceConfigRestResource(client).Update(context.Background(), config)
+
+	require.ErrorContains(t, err, "position 11 of service config service-config-id is out of range; the position must be between 1 and 3")
+}
+
+func TestShouldUpdateServiceConfigWithoutChangingTheOrderWhenItIsAtTheRequestedPosition(t *testing.T) {
+	config := makeTestServiceConfig(intPtr(1))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Put(gomock.Any(), config, ServiceConfigResourcePath).Times(1).Return(serviceConfigSerialized, nil)
+	client.EXPECT().Get(gomock.Any(), ServiceConfigResourcePath).Times(1).Return(serviceConfigsSerialized, nil)
+	client.EXPECT().PutContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	result, err := newServiceConfigRestResource(client).Update(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, makeTestServiceConfig(intPtr(1)), result)
+}
+
+func TestShouldFailToUpdateServiceConfigWhenOrderCannotBeChanged(t *testing.T) {
+	expectedError := errors.New("test")
+	config := makeTestServiceConfig(intPtr(0))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Put(gomock.Any(), config, ServiceConfigResourcePath).Times(1).Return(serviceConfigSerialized, nil)
+	client.EXPECT().Get(gomock.Any(), ServiceConfigResourcePath).Times(1).Return(serviceConfigsSerialized, nil)
+	client.EXPECT().PutContent(gomock.Any(), ServiceConfigOrderResourcePath, gomock.Any(), gomock.Any()).Times(1).Return(nil, expectedError)
+
+	_, err := newServiceConfigRestResource(client).Update(context.Background(), config)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToUpdateServiceConfigWhenConfigIsMissingAfterUpdate(t *testing.T) {
+	config := makeTestServiceConfig(intPtr(0))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Put(gomock.Any(), config, ServiceConfigResourcePath).Times(1).Return(serviceConfigSerialized, nil)
+	client.EXPECT().Get(gomock.Any(), ServiceConfigResourcePath).Times(1).Return([]byte(`[]`), nil)
+
+	_, err := newServiceConfigRestResource(client).Update(context.Background(), config)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldSuccessfullyDeleteServiceConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Delete(gomock.Any(), serviceConfigID, ServiceConfigResourcePath).Times(1).Return(nil)
+
+	err := newServiceConfigRestResource(client).Delete(context.Background(), makeTestServiceConfig(nil))
+
+	require.NoError(t, err)
+}
+
+func TestShouldAlwaysSerializeIDOfServiceConfig(t *testing.T) {
+	config := makeTestServiceConfig(nil)
+	config.ID = ""
+
+	data, err := json.Marshal(config)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":"","name":"name","comment":"comment","label":"{gce.zone}-{jvm.args.abc}","enabled":true,"matchSpecification":[{"key":"gce.zone","value":".*"}]}`, string(data))
+}
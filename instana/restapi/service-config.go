@@ -0,0 +1,32 @@
+package restapi
+
+const (
+	//ServiceConfigResourcePath path to service config resource of Instana RESTful API
+	ServiceConfigResourcePath = ApplicationMonitoringSettingsBasePath + "/service"
+	//ServiceConfigOrderResourcePath path to the resource of Instana RESTful API defining the order of service configs
+	ServiceConfigOrderResourcePath = ServiceConfigResourcePath + "/order"
+)
+
+// ServiceConfig data structure of a service mapping rule of the Instana API
+type ServiceConfig struct {
+	ID                 string                `json:"id"`
+	Name               string                `json:"name"`
+	Comment            *string               `json:"comment"`
+	Label              string                `json:"label"`
+	Enabled            bool                  `json:"enabled"`
+	MatchSpecification []ServiceMatchingRule `json:"matchSpecification"`
+	//Position the position of the service config in the ordered list of all service configs. The position is not part
+	//of the API model of the service config but is managed through the order endpoint. Nil when the position is not managed
+	Position *int `json:"-"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *ServiceConfig) GetIDForResourcePath() string {
+	return c.ID
+}
+
+// ServiceMatchingRule data structure of a match specification of a service config of the Instana API
+type ServiceMatchingRule struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,21 @@
+package restapi
+
+const (
+	//SessionSettingsResourcePath path to the session settings of the Instana RESTful API
+	SessionSettingsResourcePath = SettingsBasePath + "/session"
+	//SessionSettingsID the fixed ID of the session settings. The settings exist exactly once per tenant and are not
+	//identified by an ID in the Instana API
+	SessionSettingsID = "global"
+)
+
+// SessionSettings data structure of the tenant wide session settings of the Instana API. Both durations are provided in
+// milliseconds.
+type SessionSettings struct {
+	IdleTime  int64 `json:"idleTimeInMillis"`
+	TokenLife int64 `json:"tokenLifeTimeInMillis"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (s *SessionSettings) GetIDForResourcePath() string {
+	return SessionSettingsID
+}
@@ -0,0 +1,28 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReturnFixedIDOfSessionSettings(t *testing.T) {
+	require.Equal(t, SessionSettingsID, (&SessionSettings{}).GetIDForResourcePath())
+}
+
+func TestShouldMapSessionSettingsToAndFromJSON(t *testing.T) {
+	serialized := `{"idleTimeInMillis":1800000,"tokenLifeTimeInMillis":43200000}`
+
+	result := SessionSettings{}
+	err := json.Unmarshal([]byte(serialized), &result)
+
+	require.NoError(t, err)
+	require.Equal(t, SessionSettings{IdleTime: 1800000, TokenLife: 43200000}, result)
+
+	data, err := json.Marshal(result)
+
+	require.NoError(t, err)
+	require.JSONEq(t, serialized, string(data))
+}
@@ -0,0 +1,33 @@
+package restapi
+
+// GlobalSyntheticAlertConfigResourcePath path to global synthetic alert config resource of Instana RESTful API
+const GlobalSyntheticAlertConfigResourcePath = EventSettingsBasePath + "/global-alert-configs/synthetics"
+
+// SyntheticAlertConfig is the representation of a synthetic alert configuration in Instana
+type SyntheticAlertConfig struct {
+	ID                    string                    `json:"id"`
+	Name                  string                    `json:"name"`
+	Description           string                    `json:"description"`
+	Severity              *int                      `json:"severity,omitempty"`
+	SyntheticTestIDs      []string                  `json:"syntheticTestIds"`
+	TagFilterExpression   *TagFilter                `json:"tagFilterExpression"`
+	AlertChannelIDs       []string                  `json:"alertChannelIds"`
+	CustomerPayloadFields []CustomPayloadField[any] `json:"customPayloadFields"`
+	Rule                  SyntheticAlertRule        `json:"rule"`
+	TimeThreshold         SyntheticTimeThreshold    `json:"timeThreshold"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *SyntheticAlertConfig) GetIDForResourcePath() string {
+	return r.ID
+}
+
+// GetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (r *SyntheticAlertConfig) GetCustomerPayloadFields() []CustomPayloadField[any] {
+	return r.CustomerPayloadFields
+}
+
+// SetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (r *SyntheticAlertConfig) SetCustomerPayloadFields(fields []CustomPayloadField[any]) {
+	r.CustomerPayloadFields = fields
+}
@@ -0,0 +1,8 @@
+package restapi
+
+// SyntheticAlertRule struct representing the API model of a synthetic alert rule
+type SyntheticAlertRule struct {
+	AlertType   string       `json:"alertType"`
+	MetricName  string       `json:"metricName"`
+	Aggregation *Aggregation `json:"aggregation"`
+}
@@ -0,0 +1,31 @@
+package restapi
+
+const (
+	//SyntheticCallsSettingsResourcePath path to the synthetic calls settings of the Instana RESTful API
+	SyntheticCallsSettingsResourcePath = SettingsBasePath + "/synthetic-calls"
+	//SyntheticCallsSettingsID the fixed ID of the synthetic calls settings. The settings exist exactly once per tenant
+	//and are not identified by an ID in the Instana API
+	SyntheticCallsSettingsID = "global"
+)
+
+// SyntheticCallRule data structure of a custom rule of the synthetic calls settings of the Instana API. Calls matching
+// the match specification are classified as synthetic calls when the rule is enabled.
+type SyntheticCallRule struct {
+	Name               string     `json:"name"`
+	Description        string     `json:"description"`
+	Enabled            bool       `json:"enabled"`
+	MatchSpecification *TagFilter `json:"matchSpecification"`
+}
+
+// SyntheticCallsSettings data structure of the tenant wide synthetic calls settings of the Instana API. The custom
+// rules and the flag whether the default rules are enabled are managed. The default rules returned by the API are
+// provided by Instana and cannot be changed.
+type SyntheticCallsSettings struct {
+	CustomRules         []SyntheticCallRule `json:"customRules"`
+	DefaultRulesEnabled *bool               `json:"defaultRulesEnabled,omitempty"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (s *SyntheticCallsSettings) GetIDForResourcePath() string {
+	return SyntheticCallsSettingsID
+}
@@ -0,0 +1,73 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+// NewSyntheticCredentialRestResource creates a new REST resource for synthetic credentials
+func NewSyntheticCredentialRestResource(client RestClient) RestResource[*SyntheticCredential] {
+	return &syntheticCredentialRestResource{
+		resourcePath: SyntheticCredentialResourcePath,
+		client:       client,
+	}
+}
+
+type syntheticCredentialRestResource struct {
+	resourcePath string
+	client       RestClient
+}
+
+// GetAll returns all synthetic credentials. The Instana API only provides the names of the credentials. Therefore, the
+// values of the returned credentials are empty.
+func (r *syntheticCredentialRestResource) GetAll(ctx context.Context) (*[]*SyntheticCredential, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0)
+	if err := json.Unmarshal(data, &names); err != nil {
+		return nil, err
+	}
+	credentials := make([]*SyntheticCredential, len(names))
+	for i, name := range names {
+		credentials[i] = &SyntheticCredential{Name: name}
+	}
+	return &credentials, nil
+}
+
+// GetOne returns the synthetic credential with the given name. The Instana API does not provide an endpoint to retrieve
+// a single credential. Therefore, the credential is looked up in the list of all credential names.
+func (r *syntheticCredentialRestResource) GetOne(ctx context.Context, id string) (*SyntheticCredential, error) {
+	credentials, err := r.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, credential := range *credentials {
+		if credential.Name == id {
+			return credential, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *syntheticCredentialRestResource) Create(ctx context.Context, data *SyntheticCredential) (*SyntheticCredential, error) {
+	_, err := r.client.Post(ctx, data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	return data, nil
+}
+
+func (r *syntheticCredentialRestResource) Update(_ context.Context, _ *SyntheticCredential) (*SyntheticCredential, error) {
+	return nil, errors.New("update is not supported for synthetic credentials")
+}
+
+func (r *syntheticCredentialRestResource) Delete(ctx context.Context, data *SyntheticCredential) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
+}
+
+func (r *syntheticCredentialRestResource) DeleteByID(ctx context.Context, id string) error {
+	return r.client.Delete(ctx, id, r.resourcePath)
+}
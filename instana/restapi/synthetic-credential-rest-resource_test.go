@@ -0,0 +1,198 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const syntheticCredentialName = "user1_password"
+const syntheticCredentialValue = "secret"
+
+var syntheticCredentialNamesSerialized = []byte(`["other", "user1_password"]`)
+
+func makeTestSyntheticCredential() *SyntheticCredential {
+	return &SyntheticCredential{
+		Name:  syntheticCredentialName,
+		Value: syntheticCredentialValue,
+	}
+}
+
+func TestShouldReturnNameAsIDOfSyntheticCredential(t *testing.T) {
+	require.Equal(t, syntheticCredentialName, makeTestSyntheticCredential().GetIDForResourcePath())
+}
+
+func TestShouldSuccessfullyGetAllSyntheticCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), SyntheticCredentialResourcePath).Times(1).Return(syntheticCredentialNamesSerialized, nil)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	result, err := sut.GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*SyntheticCredential{{Name: "other"}, {Name: syntheticCredentialName}}, result)
+}
+
+func TestShouldFailToGetAllSyntheticCredentialsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), SyntheticCredentialResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	_, err := sut.GetAll(context.Background())
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToGetAllSyntheticCredentialsWhenResponseIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), SyntheticCredentialResourcePath).Times(1).Return([]byte("invalid"), nil)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	_, err := sut.GetAll(context.Background())
+
+	require.Error(t, err)
+}
+
+func TestShouldSuccessfullyGetOneSyntheticCredentialByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), SyntheticCredentialResourcePath).Times(1).Return(syntheticCredentialNamesSerialized, nil)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	result, err := sut.GetOne(context.Background(), syntheticCredentialName)
+
+	require.NoError(t, err)
+	require.Equal(t, &SyntheticCredential{Name: syntheticCredentialName}, result)
+}
+
+func TestShouldReturnEntityNotFoundWhenSyntheticCredentialDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), SyntheticCredentialResourcePath).Times(1).Return([]byte(`["other"]`), nil)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	_, err := sut.GetOne(context.Background(), syntheticCredentialName)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetOneSyntheticCredentialWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), SyntheticCredentialResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	_, err := sut.GetOne(context.Background(), syntheticCredentialName)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldSuccessfullyCreateSyntheticCredential(t *testing.T) {
+	credential := makeTestSyntheticCredential()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Post(gomock.Any(), credential, SyntheticCredentialResourcePath).Times(1).Return([]byte(`{"credentialName":"user1_password"}`), nil)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	result, err := sut.Create(context.Background(), credential)
+
+	require.NoError(t, err)
+	require.Equal(t, makeTestSyntheticCredential(), result)
+}
+
+func TestShouldFailToCreateSyntheticCredentialWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+	credential := makeTestSyntheticCredential()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Post(gomock.Any(), credential, SyntheticCredentialResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	_, err := sut.Create(context.Background(), credential)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToUpdateSyntheticCredential(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	_, err := sut.Update(context.Background(), makeTestSyntheticCredential())
+
+	require.Error(t, err)
+}
+
+func TestShouldSuccessfullyDeleteSyntheticCredentialByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Delete(gomock.Any(), syntheticCredentialName, SyntheticCredentialResourcePath).Times(1).Return(nil)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	err := sut.Delete(context.Background(), makeTestSyntheticCredential())
+
+	require.NoError(t, err)
+}
+
+func TestShouldFailToDeleteSyntheticCredentialWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Delete(gomock.Any(), syntheticCredentialName, SyntheticCredentialResourcePath).Times(1).Return(expectedError)
+
+	sut := NewSyntheticCredentialRestResource(client)
+
+	err := sut.DeleteByID(context.Background(), syntheticCredentialName)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
@@ -0,0 +1,17 @@
+package restapi
+
+// SyntheticCredentialResourcePath path to synthetic credentials
+const SyntheticCredentialResourcePath = SyntheticSettingsBasePath + "/credentials"
+
+// SyntheticCredential is the representation of a credential used by synthetic API script tests. The value of a
+// credential is stored encrypted by Instana and cannot be read after creation.
+type SyntheticCredential struct {
+	Name  string `json:"credentialName"`
+	Value string `json:"credentialValue"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. The name is the unique identifier of a
+// synthetic credential
+func (c *SyntheticCredential) GetIDForResourcePath() string {
+	return c.Name
+}
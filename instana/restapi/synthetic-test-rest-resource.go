@@ -1,5 +1,7 @@
 package restapi
 
+import "context"
+
 // NewSyntheticTestRestResource creates a new REST resource using the provided unmarshaller function to convert the response from the REST API to the corresponding InstanaDataObject. The REST resource is using PUT as operation for create and update
 func NewSyntheticTestRestResource(unmarshaller JSONUnmarshaller[*SyntheticTest], client RestClient) RestResource[*SyntheticTest] {
 	return &SyntheticTestRestResource{
@@ -15,8 +17,8 @@ type SyntheticTestRestResource struct {
 	client       RestClient
 }
 
-func (r *SyntheticTestRestResource) GetAll() (*[]*SyntheticTest, error) {
-	data, err := r.client.Get(r.resourcePath)
+func (r *SyntheticTestRestResource) GetAll(ctx context.Context) (*[]*SyntheticTest, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
 	if err != nil {
 		return nil, err
 	}
@@ -27,28 +29,28 @@ func (r *SyntheticTestRestResource) GetAll() (*[]*SyntheticTest, error) {
 	return objects, nil
 }
 
-func (r *SyntheticTestRestResource) GetOne(id string) (*SyntheticTest, error) {
-	data, err := r.client.GetOne(id, r.resourcePath)
+func (r *SyntheticTestRestResource) GetOne(ctx context.Context, id string) (*SyntheticTest, error) {
+	data, err := r.client.GetOne(ctx, id, r.resourcePath)
 	if err != nil {
 		return nil, err
 	}
 	return r.validateResponseAndConvertToStruct(data)
 }
 
-func (r *SyntheticTestRestResource) Create(data *SyntheticTest) (*SyntheticTest, error) {
-	response, err := r.client.Post(data, r.resourcePath)
+func (r *SyntheticTestRestResource) Create(ctx context.Context, data *SyntheticTest) (*SyntheticTest, error) {
+	response, err := r.client.Post(ctx, data, r.resourcePath)
 	if err != nil {
 		return data, err
 	}
 	return r.validateResponseAndConvertToStruct(response)
 }
 
-func (r *SyntheticTestRestResource) Update(data *SyntheticTest) (*SyntheticTest, error) {
-	_, err := r.client.Put(data, r.resourcePath)
+func (r *SyntheticTestRestResource) Update(ctx context.Context, data *SyntheticTest) (*SyntheticTest, error) {
+	_, err := r.client.Put(ctx, data, r.resourcePath)
 	if err != nil {
 		return data, err
 	}
-	return r.GetOne(data.GetIDForResourcePath())
+	return r.GetOne(ctx, data.GetIDForResourcePath())
 }
 
 func (r *SyntheticTestRestResource) validateResponseAndConvertToStruct(data []byte) (*SyntheticTest, error) {
@@ -59,10 +61,10 @@ func (r *SyntheticTestRestResource) validateResponseAndConvertToStruct(data []by
 	return dataObject, nil
 }
 
-func (r *SyntheticTestRestResource) Delete(data *SyntheticTest) error {
-	return r.DeleteByID(data.GetIDForResourcePath())
+func (r *SyntheticTestRestResource) Delete(ctx context.Context, data *SyntheticTest) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
 }
 
-func (r *SyntheticTestRestResource) DeleteByID(id string) error {
-	return r.client.Delete(id, r.resourcePath)
+func (r *SyntheticTestRestResource) DeleteByID(ctx context.Context, id string) error {
+	return r.client.Delete(ctx, id, r.resourcePath)
 }
@@ -1,6 +1,7 @@
 package restapi_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -50,14 +51,14 @@ func TestShouldSuccessfullyGetAllSyntheticTests(t *testing.T) {
 	defer ctrl.Finish()
 
 	restClient := mocks.NewMockRestClient(ctrl)
-	restClient.EXPECT().Get(SyntheticTestResourcePath).Times(1).Return(restResponseData, nil)
+	restClient.EXPECT().Get(gomock.Any(), SyntheticTestResourcePath).Times(1).Return(restResponseData, nil)
 
 	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*SyntheticTest](ctrl)
 	jsonUnmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(1).Return(&expectedResult, nil)
 
 	sut := NewSyntheticTestRestResource(jsonUnmarshaller, restClient)
 
-	result, err := sut.GetAll()
+	result, err := sut.GetAll(context.Background())
 
 	require.NoError(t, err)
 	require.Equal(t, &expectedResult, result)
@@ -70,14 +71,14 @@ func TestShouldReturnEmptySliceWhenNoSyntheticTestsIsReturnedForGetAll(t *testin
 	defer ctrl.Finish()
 
 	restClient := mocks.NewMockRestClient(ctrl)
-	restClient.EXPECT().Get(SyntheticTestResourcePath).Times(1).Return(restResponseData, nil)
+	restClient.EXPECT().Get(gomock.Any(), SyntheticTestResourcePath).Times(1).Return(restResponseData, nil)
 
 	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*SyntheticTest](ctrl)
 	jsonUnmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(1).Return(&[]*SyntheticTest{}, nil)
 
 	sut := NewSyntheticTestRestResource(jsonUnmarshaller, restClient)
 
-	result, err := sut.GetAll()
+	result, err := sut.GetAll(context.Background())
 
 	require.NoError(t, err)
 	require.Equal(t, &[]*SyntheticTest{}, result)
@@ -90,14 +91,14 @@ func TestShouldFailToGetAllSyntheticTestsWhenClientReturnsError(t *testing.T) {
 	defer ctrl.Finish()
 
 	restClient := mocks.NewMockRestClient(ctrl)
-	restClient.EXPECT().Get(SyntheticTestResourcePath).Times(1).Return(nil, expectedError)
+	restClient.EXPECT().Get(gomock.Any(), SyntheticTestResourcePath).Times(1).Return(nil, expectedError)
 
 	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*SyntheticTest](ctrl)
 	jsonUnmarshaller.EXPECT().UnmarshalArray(gomock.Any()).Times(0)
 
 	sut := NewSyntheticTestRestResource(jsonUnmarshaller, restClient)
 
-	_, err := sut.GetAll()
+	_, err := sut.GetAll(context.Background())
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -111,14 +112,14 @@ func TestShouldFailToGetAllSyntheticTestsWhenRestResultCannotBeUnmarshalled(t *t
 	defer ctrl.Finish()
 
 	restClient := mocks.NewMockRestClient(ctrl)
-	restClient.EXPECT().Get(SyntheticTestResourcePath).Times(1).Return(restResponseData, nil)
+	restClient.EXPECT().Get(gomock.Any(), SyntheticTestResourcePath).Times(1).Return(restResponseData, nil)
 
 	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*SyntheticTest](ctrl)
 	jsonUnmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(1).Return(nil, expectedError)
 
 	sut := NewSyntheticTestRestResource(jsonUnmarshaller, restClient)
 
-	_, err := sut.GetAll()
+	_, err := sut.GetAll(context.Background())
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -135,12 +136,12 @@ func TestShouldSuccessfullyExecuteGetOperationOfSyntheticTestRestResource(t *tes
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*SyntheticTest](ctrl)
 	syntheticTest := makeSyntheticTest()
 
-	client.EXPECT().GetOne(syntheticTestID, SyntheticTestResourcePath).Times(1).Return(syntheticTestSerialized, nil)
+	client.EXPECT().GetOne(gomock.Any(), syntheticTestID, SyntheticTestResourcePath).Times(1).Return(syntheticTestSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(syntheticTestSerialized).Times(1).Return(syntheticTest, nil)
 
 	sut := NewSyntheticTestRestResource(unmarshaller, client)
 
-	result, err := sut.GetOne(syntheticTestID)
+	result, err := sut.GetOne(context.Background(), syntheticTestID)
 
 	require.NoError(t, err)
 	require.Equal(t, syntheticTest, result)
@@ -153,12 +154,12 @@ func TestShouldReturnErrorWhenExecutingGetOperationOfSyntheticTestRestResourceAn
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*SyntheticTest](ctrl)
 	expectedError := errors.New("Error")
 
-	client.EXPECT().GetOne(syntheticTestID, SyntheticTestResourcePath).Times(1).Return(syntheticTestSerialized, expectedError)
+	client.EXPECT().GetOne(gomock.Any(), syntheticTestID, SyntheticTestResourcePath).Times(1).Return(syntheticTestSerialized, expectedError)
 	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
 	sut := NewSyntheticTestRestResource(unmarshaller, client)
 
-	_, err := sut.GetOne(syntheticTestID)
+	_, err := sut.GetOne(context.Background(), syntheticTestID)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -171,12 +172,12 @@ func TestShouldReturnErrorWhenExecutingGetOperationOfSyntheticTestRestResourceAn
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*SyntheticTest](ctrl)
 	expectedError := errors.New("Error")
 
-	client.EXPECT().GetOne(syntheticTestID, SyntheticTestResourcePath).Times(1).Return(syntheticTestSerialized, nil)
+	client.EXPECT().GetOne(gomock.Any(), syntheticTestID, SyntheticTestResourcePath).Times(1).Return(syntheticTestSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(syntheticTestSerialized).Times(1).Return(&SyntheticTest{}, expectedError)
 
 	sut := NewSyntheticTestRestResource(unmarshaller, client)
 
-	_, err := sut.GetOne(syntheticTestID)
+	_, err := sut.GetOne(context.Background(), syntheticTestID)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -193,12 +194,12 @@ func TestShouldSuccessfullyExecuteCreateOperationOfSyntheticTestRestResource(t *
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*SyntheticTest](ctrl)
 	syntheticTest := makeSyntheticTest()
 
-	client.EXPECT().Post(gomock.Eq(syntheticTest), gomock.Eq(SyntheticTestResourcePath)).Times(1).Return(syntheticTestSerialized, nil)
+	client.EXPECT().Post(gomock.Any(), gomock.Eq(syntheticTest), gomock.Eq(SyntheticTestResourcePath)).Times(1).Return(syntheticTestSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(syntheticTestSerialized).Times(1).Return(syntheticTest, nil)
 
 	sut := NewSyntheticTestRestResource(unmarshaller, client)
 
-	result, err := sut.Create(syntheticTest)
+	result, err := sut.Create(context.Background(), syntheticTest)
 
 	require.NoError(t, err)
 	require.Equal(t, syntheticTest, result)
@@ -212,12 +213,12 @@ func TestShouldReturnErrorWhenExecutingCreateOperationOfSyntheticTestRestResourc
 	expectedError := errors.New("Error")
 	syntheticTest := makeSyntheticTest()
 
-	client.EXPECT().Post(gomock.Eq(syntheticTest), gomock.Eq(SyntheticTestResourcePath)).Times(1).Return(syntheticTestSerialized, expectedError)
+	client.EXPECT().Post(gomock.Any(), gomock.Eq(syntheticTest), gomock.Eq(SyntheticTestResourcePath)).Times(1).Return(syntheticTestSerialized, expectedError)
 	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
 	sut := NewSyntheticTestRestResource(unmarshaller, client)
 
-	_, err := sut.Create(syntheticTest)
+	_, err := sut.Create(context.Background(), syntheticTest)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -231,12 +232,12 @@ func TestShouldReturnErrorWhenExecutingCreateOperationOfSyntheticTestRestResourc
 	expectedError := errors.New("Error")
 	syntheticTest := makeSyntheticTest()
 
-	client.EXPECT().Post(gomock.Eq(syntheticTest), gomock.Eq(SyntheticTestResourcePath)).Times(1).Return(syntheticTestSerialized, nil)
+	client.EXPECT().Post(gomock.Any(), gomock.Eq(syntheticTest), gomock.Eq(SyntheticTestResourcePath)).Times(1).Return(syntheticTestSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(syntheticTestSerialized).Times(1).Return(&SyntheticTest{}, expectedError)
 
 	sut := NewSyntheticTestRestResource(unmarshaller, client)
 
-	_, err := sut.Create(syntheticTest)
+	_, err := sut.Create(context.Background(), syntheticTest)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -253,13 +254,13 @@ func TestShouldSuccessfullyExecuteUpdateOperationOfSyntheticTestRestResource(t *
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*SyntheticTest](ctrl)
 	syntheticTest := makeSyntheticTest()
 
-	client.EXPECT().Put(gomock.Eq(syntheticTest), gomock.Eq(SyntheticTestResourcePath)).Times(1)
-	client.EXPECT().GetOne(syntheticTestID, SyntheticTestResourcePath).Times(1).Return(syntheticTestSerialized, nil)
+	client.EXPECT().Put(gomock.Any(), gomock.Eq(syntheticTest), gomock.Eq(SyntheticTestResourcePath)).Times(1)
+	client.EXPECT().GetOne(gomock.Any(), syntheticTestID, SyntheticTestResourcePath).Times(1).Return(syntheticTestSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(syntheticTestSerialized).Times(1).Return(syntheticTest, nil)
 
 	sut := NewSyntheticTestRestResource(unmarshaller, client)
 
-	result, err := sut.Update(syntheticTest)
+	result, err := sut.Update(context.Background(), syntheticTest)
 
 	require.NoError(t, err)
 	require.Equal(t, syntheticTest, result)
@@ -273,12 +274,12 @@ func TestShouldReturnErrorWhenExecutingUpdateOperationOfSyntheticTestRestResourc
 	expectedError := errors.New("Error")
 	syntheticTest := makeSyntheticTest()
 
-	client.EXPECT().Put(gomock.Eq(syntheticTest), gomock.Eq(SyntheticTestResourcePath)).Times(1).Return(syntheticTestSerialized, expectedError)
+	client.EXPECT().Put(gomock.Any(), gomock.Eq(syntheticTest), gomock.Eq(SyntheticTestResourcePath)).Times(1).Return(syntheticTestSerialized, expectedError)
 	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
 	sut := NewSyntheticTestRestResource(unmarshaller, client)
 
-	_, err := sut.Update(syntheticTest)
+	_, err := sut.Update(context.Background(), syntheticTest)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -292,13 +293,13 @@ func TestShouldReturnErrorWhenExecutingUpdateOperationOfSyntheticTestRestResourc
 	expectedError := errors.New("Error")
 	syntheticTest := makeSyntheticTest()
 
-	client.EXPECT().Put(gomock.Eq(syntheticTest), gomock.Eq(SyntheticTestResourcePath)).Times(1)
-	client.EXPECT().GetOne(syntheticTestID, SyntheticTestResourcePath).Times(1).Return(syntheticTestSerialized, nil)
+	client.EXPECT().Put(gomock.Any(), gomock.Eq(syntheticTest), gomock.Eq(SyntheticTestResourcePath)).Times(1)
+	client.EXPECT().GetOne(gomock.Any(), syntheticTestID, SyntheticTestResourcePath).Times(1).Return(syntheticTestSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(syntheticTestSerialized).Times(1).Return(&SyntheticTest{}, expectedError)
 
 	sut := NewSyntheticTestRestResource(unmarshaller, client)
 
-	_, err := sut.Update(syntheticTest)
+	_, err := sut.Update(context.Background(), syntheticTest)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -315,11 +316,11 @@ func TestShouldSuccessfullyExecuteDeleteByObjectOperationOfSyntheticTestRestReso
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*SyntheticTest](ctrl)
 	syntheticTest := makeSyntheticTest()
 
-	client.EXPECT().Delete(syntheticTestID, SyntheticTestResourcePath).Times(1).Return(nil)
+	client.EXPECT().Delete(gomock.Any(), syntheticTestID, SyntheticTestResourcePath).Times(1).Return(nil)
 
 	sut := NewSyntheticTestRestResource(unmarshaller, client)
 
-	err := sut.Delete(syntheticTest)
+	err := sut.Delete(context.Background(), syntheticTest)
 
 	require.NoError(t, err)
 }
@@ -332,11 +333,11 @@ func TestShouldReturnErrorWhenExecutingDeleteByObjectOperationOfSyntheticTestRes
 	expectedError := errors.New("Error")
 	syntheticTest := makeSyntheticTest()
 
-	client.EXPECT().Delete(syntheticTestID, SyntheticTestResourcePath).Times(1).Return(expectedError)
+	client.EXPECT().Delete(gomock.Any(), syntheticTestID, SyntheticTestResourcePath).Times(1).Return(expectedError)
 
 	sut := NewSyntheticTestRestResource(unmarshaller, client)
 
-	err := sut.Delete(syntheticTest)
+	err := sut.Delete(context.Background(), syntheticTest)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -347,11 +348,11 @@ func TestShouldSuccessfullyExecuteDeleteByIdOperationOfSyntheticTestRestResource
 	client := mocks.NewMockRestClient(ctrl)
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*SyntheticTest](ctrl)
 
-	client.EXPECT().Delete(syntheticTestID, SyntheticTestResourcePath).Times(1).Return(nil)
+	client.EXPECT().Delete(gomock.Any(), syntheticTestID, SyntheticTestResourcePath).Times(1).Return(nil)
 
 	sut := NewSyntheticTestRestResource(unmarshaller, client)
 
-	err := sut.DeleteByID(syntheticTestID)
+	err := sut.DeleteByID(context.Background(), syntheticTestID)
 
 	require.NoError(t, err)
 }
@@ -363,11 +364,11 @@ func TestShouldReturnErrorWhenExecutingDeleteByIdOperationOfSyntheticTestRestRes
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*SyntheticTest](ctrl)
 	expectedError := errors.New("Error")
 
-	client.EXPECT().Delete(syntheticTestID, SyntheticTestResourcePath).Times(1).Return(expectedError)
+	client.EXPECT().Delete(gomock.Any(), syntheticTestID, SyntheticTestResourcePath).Times(1).Return(expectedError)
 
 	sut := NewSyntheticTestRestResource(unmarshaller, client)
 
-	err := sut.DeleteByID(syntheticTestID)
+	err := sut.DeleteByID(context.Background(), syntheticTestID)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
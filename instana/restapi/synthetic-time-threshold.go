@@ -0,0 +1,7 @@
+package restapi
+
+// SyntheticTimeThreshold struct representing the API model of a synthetic time threshold
+type SyntheticTimeThreshold struct {
+	Type            string `json:"type"`
+	ViolationsCount *int32 `json:"violationsCount"`
+}
@@ -0,0 +1,145 @@
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// NewUserInvitationRestResource creates a new REST resource for user invitations. Invitations are only visible in the
+// Instana API as long as they are pending. Once the invited user joined the tenant the invitation is considered as
+// accepted and is returned with the Accepted flag set.
+func NewUserInvitationRestResource(unmarshaller JSONUnmarshaller[*UserInvitation], client RestClient) RestResource[*UserInvitation] {
+	return &userInvitationRestResource{
+		resourcePath: UserInvitationsResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type userInvitationRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*UserInvitation]
+	client       RestClient
+}
+
+// GetAll returns all pending invitations
+func (r *userInvitationRestResource) GetAll(ctx context.Context) (*[]*UserInvitation, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshaller.UnmarshalArray(data)
+}
+
+// GetOne returns the invitation of the given email address. When no pending invitation exists but a user with the given
+// email address exists, the invitation is returned as accepted. Otherwise, ErrEntityNotFound is returned.
+func (r *userInvitationRestResource) GetOne(ctx context.Context, id string) (*UserInvitation, error) {
+	invitation, err := r.getPending(ctx, id)
+	if err == nil || !errors.Is(err, ErrEntityNotFound) {
+		return invitation, err
+	}
+	data, err := r.client.Get(ctx, UsersResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*User, 0)
+	if err = json.Unmarshal(data, &users); err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if strings.EqualFold(user.Email, id) {
+			return &UserInvitation{Email: id, Accepted: true}, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *userInvitationRestResource) getPending(ctx context.Context, email string) (*UserInvitation, error) {
+	invitations, err := r.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, invitation := range *invitations {
+		if strings.EqualFold(invitation.Email, email) {
+			return invitation, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+// Create invites the user into all groups of the invitation. The Instana API expects one entry per group. Every entry
+// which is not reported as successful by the Instana API is turned into an error.
+func (r *userInvitationRestResource) Create(ctx context.Context, data *UserInvitation) (*UserInvitation, error) {
+	requests := make([]InvitationRequest, len(data.GroupIDs))
+	for i, groupID := range data.GroupIDs {
+		requests[i] = InvitationRequest{Email: data.Email, GroupID: groupID}
+	}
+	payload, err := json.Marshal(requests)
+	if err != nil {
+		return data, err
+	}
+	response, err := r.client.PostContent(ctx, r.resourcePath, encodingApplicationJSON, payload)
+	if err != nil {
+		return data, err
+	}
+	results, err := r.unmarshalInvitationResults(response)
+	if err != nil {
+		return data, err
+	}
+	for _, result := range results {
+		if result.InvitationStatus != InvitationStatusSuccess {
+			return data, fmt.Errorf("failed to invite user %s; invitation status %s", result.UserEmail, result.InvitationStatus)
+		}
+	}
+	return data, nil
+}
+
+// unmarshalInvitationResults unmarshals the response of the invitation request. The OpenAPI specification documents
+// both a single InvitationResponse object and an array of InvitationResponse objects. Therefore, both are supported.
+func (r *userInvitationRestResource) unmarshalInvitationResults(data []byte) ([]InvitationResult, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return []InvitationResult{}, nil
+	}
+	if trimmed[0] == '[' {
+		responses := make([]InvitationResponse, 0)
+		if err := json.Unmarshal(trimmed, &responses); err != nil {
+			return nil, fmt.Errorf("failed to parse invitation response; %w", err)
+		}
+		results := make([]InvitationResult, 0)
+		for _, response := range responses {
+			results = append(results, response.InvitationResults...)
+		}
+		return results, nil
+	}
+	response := InvitationResponse{}
+	if err := json.Unmarshal(trimmed, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse invitation response; %w", err)
+	}
+	return response.InvitationResults, nil
+}
+
+func (r *userInvitationRestResource) Update(_ context.Context, _ *UserInvitation) (*UserInvitation, error) {
+	return nil, errors.New("update is not supported for user invitations")
+}
+
+func (r *userInvitationRestResource) Delete(ctx context.Context, data *UserInvitation) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
+}
+
+// DeleteByID revokes the pending invitation of the given email address. Accepted invitations cannot be revoked. The
+// user remains member of the tenant.
+func (r *userInvitationRestResource) DeleteByID(ctx context.Context, id string) error {
+	_, err := r.getPending(ctx, id)
+	if errors.Is(err, ErrEntityNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return r.client.DeleteByQuery(ctx, r.resourcePath, map[string]string{"email": id})
+}
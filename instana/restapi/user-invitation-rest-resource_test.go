@@ -0,0 +1,247 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const userInvitationTestEmail = "user@example.com"
+
+func createUserInvitationRestResource(client RestClient) RestResource[*UserInvitation] {
+	return NewUserInvitationRestResource(NewDefaultJSONUnmarshaller(&UserInvitation{}), client)
+}
+
+func TestShouldReturnEmailAsIDOfUserInvitation(t *testing.T) {
+	require.Equal(t, userInvitationTestEmail, (&UserInvitation{Email: userInvitationTestEmail}).GetIDForResourcePath())
+}
+
+func TestShouldReturnIDOfUser(t *testing.T) {
+	require.Equal(t, "user-id", (&User{ID: "user-id"}).GetIDForResourcePath())
+}
+
+func TestShouldGetPendingUserInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Times(1).Return([]byte(`[{"userEmail":"other@example.com","invitationStatus":"SUCCESS"},{"userEmail":"USER@example.com","invitationStatus":"SUCCESS"}]`), nil)
+
+	sut := createUserInvitationRestResource(client)
+
+	result, err := sut.GetOne(context.Background(), userInvitationTestEmail)
+
+	require.NoError(t, err)
+	require.Equal(t, &UserInvitation{Email: "USER@example.com", InvitationStatus: InvitationStatusSuccess}, result)
+}
+
+func TestShouldReturnAcceptedUserInvitationWhenUserExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Times(1).Return([]byte(`[]`), nil)
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Times(1).Return([]byte(`[{"id":"user-id","email":"user@example.com","fullName":"User"}]`), nil)
+
+	sut := createUserInvitationRestResource(client)
+
+	result, err := sut.GetOne(context.Background(), userInvitationTestEmail)
+
+	require.NoError(t, err)
+	require.Equal(t, &UserInvitation{Email: userInvitationTestEmail, Accepted: true}, result)
+}
+
+func TestShouldReturnEntityNotFoundWhenNeitherUserInvitationNorUserExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Times(1).Return([]byte(`[]`), nil)
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Times(1).Return([]byte(`[{"id":"user-id","email":"other@example.com"}]`), nil)
+
+	sut := createUserInvitationRestResource(client)
+
+	_, err := sut.GetOne(context.Background(), userInvitationTestEmail)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetUserInvitationWhenInvitationsCannotBeRetrieved(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := createUserInvitationRestResource(client)
+
+	_, err := sut.GetOne(context.Background(), userInvitationTestEmail)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldFailToGetUserInvitationWhenUsersCannotBeRetrieved(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Times(1).Return([]byte(`[]`), nil)
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := createUserInvitationRestResource(client)
+
+	_, err := sut.GetOne(context.Background(), userInvitationTestEmail)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldCreateUserInvitationWithOneEntryPerGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	invitation := &UserInvitation{Email: userInvitationTestEmail, GroupIDs: []string{"group-1", "group-2"}}
+	expectedPayload := `[{"email":"user@example.com","groupId":"group-1"},{"email":"user@example.com","groupId":"group-2"}]`
+	response := `{"invitationResults":[{"userEmail":"user@example.com","invitationStatus":"SUCCESS"},{"userEmail":"user@example.com","invitationStatus":"SUCCESS"}]}`
+	client.EXPECT().PostContent(gomock.Any(), UserInvitationsResourcePath, gomock.Any(), []byte(expectedPayload)).Times(1).Return([]byte(response), nil)
+
+	sut := createUserInvitationRestResource(client)
+
+	result, err := sut.Create(context.Background(), invitation)
+
+	require.NoError(t, err)
+	require.Equal(t, invitation, result)
+}
+
+func TestShouldCreateUserInvitationWhenResponseIsProvidedAsArray(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	invitation := &UserInvitation{Email: userInvitationTestEmail, GroupIDs: []string{"group-1"}}
+	response := `[{"invitationResults":[{"userEmail":"user@example.com","invitationStatus":"SUCCESS"}]}]`
+	client.EXPECT().PostContent(gomock.Any(), UserInvitationsResourcePath, gomock.Any(), gomock.Any()).Times(1).Return([]byte(response), nil)
+
+	sut := createUserInvitationRestResource(client)
+
+	result, err := sut.Create(context.Background(), invitation)
+
+	require.NoError(t, err)
+	require.Equal(t, invitation, result)
+}
+
+func TestShouldFailToCreateUserInvitationWhenInvitationStatusIsNotSuccess(t *testing.T) {
+	for _, status := range []string{"INTERNAL_ERROR", "FAILURE_USER_ALREADY_EXISTS", "FAILURE_TENANT_IDP_CONFIGURED"} {
+		t.Run(status, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := mocks.NewMockRestClient(ctrl)
+
+			response := `{"invitationResults":[{"userEmail":"user@example.com","invitationStatus":"` + status + `"}]}`
+			client.EXPECT().PostContent(gomock.Any(), UserInvitationsResourcePath, gomock.Any(), gomock.Any()).Times(1).Return([]byte(response), nil)
+
+			sut := createUserInvitationRestResource(client)
+
+			_, err := sut.Create(context.Background(), &UserInvitation{Email: userInvitationTestEmail, GroupIDs: []string{"group-1"}})
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), status)
+		})
+	}
+}
+
+func TestShouldFailToCreateUserInvitationWhenResponseIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().PostContent(gomock.Any(), UserInvitationsResourcePath, gomock.Any(), gomock.Any()).Times(1).Return([]byte(`invalid`), nil)
+
+	sut := createUserInvitationRestResource(client)
+
+	_, err := sut.Create(context.Background(), &UserInvitation{Email: userInvitationTestEmail, GroupIDs: []string{"group-1"}})
+
+	require.Error(t, err)
+}
+
+func TestShouldFailToCreateUserInvitationWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().PostContent(gomock.Any(), UserInvitationsResourcePath, gomock.Any(), gomock.Any()).Times(1).Return(nil, expectedError)
+
+	sut := createUserInvitationRestResource(client)
+
+	_, err := sut.Create(context.Background(), &UserInvitation{Email: userInvitationTestEmail, GroupIDs: []string{"group-1"}})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldFailToUpdateUserInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	sut := createUserInvitationRestResource(client)
+
+	_, err := sut.Update(context.Background(), &UserInvitation{Email: userInvitationTestEmail})
+
+	require.Error(t, err)
+}
+
+func TestShouldRevokePendingUserInvitationWhenDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Times(1).Return([]byte(`[{"userEmail":"user@example.com","invitationStatus":"SUCCESS"}]`), nil)
+	client.EXPECT().DeleteByQuery(gomock.Any(), UserInvitationsResourcePath, map[string]string{"email": userInvitationTestEmail}).Times(1).Return(nil)
+
+	sut := createUserInvitationRestResource(client)
+
+	err := sut.Delete(context.Background(), &UserInvitation{Email: userInvitationTestEmail})
+
+	require.NoError(t, err)
+}
+
+func TestShouldNotRevokeAcceptedUserInvitationWhenDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Times(1).Return([]byte(`[]`), nil)
+
+	sut := createUserInvitationRestResource(client)
+
+	err := sut.DeleteByID(context.Background(), userInvitationTestEmail)
+
+	require.NoError(t, err)
+}
+
+func TestShouldFailToDeleteUserInvitationWhenInvitationsCannotBeRetrieved(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := createUserInvitationRestResource(client)
+
+	err := sut.DeleteByID(context.Background(), userInvitationTestEmail)
+
+	require.ErrorIs(t, err, expectedError)
+}
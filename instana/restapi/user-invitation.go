@@ -0,0 +1,42 @@
+package restapi
+
+// UserInvitationsResourcePath path to the user invitations of the Instana RESTful API
+const UserInvitationsResourcePath = SettingsBasePath + "/invitations"
+
+// InvitationStatusSuccess the invitation status returned by the Instana API when a user was invited successfully
+const InvitationStatusSuccess = "SUCCESS"
+
+// UserInvitation data structure of an invitation of a user into a set of RBAC groups. The Instana API only returns the
+// email address and the status of pending invitations. GroupIDs and Accepted are not part of the API model. The groups
+// are sent as individual InvitationRequest entries. Accepted is set when no pending invitation exists anymore because
+// the invited user joined the tenant.
+type UserInvitation struct {
+	Email            string   `json:"userEmail"`
+	InvitationStatus string   `json:"invitationStatus,omitempty"`
+	GroupIDs         []string `json:"-"`
+	Accepted         bool     `json:"-"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. The email address is the unique identifier
+// of an invitation
+func (i *UserInvitation) GetIDForResourcePath() string {
+	return i.Email
+}
+
+// InvitationRequest data structure of a single entry of the request to invite users of the Instana API. Each entry
+// invites the user into exactly one group.
+type InvitationRequest struct {
+	Email   string `json:"email"`
+	GroupID string `json:"groupId"`
+}
+
+// InvitationResult data structure of the result of a single invitation of the Instana API
+type InvitationResult struct {
+	UserEmail        string `json:"userEmail"`
+	InvitationStatus string `json:"invitationStatus"`
+}
+
+// InvitationResponse data structure of the response of the Instana API when users are invited
+type InvitationResponse struct {
+	InvitationResults []InvitationResult `json:"invitationResults"`
+}
@@ -0,0 +1,36 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NewUsersOverviewJSONUnmarshaller creates a new instance of a JSONUnmarshaller for the users overview of the Instana
+// API which returns the users wrapped in a users attribute. Pending invitations are ignored.
+func NewUsersOverviewJSONUnmarshaller() JSONUnmarshaller[*User] {
+	return &usersOverviewJSONUnmarshaller{}
+}
+
+type usersOverviewJSONUnmarshaller struct{}
+
+type usersOverview struct {
+	Users []*User `json:"users"`
+}
+
+// Unmarshal JSONUnmarshaller interface implementation
+func (u *usersOverviewJSONUnmarshaller) Unmarshal(data []byte) (*User, error) {
+	target := &User{}
+	if err := json.Unmarshal(data, target); err != nil {
+		return target, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return target, nil
+}
+
+// UnmarshalArray JSONUnmarshaller interface implementation
+func (u *usersOverviewJSONUnmarshaller) UnmarshalArray(data []byte) (*[]*User, error) {
+	target := usersOverview{Users: []*User{}}
+	if err := json.Unmarshal(data, &target); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return &target.Users, nil
+}
@@ -0,0 +1,22 @@
+package restapi
+
+const (
+	//UsersResourcePath path to the users of the Instana RESTful API
+	UsersResourcePath = SettingsBasePath + "/users"
+	//UsersOverviewResourcePath path to the overview of the users and pending invitations of the Instana RESTful API
+	UsersOverviewResourcePath = UsersResourcePath + "/overview"
+	//UserGroupsResourcePath path to the groups of a single user of the Instana RESTful API
+	UserGroupsResourcePath = GroupsResourcePath + "/user"
+)
+
+// User data structure of a user of the Instana API
+type User struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	FullName string `json:"fullName"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (u *User) GetIDForResourcePath() string {
+	return u.ID
+}
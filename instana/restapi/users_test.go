@@ -0,0 +1,65 @@
+package restapi_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReturnUsersOfUsersOverview(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, UsersOverviewResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, []byte(`{"users":[{"id":"user-id","email":"john.doe@example.com","fullName":"John Doe","lastLoggedIn":1699313025975,"groupCount":null,"tfaEnabled":null}],"invitations":[{"id":"invited-user-id","email":"jane.doe@example.com","groupId":"-3"}]}`))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	api, err := NewInstanaAPI(createTestClientConfig(httpServer, testRetryConfig, DefaultThrottleConfig()))
+	require.NoError(t, err)
+
+	users, err := api.UsersOverview().GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*User{{ID: "user-id", Email: "john.doe@example.com", FullName: "John Doe"}}, users)
+}
+
+func TestShouldFailToReturnUsersOfUsersOverviewWhenResponseIsInvalid(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, UsersOverviewResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, []byte(`[{"id":"user-id"}]`))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	api, err := NewInstanaAPI(createTestClientConfig(httpServer, testRetryConfig, DefaultThrottleConfig()))
+	require.NoError(t, err)
+
+	_, err = api.UsersOverview().GetAll(context.Background())
+
+	require.Error(t, err)
+}
+
+func TestShouldReturnGroupsOfUser(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, UserGroupsResourcePath+"/{email}", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != UserGroupsResourcePath+"/john.doe@example.com" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		httpServer.WriteJSONResponse(w, []byte(`[{"id":"group-id","name":"group"}]`))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	api, err := NewInstanaAPI(createTestClientConfig(httpServer, testRetryConfig, DefaultThrottleConfig()))
+	require.NoError(t, err)
+
+	groups, err := api.UserGroups("john.doe@example.com").GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*Group{{ID: "group-id", Name: "group"}}, groups)
+}
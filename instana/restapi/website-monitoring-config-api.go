@@ -8,6 +8,7 @@ type WebsiteMonitoringConfig struct {
 	ID      string `json:"id"`
 	Name    string `json:"name"`
 	AppName string `json:"appName"`
+	GeoLocationAndIPMaskingSettings
 }
 
 // GetIDForResourcePath implemention of the interface InstanaDataObject
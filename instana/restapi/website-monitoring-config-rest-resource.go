@@ -1,5 +1,7 @@
 package restapi
 
+import "context"
+
 // NewWebsiteMonitoringConfigRestResource creates a new REST for the website monitoring config
 func NewWebsiteMonitoringConfigRestResource(unmarshaller JSONUnmarshaller[*WebsiteMonitoringConfig], client RestClient) RestResource[*WebsiteMonitoringConfig] {
 	return &websiteMonitoringConfigRestResource{
@@ -15,8 +17,8 @@ type websiteMonitoringConfigRestResource struct {
 	client       RestClient
 }
 
-func (r *websiteMonitoringConfigRestResource) GetAll() (*[]*WebsiteMonitoringConfig, error) {
-	data, err := r.client.Get(r.resourcePath)
+func (r *websiteMonitoringConfigRestResource) GetAll(ctx context.Context) (*[]*WebsiteMonitoringConfig, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
 	if err != nil {
 		return nil, err
 	}
@@ -27,28 +29,57 @@ func (r *websiteMonitoringConfigRestResource) GetAll() (*[]*WebsiteMonitoringCon
 	return objects, nil
 }
 
-func (r *websiteMonitoringConfigRestResource) GetOne(id string) (*WebsiteMonitoringConfig, error) {
-	data, err := r.client.GetOne(id, r.resourcePath)
+// GetOne returns the website with the given ID including all of its geo location and IP masking settings
+func (r *websiteMonitoringConfigRestResource) GetOne(ctx context.Context, id string) (*WebsiteMonitoringConfig, error) {
+	return r.GetOneWithSettings(ctx, id, AllGeoLocationAndIPMaskingSettings)
+}
+
+// GetOneWithSettings returns the website with the given ID including the selected geo location and IP masking settings
+// only. Each selected setting requires an additional request to the Instana API.
+func (r *websiteMonitoringConfigRestResource) GetOneWithSettings(ctx context.Context, id string, selection GeoLocationAndIPMaskingSettingsSelection) (*WebsiteMonitoringConfig, error) {
+	data, err := r.client.GetOne(ctx, id, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	object, err := r.validateResponseAndConvertToStruct(data)
+	if err != nil {
+		return nil, err
+	}
+	object.GeoLocationAndIPMaskingSettings, err = readGeoLocationAndIPMaskingSettings(ctx, r.client, r.resourcePath, id, selection)
 	if err != nil {
 		return nil, err
 	}
-	return r.validateResponseAndConvertToStruct(data)
+	return object, nil
 }
 
-func (r *websiteMonitoringConfigRestResource) Create(data *WebsiteMonitoringConfig) (*WebsiteMonitoringConfig, error) {
-	response, err := r.client.PostByQuery(r.resourcePath, map[string]string{"name": data.Name})
+func (r *websiteMonitoringConfigRestResource) Create(ctx context.Context, data *WebsiteMonitoringConfig) (*WebsiteMonitoringConfig, error) {
+	response, err := r.client.PostByQuery(ctx, r.resourcePath, map[string]string{"name": data.Name})
 	if err != nil {
 		return data, err
 	}
-	return r.validateResponseAndConvertToStruct(response)
+	created, err := r.validateResponseAndConvertToStruct(response)
+	if err != nil {
+		return data, err
+	}
+	if err := updateGeoLocationAndIPMaskingSettings(ctx, r.client, r.resourcePath, created.ID, data.GeoLocationAndIPMaskingSettings); err != nil {
+		return created, err
+	}
+	return r.GetOneWithSettings(ctx, created.ID, data.Selection())
 }
 
-func (r *websiteMonitoringConfigRestResource) Update(data *WebsiteMonitoringConfig) (*WebsiteMonitoringConfig, error) {
-	response, err := r.client.PutByQuery(r.resourcePath, data.GetIDForResourcePath(), map[string]string{"name": data.Name})
+func (r *websiteMonitoringConfigRestResource) Update(ctx context.Context, data *WebsiteMonitoringConfig) (*WebsiteMonitoringConfig, error) {
+	response, err := r.client.PutByQuery(ctx, r.resourcePath, data.GetIDForResourcePath(), map[string]string{"name": data.Name})
 	if err != nil {
 		return data, err
 	}
-	return r.validateResponseAndConvertToStruct(response)
+	_, err = r.validateResponseAndConvertToStruct(response)
+	if err != nil {
+		return data, err
+	}
+	if err := updateGeoLocationAndIPMaskingSettings(ctx, r.client, r.resourcePath, data.GetIDForResourcePath(), data.GeoLocationAndIPMaskingSettings); err != nil {
+		return data, err
+	}
+	return r.GetOneWithSettings(ctx, data.GetIDForResourcePath(), data.Selection())
 }
 
 func (r *websiteMonitoringConfigRestResource) validateResponseAndConvertToStruct(data []byte) (*WebsiteMonitoringConfig, error) {
@@ -59,10 +90,10 @@ func (r *websiteMonitoringConfigRestResource) validateResponseAndConvertToStruct
 	return dataObject, nil
 }
 
-func (r *websiteMonitoringConfigRestResource) Delete(data *WebsiteMonitoringConfig) error {
-	return r.DeleteByID(data.GetIDForResourcePath())
+func (r *websiteMonitoringConfigRestResource) Delete(ctx context.Context, data *WebsiteMonitoringConfig) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
 }
 
-func (r *websiteMonitoringConfigRestResource) DeleteByID(id string) error {
-	return r.client.Delete(id, r.resourcePath)
+func (r *websiteMonitoringConfigRestResource) DeleteByID(ctx context.Context, id string) error {
+	return r.client.Delete(ctx, id, r.resourcePath)
 }
@@ -1,6 +1,7 @@
 package restapi_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -25,6 +26,20 @@ func makeTestWebsiteMonitoringConfig() *WebsiteMonitoringConfig {
 	}
 }
 
+const websiteMonitoringConfigPath = WebsiteMonitoringConfigResourcePath + "/" + websiteMonitoringConfigID
+
+func expectWebsiteMonitoringConfigSettingsToBeRead(client *mocks.MockRestClient) GeoLocationAndIPMaskingSettings {
+	client.EXPECT().GetContent(gomock.Any(), websiteMonitoringConfigPath+"/geo-location", "application/json").Times(1).Return([]byte(`{"geoDetailRemoval":"REMOVE_ALL"}`), nil)
+	client.EXPECT().GetContent(gomock.Any(), websiteMonitoringConfigPath+"/geo-mapping-rules", "text/csv").Times(1).Return([]byte("rules"), nil)
+	client.EXPECT().GetContent(gomock.Any(), websiteMonitoringConfigPath+"/ip-masking", "application/json").Times(1).Return([]byte(`{"ipMasking":"DEFAULT"}`), nil)
+	rules := "rules"
+	return GeoLocationAndIPMaskingSettings{
+		GeoLocation:     &GeoLocationConfiguration{GeoDetailRemoval: GeoDetailRemovalRemoveAll},
+		GeoMappingRules: &rules,
+		IPMasking:       &IPMaskingConfiguration{IPMasking: IPMaskingDefault},
+	}
+}
+
 // ########################################################
 // GET All Tests
 // ########################################################
@@ -37,14 +52,14 @@ func TestShouldSuccessfullyGetAllWebsiteMonitoringConfigs(t *testing.T) {
 	defer ctrl.Finish()
 
 	restClient := mocks.NewMockRestClient(ctrl)
-	restClient.EXPECT().Get(WebsiteMonitoringConfigResourcePath).Times(1).Return(restResponseData, nil)
+	restClient.EXPECT().Get(gomock.Any(), WebsiteMonitoringConfigResourcePath).Times(1).Return(restResponseData, nil)
 
 	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
 	jsonUnmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(1).Return(&expectedResult, nil)
 
 	sut := NewWebsiteMonitoringConfigRestResource(jsonUnmarshaller, restClient)
 
-	result, err := sut.GetAll()
+	result, err := sut.GetAll(context.Background())
 
 	require.NoError(t, err)
 	require.Equal(t, &expectedResult, result)
@@ -57,14 +72,14 @@ func TestShouldReturnEmptySliceWhenNoWebsiteMonitoringConfigsIsReturnedForGetAll
 	defer ctrl.Finish()
 
 	restClient := mocks.NewMockRestClient(ctrl)
-	restClient.EXPECT().Get(WebsiteMonitoringConfigResourcePath).Times(1).Return(restResponseData, nil)
+	restClient.EXPECT().Get(gomock.Any(), WebsiteMonitoringConfigResourcePath).Times(1).Return(restResponseData, nil)
 
 	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
 	jsonUnmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(1).Return(&[]*WebsiteMonitoringConfig{}, nil)
 
 	sut := NewWebsiteMonitoringConfigRestResource(jsonUnmarshaller, restClient)
 
-	result, err := sut.GetAll()
+	result, err := sut.GetAll(context.Background())
 
 	require.NoError(t, err)
 	require.Equal(t, &[]*WebsiteMonitoringConfig{}, result)
@@ -77,14 +92,14 @@ func TestShouldFailToGetAllWebsiteMonitoringConfigsWhenClientReturnsError(t *tes
 	defer ctrl.Finish()
 
 	restClient := mocks.NewMockRestClient(ctrl)
-	restClient.EXPECT().Get(WebsiteMonitoringConfigResourcePath).Times(1).Return(nil, expectedError)
+	restClient.EXPECT().Get(gomock.Any(), WebsiteMonitoringConfigResourcePath).Times(1).Return(nil, expectedError)
 
 	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
 	jsonUnmarshaller.EXPECT().UnmarshalArray(gomock.Any()).Times(0)
 
 	sut := NewWebsiteMonitoringConfigRestResource(jsonUnmarshaller, restClient)
 
-	_, err := sut.GetAll()
+	_, err := sut.GetAll(context.Background())
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -98,14 +113,14 @@ func TestShouldFailToGetAllWebsiteMonitoringConfigsWhenRestResultCannotBeUnmarsh
 	defer ctrl.Finish()
 
 	restClient := mocks.NewMockRestClient(ctrl)
-	restClient.EXPECT().Get(WebsiteMonitoringConfigResourcePath).Times(1).Return(restResponseData, nil)
+	restClient.EXPECT().Get(gomock.Any(), WebsiteMonitoringConfigResourcePath).Times(1).Return(restResponseData, nil)
 
 	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
 	jsonUnmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(1).Return(nil, expectedError)
 
 	sut := NewWebsiteMonitoringConfigRestResource(jsonUnmarshaller, restClient)
 
-	_, err := sut.GetAll()
+	_, err := sut.GetAll(context.Background())
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -122,15 +137,36 @@ func TestShouldSuccessfullyExecuteGetOperationOfWebsiteMonitoringConfigRestResou
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().GetOne(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().GetOne(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(websiteMonitoringConfig, nil)
+	settings := expectWebsiteMonitoringConfigSettingsToBeRead(client)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	result, err := sut.GetOne(websiteMonitoringConfigID)
+	result, err := sut.GetOne(context.Background(), websiteMonitoringConfigID)
 
 	require.NoError(t, err)
 	require.Equal(t, websiteMonitoringConfig, result)
+	require.Equal(t, settings, result.GeoLocationAndIPMaskingSettings)
+}
+
+func TestShouldReturnErrorWhenExecutingGetOperationOfWebsiteMonitoringConfigRestResourceAndSettingsCannotBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
+	expectedError := errors.New("error")
+
+	client.EXPECT().GetOne(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(makeTestWebsiteMonitoringConfig(), nil)
+	client.EXPECT().GetContent(gomock.Any(), websiteMonitoringConfigPath+"/geo-location", "application/json").Times(1).Return(nil, expectedError)
+
+	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(context.Background(), websiteMonitoringConfigID)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
 }
 
 func TestShouldReturnErrorWhenExecutingGetOperationOfWebsiteMonitoringConfigRestResourceAndGetOperationFails(t *testing.T) {
@@ -140,12 +176,12 @@ func TestShouldReturnErrorWhenExecutingGetOperationOfWebsiteMonitoringConfigRest
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
 	expectedError := errors.New("error")
 
-	client.EXPECT().GetOne(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, expectedError)
+	client.EXPECT().GetOne(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, expectedError)
 	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.GetOne(websiteMonitoringConfigID)
+	_, err := sut.GetOne(context.Background(), websiteMonitoringConfigID)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -158,17 +194,36 @@ func TestShouldReturnErrorWhenExecutingGetOperationOfWebsiteMonitoringConfigRest
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
 	expectedError := errors.New("error")
 
-	client.EXPECT().GetOne(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().GetOne(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(&WebsiteMonitoringConfig{}, expectedError)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.GetOne(websiteMonitoringConfigID)
+	_, err := sut.GetOne(context.Background(), websiteMonitoringConfigID)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
 }
 
+func TestShouldReadOnlySelectedSettingsOfWebsiteMonitoringConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(makeTestWebsiteMonitoringConfig(), nil)
+	client.EXPECT().GetContent(gomock.Any(), websiteMonitoringConfigPath+"/geo-mapping-rules", "text/csv").Times(1).Return([]byte("rules"), nil)
+
+	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client).(GeoLocationAndIPMaskingSettingsReader[*WebsiteMonitoringConfig])
+
+	result, err := sut.GetOneWithSettings(context.Background(), websiteMonitoringConfigID, GeoLocationAndIPMaskingSettingsSelection{GeoMappingRules: true})
+
+	require.NoError(t, err)
+	rules := "rules"
+	require.Equal(t, GeoLocationAndIPMaskingSettings{GeoMappingRules: &rules}, result.GeoLocationAndIPMaskingSettings)
+}
+
 // ########################################################
 // Create Operation Tests
 // ########################################################
@@ -180,17 +235,74 @@ func TestShouldSuccessfullyExecuteCreateOperationOfWebsiteMonitoringConfigRestRe
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().PostByQuery(WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
-	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(websiteMonitoringConfig, nil)
+	client.EXPECT().PostByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().GetOne(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(2).Return(websiteMonitoringConfig, nil)
+	client.EXPECT().GetContent(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	result, err := sut.Create(websiteMonitoringConfig)
+	result, err := sut.Create(context.Background(), websiteMonitoringConfig)
 
 	require.NoError(t, err)
 	require.Equal(t, websiteMonitoringConfig, result)
 }
 
+func TestShouldSyncProvidedSettingsWhenExecutingCreateOperationOfWebsiteMonitoringConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
+	request := makeTestWebsiteMonitoringConfig()
+	request.GeoLocation = &GeoLocationConfiguration{GeoDetailRemoval: GeoDetailRemovalRemoveAll, GeoMappingRules: []byte("[]")}
+	request.IPMasking = &IPMaskingConfiguration{IPMasking: IPMaskingDefault}
+
+	gomock.InOrder(
+		client.EXPECT().PostByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil),
+		client.EXPECT().PutContent(gomock.Any(), websiteMonitoringConfigPath+"/geo-location", "application/json", []byte(`{"geoDetailRemoval":"REMOVE_ALL","geoMappingRules":[]}`)).Times(1).Return([]byte(`{"geoDetailRemoval":"REMOVE_ALL"}`), nil),
+		client.EXPECT().PutContent(gomock.Any(), websiteMonitoringConfigPath+"/ip-masking", "application/json", []byte(`{"ipMasking":"DEFAULT"}`)).Times(1).Return([]byte(`{"ipMasking":"DEFAULT"}`), nil),
+		client.EXPECT().GetOne(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil),
+	)
+	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(2).DoAndReturn(func(_ []byte) (*WebsiteMonitoringConfig, error) {
+		return makeTestWebsiteMonitoringConfig(), nil
+	})
+	client.EXPECT().GetContent(gomock.Any(), websiteMonitoringConfigPath+"/geo-location", "application/json").Times(1).Return([]byte(`{"geoDetailRemoval":"REMOVE_ALL"}`), nil)
+	client.EXPECT().GetContent(gomock.Any(), websiteMonitoringConfigPath+"/ip-masking", "application/json").Times(1).Return([]byte(`{"ipMasking":"DEFAULT"}`), nil)
+
+	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Create(context.Background(), request)
+
+	require.NoError(t, err)
+	require.Equal(t, GeoLocationAndIPMaskingSettings{
+		GeoLocation: &GeoLocationConfiguration{GeoDetailRemoval: GeoDetailRemovalRemoveAll},
+		IPMasking:   &IPMaskingConfiguration{IPMasking: IPMaskingDefault},
+	}, result.GeoLocationAndIPMaskingSettings)
+}
+
+func TestShouldReturnCreatedWebsiteMonitoringConfigWhenSyncOfSettingsFailsAfterCreation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
+	expectedError := errors.New("error")
+	request := &WebsiteMonitoringConfig{Name: websiteMonitoringConfigName}
+	request.IPMasking = &IPMaskingConfiguration{IPMasking: IPMaskingDefault}
+
+	gomock.InOrder(
+		client.EXPECT().PostByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil),
+		unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(makeTestWebsiteMonitoringConfig(), nil),
+		client.EXPECT().PutContent(gomock.Any(), websiteMonitoringConfigPath+"/ip-masking", "application/json", gomock.Any()).Times(1).Return(nil, expectedError),
+	)
+
+	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Create(context.Background(), request)
+
+	require.ErrorIs(t, err, expectedError)
+	require.Equal(t, websiteMonitoringConfigID, result.ID)
+}
+
 func TestShouldReturnErrorWhenExecutingCreateOperationOfWebsiteMonitoringConfigRestResourceAndPostOperationFails(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -199,12 +311,12 @@ func TestShouldReturnErrorWhenExecutingCreateOperationOfWebsiteMonitoringConfigR
 	expectedError := errors.New("error")
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().PostByQuery(WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, expectedError)
+	client.EXPECT().PostByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, expectedError)
 	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.Create(websiteMonitoringConfig)
+	_, err := sut.Create(context.Background(), websiteMonitoringConfig)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -218,12 +330,12 @@ func TestShouldReturnErrorWhenExecutingCreateOperationOfWebsiteMonitoringConfigR
 	expectedError := errors.New("error")
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().PostByQuery(WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().PostByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(&WebsiteMonitoringConfig{}, expectedError)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.Create(websiteMonitoringConfig)
+	_, err := sut.Create(context.Background(), websiteMonitoringConfig)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -240,17 +352,41 @@ func TestShouldSuccessfullyExecuteUpdateOperationOfWebsiteMonitoringConfigRestRe
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().PutByQuery(WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
-	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(websiteMonitoringConfig, nil)
+	client.EXPECT().PutByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().GetOne(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(2).Return(websiteMonitoringConfig, nil)
+	client.EXPECT().GetContent(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	result, err := sut.Update(websiteMonitoringConfig)
+	result, err := sut.Update(context.Background(), websiteMonitoringConfig)
 
 	require.NoError(t, err)
 	require.Equal(t, websiteMonitoringConfig, result)
 }
 
+func TestShouldReturnErrorWhenExecutingUpdateOperationOfWebsiteMonitoringConfigRestResourceAndSettingsCannotBeUpdated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
+	expectedError := errors.New("error")
+	rules := "rules"
+	request := makeTestWebsiteMonitoringConfig()
+	request.GeoMappingRules = &rules
+
+	client.EXPECT().PutByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(makeTestWebsiteMonitoringConfig(), nil)
+	client.EXPECT().PutContent(gomock.Any(), websiteMonitoringConfigPath+"/geo-mapping-rules", "text/csv", []byte(rules)).Times(1).Return(nil, expectedError)
+
+	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
+
+	_, err := sut.Update(context.Background(), request)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
 func TestShouldReturnErrorWhenExecutingUpdateOperationOfWebsiteMonitoringConfigRestResourceAndPutOperationFails(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -259,12 +395,12 @@ func TestShouldReturnErrorWhenExecutingUpdateOperationOfWebsiteMonitoringConfigR
 	expectedError := errors.New("error")
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().PutByQuery(WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, expectedError)
+	client.EXPECT().PutByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, expectedError)
 	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.Update(websiteMonitoringConfig)
+	_, err := sut.Update(context.Background(), websiteMonitoringConfig)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -278,12 +414,12 @@ func TestShouldReturnErrorWhenExecutingUpdateOperationOfWebsiteMonitoringConfigR
 	expectedError := errors.New("error")
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().PutByQuery(WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().PutByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(&WebsiteMonitoringConfig{}, expectedError)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.Update(websiteMonitoringConfig)
+	_, err := sut.Update(context.Background(), websiteMonitoringConfig)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -300,11 +436,11 @@ func TestShouldSuccessfullyExecuteDeleteByObjectOperationOfWebsiteMonitoringConf
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().Delete(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(nil)
+	client.EXPECT().Delete(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(nil)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	err := sut.Delete(websiteMonitoringConfig)
+	err := sut.Delete(context.Background(), websiteMonitoringConfig)
 
 	require.NoError(t, err)
 }
@@ -317,11 +453,11 @@ func TestShouldReturnErrorWhenExecutingDeleteByObjectOperationOfWebsiteMonitorin
 	expectedError := errors.New("error")
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().Delete(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(expectedError)
+	client.EXPECT().Delete(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(expectedError)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	err := sut.Delete(websiteMonitoringConfig)
+	err := sut.Delete(context.Background(), websiteMonitoringConfig)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -332,11 +468,11 @@ func TestShouldSuccessfullyExecuteDeleteByIdOperationOfWebsiteMonitoringConfigRe
 	client := mocks.NewMockRestClient(ctrl)
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
 
-	client.EXPECT().Delete(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(nil)
+	client.EXPECT().Delete(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(nil)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	err := sut.DeleteByID(websiteMonitoringConfigID)
+	err := sut.DeleteByID(context.Background(), websiteMonitoringConfigID)
 
 	require.NoError(t, err)
 }
@@ -348,11 +484,11 @@ func TestShouldReturnErrorWhenExecutingDeleteByIdOperationOfWebsiteMonitoringCon
 	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
 	expectedError := errors.New("error")
 
-	client.EXPECT().Delete(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(expectedError)
+	client.EXPECT().Delete(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(expectedError)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	err := sut.DeleteByID(websiteMonitoringConfigID)
+	err := sut.DeleteByID(context.Background(), websiteMonitoringConfigID)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -0,0 +1,56 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	//websiteSourceMapUploadPathElement path element of the source map upload configurations of a website
+	websiteSourceMapUploadPathElement = "sourcemap-upload"
+	//websiteSourceMapUploadIDSeparator separator of the elements of the ID of a WebsiteSourceMapUpload
+	websiteSourceMapUploadIDSeparator = ":"
+)
+
+// WebsiteSourceMapUpload data structure of the source map files which are uploaded to a source map upload
+// configuration of a website. The Instana API only supports clearing all files of a source map upload configuration.
+// Therefore, the upload owns all files of the configuration and is identified by the website and the source map upload
+// configuration.
+type WebsiteSourceMapUpload struct {
+	WebsiteID         string
+	SourceMapConfigID string
+	SourceMaps        []WebsiteSourceMap
+}
+
+// websiteSourceMapUploadConfig data structure of a source map upload configuration as returned by the Instana API.
+// Only the metadata of the uploaded files is provided.
+type websiteSourceMapUploadConfig struct {
+	ID       string            `json:"id"`
+	Metadata []json.RawMessage `json:"metadata"`
+}
+
+// WebsiteSourceMap data structure of a single source map file of a WebsiteSourceMapUpload
+type WebsiteSourceMap struct {
+	URL           string
+	FileFormat    *string
+	SourceMapFile string
+}
+
+// GetIDForResourcePath implemention of the interface InstanaDataObject
+func (r *WebsiteSourceMapUpload) GetIDForResourcePath() string {
+	return r.WebsiteID + websiteSourceMapUploadIDSeparator + r.SourceMapConfigID
+}
+
+// ParseWebsiteSourceMapUploadID parses the ID of a WebsiteSourceMapUpload which has the format
+// <website_id>:<source_map_config_id>
+func ParseWebsiteSourceMapUploadID(id string) (*WebsiteSourceMapUpload, error) {
+	parts := strings.Split(id, websiteSourceMapUploadIDSeparator)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid ID %s of website source map upload; expected format <website_id>:<source_map_config_id>", id)
+	}
+	return &WebsiteSourceMapUpload{
+		WebsiteID:         parts[0],
+		SourceMapConfigID: parts[1],
+	}, nil
+}
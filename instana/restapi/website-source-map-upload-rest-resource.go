@@ -0,0 +1,114 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// NewWebsiteSourceMapUploadRestResource creates a new REST resource for the upload of source map files of websites
+func NewWebsiteSourceMapUploadRestResource(client RestClient) RestResource[*WebsiteSourceMapUpload] {
+	return &websiteSourceMapUploadRestResource{
+		resourcePath: WebsiteMonitoringConfigResourcePath,
+		client:       client,
+	}
+}
+
+type websiteSourceMapUploadRestResource struct {
+	resourcePath string
+	client       RestClient
+}
+
+func (r *websiteSourceMapUploadRestResource) GetAll(_ context.Context) (*[]*WebsiteSourceMapUpload, error) {
+	return nil, errors.New("reading all uploaded source map files of websites is not supported by the Instana API")
+}
+
+// GetOne returns the upload identified by the given ID. ErrEntityNotFound is returned when the website or the source
+// map upload configuration does not exist anymore. The Instana API does not provide the content of uploaded files.
+// Therefore, the upload only contains the identifying fields and changes of the uploaded files cannot be detected.
+func (r *websiteSourceMapUploadRestResource) GetOne(ctx context.Context, id string) (*WebsiteSourceMapUpload, error) {
+	data, err := ParseWebsiteSourceMapUploadID(id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.client.GetOne(ctx, data.WebsiteID, r.resourcePath); err != nil {
+		return nil, err
+	}
+	if _, err = r.getSourceMapUploadConfig(ctx, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Create uploads all source map files of the given upload to the source map upload configuration. The upload owns all
+// files of the configuration. Therefore, configurations which already contain files are rejected. When a file cannot
+// be uploaded, the configuration is cleared again so that no files remain which are not tracked by terraform.
+func (r *websiteSourceMapUploadRestResource) Create(ctx context.Context, data *WebsiteSourceMapUpload) (*WebsiteSourceMapUpload, error) {
+	config, err := r.getSourceMapUploadConfig(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(config.Metadata) > 0 {
+		return nil, fmt.Errorf("source map upload configuration %s of website %s already contains %d source map files; only empty source map upload configurations are supported", data.SourceMapConfigID, data.WebsiteID, len(config.Metadata))
+	}
+	for _, sourceMap := range data.SourceMaps {
+		if err := r.upload(ctx, data, sourceMap); err != nil {
+			if clearErr := r.Delete(ctx, data); clearErr != nil {
+				return nil, errors.Join(err, fmt.Errorf("failed to clear source map upload configuration after failed upload; %w", clearErr))
+			}
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+func (r *websiteSourceMapUploadRestResource) getSourceMapUploadConfig(ctx context.Context, data *WebsiteSourceMapUpload) (*websiteSourceMapUploadConfig, error) {
+	response, err := r.client.GetOne(ctx, data.SourceMapConfigID, fmt.Sprintf("%s/%s/%s", r.resourcePath, data.WebsiteID, websiteSourceMapUploadPathElement))
+	if err != nil {
+		return nil, err
+	}
+	config := &websiteSourceMapUploadConfig{}
+	if err := json.Unmarshal(response, config); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %w", err)
+	}
+	return config, nil
+}
+
+func (r *websiteSourceMapUploadRestResource) upload(ctx context.Context, data *WebsiteSourceMapUpload, sourceMap WebsiteSourceMap) error {
+	content, err := os.ReadFile(sourceMap.SourceMapFile)
+	if err != nil {
+		return fmt.Errorf("failed to read source map file %s; %w", sourceMap.SourceMapFile, err)
+	}
+	fields := map[string]string{"url": sourceMap.URL}
+	if sourceMap.FileFormat != nil {
+		fields["fileFormat"] = *sourceMap.FileFormat
+	}
+	_, err = r.client.PutMultipartForm(ctx, r.buildPath(data, "form"), fields, "sourceMap", filepath.Base(sourceMap.SourceMapFile), content)
+	return err
+}
+
+func (r *websiteSourceMapUploadRestResource) Update(_ context.Context, _ *WebsiteSourceMapUpload) (*WebsiteSourceMapUpload, error) {
+	return nil, errors.New("update is not supported for uploaded source map files of websites")
+}
+
+// Delete clears the source map upload configuration. The Instana API does not support the deletion of single files.
+// As the upload owns all files of the configuration, all files are removed.
+func (r *websiteSourceMapUploadRestResource) Delete(ctx context.Context, data *WebsiteSourceMapUpload) error {
+	_, err := r.client.PutByPath(ctx, r.buildPath(data, "clear"))
+	return err
+}
+
+func (r *websiteSourceMapUploadRestResource) DeleteByID(ctx context.Context, id string) error {
+	data, err := ParseWebsiteSourceMapUploadID(id)
+	if err != nil {
+		return err
+	}
+	return r.Delete(ctx, data)
+}
+
+func (r *websiteSourceMapUploadRestResource) buildPath(data *WebsiteSourceMapUpload, operation string) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", r.resourcePath, data.WebsiteID, websiteSourceMapUploadPathElement, data.SourceMapConfigID, operation)
+}
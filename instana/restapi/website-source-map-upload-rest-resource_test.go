@@ -0,0 +1,296 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const websiteSourceMapUploadWebsiteID = "website-id"
+const websiteSourceMapUploadConfigID = "source-map-config-id"
+const websiteSourceMapUploadURL = "https://example.com/js/app.min.js"
+const websiteSourceMapUploadID = websiteSourceMapUploadWebsiteID + ":" + websiteSourceMapUploadConfigID
+const websiteSourceMapUploadConfigPath = WebsiteMonitoringConfigResourcePath + "/" + websiteSourceMapUploadWebsiteID + "/sourcemap-upload/" + websiteSourceMapUploadConfigID
+
+var websiteSourceMapFileContent = []byte(`{"version":3,"mappings":""}`)
+var emptyWebsiteSourceMapUploadConfig = []byte(`{"id":"source-map-config-id","metadata":[]}`)
+
+func expectEmptyWebsiteSourceMapUploadConfig(client *mocks.MockRestClient) *gomock.Call {
+	return client.EXPECT().GetOne(gomock.Any(), websiteSourceMapUploadConfigID, WebsiteMonitoringConfigResourcePath+"/"+websiteSourceMapUploadWebsiteID+"/sourcemap-upload").Times(1).Return(emptyWebsiteSourceMapUploadConfig, nil)
+}
+
+func writeTestSourceMapFile(t *testing.T) string {
+	file := filepath.Join(t.TempDir(), "app.min.js.map")
+	require.NoError(t, os.WriteFile(file, websiteSourceMapFileContent, 0600))
+	return file
+}
+
+func makeTestWebsiteSourceMapUpload(file string) *WebsiteSourceMapUpload {
+	return &WebsiteSourceMapUpload{
+		WebsiteID:         websiteSourceMapUploadWebsiteID,
+		SourceMapConfigID: websiteSourceMapUploadConfigID,
+		SourceMaps: []WebsiteSourceMap{
+			{URL: websiteSourceMapUploadURL, SourceMapFile: file},
+		},
+	}
+}
+
+func TestShouldReturnIDOfWebsiteSourceMapUpload(t *testing.T) {
+	upload := makeTestWebsiteSourceMapUpload("file")
+
+	require.Equal(t, websiteSourceMapUploadID, upload.GetIDForResourcePath())
+}
+
+func TestShouldParseIDOfWebsiteSourceMapUpload(t *testing.T) {
+	result, err := ParseWebsiteSourceMapUploadID(websiteSourceMapUploadID)
+
+	require.NoError(t, err)
+	require.Equal(t, websiteSourceMapUploadWebsiteID, result.WebsiteID)
+	require.Equal(t, websiteSourceMapUploadConfigID, result.SourceMapConfigID)
+}
+
+func TestShouldFailToParseInvalidIDOfWebsiteSourceMapUpload(t *testing.T) {
+	for _, id := range []string{"", "website-id", ":config-id", "website-id:", "website-id:config-id:url"} {
+		t.Run(id, func(t *testing.T) {
+			result, err := ParseWebsiteSourceMapUploadID(id)
+
+			require.Error(t, err)
+			require.Nil(t, result)
+		})
+	}
+}
+
+func TestShouldGetWebsiteSourceMapUploadWhenWebsiteAndSourceMapConfigExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), websiteSourceMapUploadWebsiteID, WebsiteMonitoringConfigResourcePath).Times(1).Return([]byte(`{"id":"website-id"}`), nil)
+	expectEmptyWebsiteSourceMapUploadConfig(client)
+
+	sut := NewWebsiteSourceMapUploadRestResource(client)
+	result, err := sut.GetOne(context.Background(), websiteSourceMapUploadID)
+
+	require.NoError(t, err)
+	require.Equal(t, &WebsiteSourceMapUpload{
+		WebsiteID:         websiteSourceMapUploadWebsiteID,
+		SourceMapConfigID: websiteSourceMapUploadConfigID,
+	}, result)
+}
+
+func TestShouldReturnEntityNotFoundWhenWebsiteOfWebsiteSourceMapUploadDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), websiteSourceMapUploadWebsiteID, WebsiteMonitoringConfigResourcePath).Times(1).Return(nil, ErrEntityNotFound)
+
+	sut := NewWebsiteSourceMapUploadRestResource(client)
+	_, err := sut.GetOne(context.Background(), websiteSourceMapUploadID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldReturnEntityNotFoundWhenSourceMapConfigOfWebsiteSourceMapUploadDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), websiteSourceMapUploadWebsiteID, WebsiteMonitoringConfigResourcePath).Times(1).Return([]byte(`{"id":"website-id"}`), nil)
+	client.EXPECT().GetOne(gomock.Any(), websiteSourceMapUploadConfigID, WebsiteMonitoringConfigResourcePath+"/"+websiteSourceMapUploadWebsiteID+"/sourcemap-upload").Times(1).Return(nil, ErrEntityNotFound)
+
+	sut := NewWebsiteSourceMapUploadRestResource(client)
+	_, err := sut.GetOne(context.Background(), websiteSourceMapUploadID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetWebsiteSourceMapUploadByInvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	sut := NewWebsiteSourceMapUploadRestResource(client)
+	_, err := sut.GetOne(context.Background(), "invalid")
+
+	require.Error(t, err)
+}
+
+func TestShouldFailToGetAllWebsiteSourceMapUploads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	sut := NewWebsiteSourceMapUploadRestResource(client)
+	result, err := sut.GetAll(context.Background())
+
+	require.Error(t, err)
+	require.Nil(t, result)
+}
+
+func TestShouldUploadAllWebsiteSourceMapFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	file := writeTestSourceMapFile(t)
+	fileFormat := "js"
+	upload := makeTestWebsiteSourceMapUpload(file)
+	upload.SourceMaps[0].FileFormat = &fileFormat
+	upload.SourceMaps = append(upload.SourceMaps, WebsiteSourceMap{URL: "https://example.com/js/vendor.min.js", SourceMapFile: file})
+
+	gomock.InOrder(
+		expectEmptyWebsiteSourceMapUploadConfig(client),
+		client.EXPECT().PutMultipartForm(gomock.Any(), websiteSourceMapUploadConfigPath+"/form", map[string]string{"url": websiteSourceMapUploadURL, "fileFormat": fileFormat}, "sourceMap", "app.min.js.map", websiteSourceMapFileContent).Times(1).Return([]byte{}, nil),
+		client.EXPECT().PutMultipartForm(gomock.Any(), websiteSourceMapUploadConfigPath+"/form", map[string]string{"url": "https://example.com/js/vendor.min.js"}, "sourceMap", "app.min.js.map", websiteSourceMapFileContent).Times(1).Return([]byte{}, nil),
+	)
+
+	sut := NewWebsiteSourceMapUploadRestResource(client)
+	result, err := sut.Create(context.Background(), upload)
+
+	require.NoError(t, err)
+	require.Equal(t, upload, result)
+}
+
+func TestShouldFailToUploadWebsiteSourceMapFileWhenFileCannotBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	upload := makeTestWebsiteSourceMapUpload(filepath.Join(t.TempDir(), "missing.map"))
+
+	expectEmptyWebsiteSourceMapUploadConfig(client)
+	client.EXPECT().PutMultipartForm(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	client.EXPECT().PutByPath(gomock.Any(), websiteSourceMapUploadConfigPath+"/clear").Times(1).Return([]byte{}, nil)
+
+	sut := NewWebsiteSourceMapUploadRestResource(client)
+	_, err := sut.Create(context.Background(), upload)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to read source map file")
+}
+
+func TestShouldFailToUploadWebsiteSourceMapFileWhenClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	upload := makeTestWebsiteSourceMapUpload(writeTestSourceMapFile(t))
+	expectedError := errors.New("test")
+
+	gomock.InOrder(
+		expectEmptyWebsiteSourceMapUploadConfig(client),
+		client.EXPECT().PutMultipartForm(gomock.Any(), websiteSourceMapUploadConfigPath+"/form", map[string]string{"url": websiteSourceMapUploadURL}, "sourceMap", "app.min.js.map", websiteSourceMapFileContent).Times(1).Return(nil, expectedError),
+		client.EXPECT().PutByPath(gomock.Any(), websiteSourceMapUploadConfigPath+"/clear").Times(1).Return([]byte{}, nil),
+	)
+
+	sut := NewWebsiteSourceMapUploadRestResource(client)
+	result, err := sut.Create(context.Background(), upload)
+
+	require.ErrorIs(t, err, expectedError)
+	require.Nil(t, result)
+}
+
+func TestShouldReturnUploadAndClearErrorWhenUploadOfWebsiteSourceMapFileFailsAndConfigCannotBeCleared(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	upload := makeTestWebsiteSourceMapUpload(writeTestSourceMapFile(t))
+	uploadError := errors.New("upload")
+	clearError := errors.New("clear")
+
+	expectEmptyWebsiteSourceMapUploadConfig(client)
+	client.EXPECT().PutMultipartForm(gomock.Any(), websiteSourceMapUploadConfigPath+"/form", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, uploadError)
+	client.EXPECT().PutByPath(gomock.Any(), websiteSourceMapUploadConfigPath+"/clear").Times(1).Return(nil, clearError)
+
+	sut := NewWebsiteSourceMapUploadRestResource(client)
+	_, err := sut.Create(context.Background(), upload)
+
+	require.ErrorIs(t, err, uploadError)
+	require.ErrorIs(t, err, clearError)
+}
+
+func TestShouldRejectCreationOfWebsiteSourceMapUploadWhenSourceMapConfigAlreadyContainsFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	upload := makeTestWebsiteSourceMapUpload(writeTestSourceMapFile(t))
+
+	client.EXPECT().GetOne(gomock.Any(), websiteSourceMapUploadConfigID, WebsiteMonitoringConfigResourcePath+"/"+websiteSourceMapUploadWebsiteID+"/sourcemap-upload").Times(1).Return([]byte(`{"id":"source-map-config-id","metadata":[{"url":"https://example.com/js/other.min.js","type":"JS_MAP","format":"js"}]}`), nil)
+	client.EXPECT().PutMultipartForm(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	client.EXPECT().PutByPath(gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewWebsiteSourceMapUploadRestResource(client)
+	_, err := sut.Create(context.Background(), upload)
+
+	require.ErrorContains(t, err, "already contains 1 source map files")
+}
+
+func TestShouldFailToCreateWebsiteSourceMapUploadWhenSourceMapConfigDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	upload := makeTestWebsiteSourceMapUpload(writeTestSourceMapFile(t))
+
+	client.EXPECT().GetOne(gomock.Any(), websiteSourceMapUploadConfigID, gomock.Any()).Times(1).Return(nil, ErrEntityNotFound)
+	client.EXPECT().PutMultipartForm(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewWebsiteSourceMapUploadRestResource(client)
+	_, err := sut.Create(context.Background(), upload)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToUpdateWebsiteSourceMapUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	sut := NewWebsiteSourceMapUploadRestResource(client)
+	result, err := sut.Update(context.Background(), makeTestWebsiteSourceMapUpload("file"))
+
+	require.Error(t, err)
+	require.Nil(t, result)
+}
+
+func TestShouldClearSourceMapConfigWhenWebsiteSourceMapUploadIsDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().PutByPath(gomock.Any(), websiteSourceMapUploadConfigPath+"/clear").Times(1).Return([]byte{}, nil)
+
+	sut := NewWebsiteSourceMapUploadRestResource(client)
+	err := sut.Delete(context.Background(), makeTestWebsiteSourceMapUpload("file"))
+
+	require.NoError(t, err)
+}
+
+func TestShouldClearSourceMapConfigWhenWebsiteSourceMapUploadIsDeletedByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().PutByPath(gomock.Any(), websiteSourceMapUploadConfigPath+"/clear").Times(1).Return(nil, expectedError)
+
+	sut := NewWebsiteSourceMapUploadRestResource(client)
+	err := sut.DeleteByID(context.Background(), websiteSourceMapUploadID)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldFailToDeleteWebsiteSourceMapUploadByInvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	sut := NewWebsiteSourceMapUploadRestResource(client)
+	err := sut.DeleteByID(context.Background(), "invalid")
+
+	require.Error(t, err)
+}
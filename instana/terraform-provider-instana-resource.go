@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -11,6 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// DefaultResourceOperationTimeout the default timeout of create, read, update and delete operations of resources. The
+// timeout can be changed per resource instance using the timeouts block
+const DefaultResourceOperationTimeout = 5 * time.Minute
+
 // ResourceMetaData the metadata of a terraform ResourceHandle
 type ResourceMetaData struct {
 	ResourceName       string
@@ -20,6 +26,8 @@ type ResourceMetaData struct {
 	ResourceIDField    *string
 	CreateOnly         bool
 	DeprecationMessage string
+	//CustomizeDiff optional resource specific validation of the planned changes
+	CustomizeDiff schema.CustomizeDiffFunc
 }
 
 // ResourceHandle resource specific implementation which provides metadata and maps data from/to terraform state. Together with TerraformResource terraform schema resources can be created
@@ -39,6 +47,13 @@ type ResourceHandle[T restapi.InstanaDataObject] interface {
 	SetComputedFields(d *schema.ResourceData) error
 }
 
+// StateAwareResourceHandle optional extension of a ResourceHandle which reads the object from the Instana API depending
+// on the current state, e.g. to read only the sub resources which are managed by terraform
+type StateAwareResourceHandle[T restapi.InstanaDataObject] interface {
+	//ReadFromAPI reads the object with the given ID from the Instana API for the given state
+	ReadFromAPI(ctx context.Context, d *schema.ResourceData, api restapi.InstanaAPI, id string) (T, error)
+}
+
 // NewTerraformResource creates a new terraform resource for the given handle
 func NewTerraformResource[T restapi.InstanaDataObject](handle ResourceHandle[T]) TerraformResource {
 	return &terraformResourceImpl[T]{
@@ -60,7 +75,7 @@ type terraformResourceImpl[T restapi.InstanaDataObject] struct {
 }
 
 // Create defines the create operation for the terraform resource
-func (r *terraformResourceImpl[T]) Create(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (r *terraformResourceImpl[T]) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 
@@ -76,8 +91,9 @@ func (r *terraformResourceImpl[T]) Create(_ context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	createdObject, err := r.resourceHandle.GetRestResource(instanaAPI).Create(createRequest)
+	createdObject, err := r.resourceHandle.GetRestResource(instanaAPI).Create(ctx, createRequest)
 	if err != nil {
+		r.keepIDOfPartiallyCreatedObject(d, createRequest, createdObject)
 		return diag.FromErr(err)
 	}
 	err = r.resourceHandle.UpdateState(d, createdObject)
@@ -87,15 +103,29 @@ func (r *terraformResourceImpl[T]) Create(_ context.Context, d *schema.ResourceD
 	return nil
 }
 
+// keepIDOfPartiallyCreatedObject stores the ID assigned by the Instana API when the create operation failed after the
+// object was created, e.g. when a subsequent request failed. Terraform keeps the object as tainted in this case and
+// replaces it with the next apply instead of leaking it.
+func (r *terraformResourceImpl[T]) keepIDOfPartiallyCreatedObject(d *schema.ResourceData, createRequest T, createdObject T) {
+	value := reflect.ValueOf(createdObject)
+	if !value.IsValid() || (value.Kind() == reflect.Ptr && value.IsNil()) || len(d.Id()) > 0 {
+		return
+	}
+	id := createdObject.GetIDForResourcePath()
+	if len(id) > 0 && id != createRequest.GetIDForResourcePath() {
+		d.SetId(id)
+	}
+}
+
 // Read defines the read operation for the terraform resource
-func (r *terraformResourceImpl[T]) Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (r *terraformResourceImpl[T]) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 	resourceID := r.getResourceID(d)
 	if len(resourceID) == 0 {
 		return diag.FromErr(fmt.Errorf("resource ID of %s is missing", r.resourceHandle.MetaData().ResourceName))
 	}
-	obj, err := r.resourceHandle.GetRestResource(instanaAPI).GetOne(resourceID)
+	obj, err := r.readFromAPI(ctx, d, instanaAPI, resourceID)
 	if err != nil {
 		if errors.Is(err, restapi.ErrEntityNotFound) {
 			d.SetId("")
@@ -110,6 +140,13 @@ func (r *terraformResourceImpl[T]) Read(_ context.Context, d *schema.ResourceDat
 	return nil
 }
 
+func (r *terraformResourceImpl[T]) readFromAPI(ctx context.Context, d *schema.ResourceData, instanaAPI restapi.InstanaAPI, resourceID string) (T, error) {
+	if stateAwareHandle, ok := r.resourceHandle.(StateAwareResourceHandle[T]); ok {
+		return stateAwareHandle.ReadFromAPI(ctx, d, instanaAPI, resourceID)
+	}
+	return r.resourceHandle.GetRestResource(instanaAPI).GetOne(ctx, resourceID)
+}
+
 func (r *terraformResourceImpl[T]) getResourceID(d *schema.ResourceData) string {
 	if r.resourceHandle.MetaData().ResourceIDField != nil {
 		return d.Get(*r.resourceHandle.MetaData().ResourceIDField).(string)
@@ -118,7 +155,7 @@ func (r *terraformResourceImpl[T]) getResourceID(d *schema.ResourceData) string
 }
 
 // Update defines the update operation for the terraform resource
-func (r *terraformResourceImpl[T]) Update(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (r *terraformResourceImpl[T]) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 
@@ -126,7 +163,7 @@ func (r *terraformResourceImpl[T]) Update(_ context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	updatedObject, err := r.resourceHandle.GetRestResource(instanaAPI).Update(obj)
+	updatedObject, err := r.resourceHandle.GetRestResource(instanaAPI).Update(ctx, obj)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -137,13 +174,23 @@ func (r *terraformResourceImpl[T]) Update(_ context.Context, d *schema.ResourceD
 	return nil
 }
 
+func allFieldsForceNew(schemaMap map[string]*schema.Schema) bool {
+	for _, fieldSchema := range schemaMap {
+		//computed only fields cannot be changed by the user and therefore do not require an update operation
+		if !fieldSchema.ForceNew && (fieldSchema.Optional || fieldSchema.Required) {
+			return false
+		}
+	}
+	return true
+}
+
 // NoUpdateSupported defines the update operation for the terraform resource not supporting update operations
 func (r *terraformResourceImpl[T]) NoUpdateSupported(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return diag.FromErr(fmt.Errorf("update operations not supported for %s resources", r.resourceHandle.MetaData().ResourceName))
 }
 
 // Delete defines the delete operation for the terraform resource
-func (r *terraformResourceImpl[T]) Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (r *terraformResourceImpl[T]) Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 
@@ -151,7 +198,7 @@ func (r *terraformResourceImpl[T]) Delete(_ context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = r.resourceHandle.GetRestResource(instanaAPI).DeleteByID(object.GetIDForResourcePath())
+	err = r.resourceHandle.GetRestResource(instanaAPI).Delete(ctx, object)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -162,8 +209,11 @@ func (r *terraformResourceImpl[T]) Delete(_ context.Context, d *schema.ResourceD
 func (r *terraformResourceImpl[T]) ToSchemaResource() *schema.Resource {
 	metaData := r.resourceHandle.MetaData()
 	var updateOperation schema.UpdateContextFunc
-	if r.resourceHandle.MetaData().CreateOnly {
-		updateOperation = r.NoUpdateSupported
+	if metaData.CreateOnly {
+		//terraform rejects update operations when every field forces a new resource
+		if !allFieldsForceNew(metaData.Schema) {
+			updateOperation = r.NoUpdateSupported
+		}
 	} else {
 		updateOperation = r.Update
 	}
@@ -179,6 +229,13 @@ func (r *terraformResourceImpl[T]) ToSchemaResource() *schema.Resource {
 		SchemaVersion:      metaData.SchemaVersion,
 		StateUpgraders:     r.resourceHandle.StateUpgraders(),
 		DeprecationMessage: metaData.DeprecationMessage,
+		CustomizeDiff:      metaData.CustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultResourceOperationTimeout),
+			Read:   schema.DefaultTimeout(DefaultResourceOperationTimeout),
+			Update: schema.DefaultTimeout(DefaultResourceOperationTimeout),
+			Delete: schema.DefaultTimeout(DefaultResourceOperationTimeout),
+		},
 	}
 }
 
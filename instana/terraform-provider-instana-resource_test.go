@@ -3,11 +3,16 @@ package instana_test
 import (
 	"context"
 	"errors"
+	"net/http"
+	"regexp"
 	"testing"
+	"time"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -24,10 +29,50 @@ func TestTerraformProviderInstanaResource(t *testing.T) {
 	t.Run("should fail to read test object from instana API and return error code when API call fails", ut.shouldFailToReadTestObjectFromInstanaAPIAndReturnErrorWhenAPICallFails)
 	t.Run("should create test object through Instana API", ut.shouldCreateTestObjectThroughInstanaAPI)
 	t.Run("should return error when create test object fails through Instana API", ut.shouldReturnErrorWhenCreateTestObjectFailsThroughInstanaAPI)
+	t.Run("should keep id of partially created object when create fails through Instana API", ut.shouldKeepIDOfPartiallyCreatedObjectWhenCreateFailsThroughInstanaAPI)
 	t.Run("should update test object through Instana API", ut.shouldUpdateTestObjectThroughInstanaAPI)
 	t.Run("should return error when update test object fails through Instana API", ut.shouldReturnErrorWhenUpdateTestObjectFailsThroughInstanaAPI)
 	t.Run("should delete test object through Instana API", ut.shouldDeleteTestObjectThroughInstanaAPI)
 	t.Run("should return error when delete test object fails through Instana API", ut.shouldReturnErrorWhenDeleteTestObjectFailsThroughInstanaAPI)
+	t.Run("should pass context of terraform operations to Instana API", ut.shouldPassContextOfTerraformOperationsToInstanaAPI)
+	t.Run("should support timeouts for all operations", ut.shouldSupportTimeoutsForAllOperations)
+	t.Run("should reject updates of create only resources", ut.shouldRejectUpdatesOfCreateOnlyResources)
+	t.Run("should not define update operation for create only resources when all fields force new resource", ut.shouldNotDefineUpdateOperationForCreateOnlyResourcesWhenAllFieldsForceNewResource)
+	t.Run("should define customize diff when resource specific customize diff is provided", ut.shouldDefineCustomizeDiffWhenResourceSpecificCustomizeDiffIsProvided)
+}
+
+const resourceWithCreateTimeoutDefinition = `
+resource "instana_website_monitoring_config" "example" {
+	name = "name"
+
+	timeouts {
+		create = "1s"
+	}
+}
+`
+
+func TestShouldAbortCreateOperationWhenTimeoutOfResourceIsExceededWithMockServer(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPost, restapi.WebsiteMonitoringConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(10 * time.Second):
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	httpServer.AddRoute(http.MethodDelete, restapi.WebsiteMonitoringConfigResourcePath+"/{id}", testutils.EchoHandlerFunc)
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      appendProviderConfig(resourceWithCreateTimeoutDefinition, httpServer.GetPort()),
+				ExpectError: regexp.MustCompile("context deadline exceeded"),
+			},
+		},
+	})
 }
 
 type terraformProviderInstanaResourceUnitTest struct{}
@@ -41,7 +86,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldSuccessfullyReadTestObj
 		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().GetOne(gomock.Eq(alertingChannelEmailID)).Return(expectedModel, nil).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Any(), gomock.Eq(alertingChannelEmailID)).Return(expectedModel, nil).Times(1)
 
 		resourceHandle := NewAlertingChannelResourceHandle()
 		diag := NewTerraformResource(resourceHandle).Read(context.TODO(), resourceData, providerMeta)
@@ -73,7 +118,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldFailToReadTestObjectFro
 		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().GetOne(gomock.Eq(alertingChannelEmailID)).Return(&restapi.AlertingChannel{}, restapi.ErrEntityNotFound).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Any(), gomock.Eq(alertingChannelEmailID)).Return(&restapi.AlertingChannel{}, restapi.ErrEntityNotFound).Times(1)
 
 		resourceHandle := NewAlertingChannelResourceHandle()
 		diag := NewTerraformResource(resourceHandle).Read(context.TODO(), resourceData, providerMeta)
@@ -92,7 +137,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldFailToReadTestObjectFro
 		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().GetOne(gomock.Eq(alertingChannelEmailID)).Return(&restapi.AlertingChannel{}, expectedError).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Any(), gomock.Eq(alertingChannelEmailID)).Return(&restapi.AlertingChannel{}, expectedError).Times(1)
 
 		resourceHandle := NewAlertingChannelResourceHandle()
 		diag := NewTerraformResource(resourceHandle).Read(context.TODO(), resourceData, providerMeta)
@@ -113,7 +158,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldCreateTestObjectThrough
 		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().Create(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(expectedModel, nil).Times(1)
+		mockTestObjectApi.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(expectedModel, nil).Times(1)
 
 		resourceHandle := NewAlertingChannelResourceHandle()
 		diag := NewTerraformResource(resourceHandle).Create(context.TODO(), resourceData, providerMeta)
@@ -132,7 +177,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldReturnErrorWhenCreateTe
 		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().Create(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(&restapi.AlertingChannel{}, expectedError).Times(1)
+		mockTestObjectApi.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(&restapi.AlertingChannel{}, expectedError).Times(1)
 
 		resourceHandle := NewAlertingChannelResourceHandle()
 		diag := NewTerraformResource(resourceHandle).Create(context.TODO(), resourceData, providerMeta)
@@ -143,6 +188,26 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldReturnErrorWhenCreateTe
 	})
 }
 
+func (r *terraformProviderInstanaResourceUnitTest) shouldKeepIDOfPartiallyCreatedObjectWhenCreateFailsThroughInstanaAPI(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.MobileAppConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewMobileAppConfigResourceHandle()
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+		setValueOnResourceData(t, resourceData, MobileAppConfigFieldName, "name")
+		expectedError := errors.New("test")
+		mockTestObjectApi := mocks.NewMockRestResource[*restapi.MobileAppConfig](ctrl)
+
+		mockInstanaAPI.EXPECT().MobileAppConfigs().Return(mockTestObjectApi).Times(1)
+		mockTestObjectApi.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&restapi.MobileAppConfig{})).Return(&restapi.MobileAppConfig{ID: "created-id", Name: "name"}, expectedError).Times(1)
+
+		diag := NewTerraformResource(resourceHandle).Create(context.TODO(), resourceData, providerMeta)
+
+		assert.NotNil(t, diag)
+		assert.True(t, diag.HasError())
+		assert.Equal(t, "created-id", resourceData.Id())
+	})
+}
+
 func (r *terraformProviderInstanaResourceUnitTest) shouldUpdateTestObjectThroughInstanaAPI(t *testing.T) {
 	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
 	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
@@ -152,7 +217,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldUpdateTestObjectThrough
 		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().Update(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(expectedModel, nil).Times(1)
+		mockTestObjectApi.EXPECT().Update(gomock.Any(), gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(expectedModel, nil).Times(1)
 
 		resourceHandle := NewAlertingChannelResourceHandle()
 		diag := NewTerraformResource(resourceHandle).Update(context.TODO(), resourceData, providerMeta)
@@ -171,7 +236,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldReturnErrorWhenUpdateTe
 		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().Update(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(&restapi.AlertingChannel{}, expectedError).Times(1)
+		mockTestObjectApi.EXPECT().Update(gomock.Any(), gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(&restapi.AlertingChannel{}, expectedError).Times(1)
 
 		resourceHandle := NewAlertingChannelResourceHandle()
 		diag := NewTerraformResource(resourceHandle).Update(context.TODO(), resourceData, providerMeta)
@@ -192,7 +257,10 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldDeleteTestObjectThrough
 		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().DeleteByID(gomock.Eq(id)).Return(nil).Times(1)
+		mockTestObjectApi.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, object *restapi.AlertingChannel) error {
+			assert.Equal(t, id, object.ID)
+			return nil
+		}).Times(1)
 
 		resourceHandle := NewAlertingChannelResourceHandle()
 		diag := NewTerraformResource(resourceHandle).Delete(context.TODO(), resourceData, providerMeta)
@@ -213,7 +281,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldReturnErrorWhenDeleteTe
 		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().DeleteByID(gomock.Eq(id)).Return(expectedError).Times(1)
+		mockTestObjectApi.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(expectedError).Times(1)
 
 		resourceHandle := NewAlertingChannelResourceHandle()
 		diag := NewTerraformResource(resourceHandle).Delete(context.TODO(), resourceData, providerMeta)
@@ -225,6 +293,61 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldReturnErrorWhenDeleteTe
 	})
 }
 
+type testContextKey string
+
+func (r *terraformProviderInstanaResourceUnitTest) shouldPassContextOfTerraformOperationsToInstanaAPI(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		ctx := context.WithValue(context.Background(), testContextKey("operation"), "test")
+		data := r.createTestAlertingChannelEmailData()
+		resourceData := r.createAlertingChannelResourceData(data, t)
+		expectedModel := r.createTestAlertingChannelEmailObject()
+		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(4)
+		mockTestObjectApi.EXPECT().Create(gomock.Eq(ctx), gomock.Any()).Return(expectedModel, nil).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Eq(ctx), gomock.Any()).Return(expectedModel, nil).Times(1)
+		mockTestObjectApi.EXPECT().Update(gomock.Eq(ctx), gomock.Any()).Return(expectedModel, nil).Times(1)
+		mockTestObjectApi.EXPECT().Delete(gomock.Eq(ctx), gomock.Any()).Return(nil).Times(1)
+
+		sut := NewTerraformResource(NewAlertingChannelResourceHandle())
+
+		assert.Nil(t, sut.Create(ctx, resourceData, providerMeta))
+		assert.Nil(t, sut.Read(ctx, resourceData, providerMeta))
+		assert.Nil(t, sut.Update(ctx, resourceData, providerMeta))
+		assert.Nil(t, sut.Delete(ctx, resourceData, providerMeta))
+	})
+}
+
+func (r *terraformProviderInstanaResourceUnitTest) shouldSupportTimeoutsForAllOperations(t *testing.T) {
+	schemaResource := NewTerraformResource(NewAlertingChannelResourceHandle()).ToSchemaResource()
+
+	assert.NotNil(t, schemaResource.Timeouts)
+	assert.Equal(t, DefaultResourceOperationTimeout, *schemaResource.Timeouts.Create)
+	assert.Equal(t, DefaultResourceOperationTimeout, *schemaResource.Timeouts.Read)
+	assert.Equal(t, DefaultResourceOperationTimeout, *schemaResource.Timeouts.Update)
+	assert.Equal(t, DefaultResourceOperationTimeout, *schemaResource.Timeouts.Delete)
+}
+
+func (r *terraformProviderInstanaResourceUnitTest) shouldRejectUpdatesOfCreateOnlyResources(t *testing.T) {
+	schemaResource := NewTerraformResource(NewSliConfigResourceHandle()).ToSchemaResource()
+
+	assert.NotNil(t, schemaResource.UpdateContext)
+	assert.True(t, schemaResource.UpdateContext(context.Background(), nil, nil).HasError())
+}
+
+func (r *terraformProviderInstanaResourceUnitTest) shouldNotDefineUpdateOperationForCreateOnlyResourcesWhenAllFieldsForceNewResource(t *testing.T) {
+	schemaResource := NewTerraformResource(NewWebsiteSourceMapUploadResourceHandle()).ToSchemaResource()
+
+	assert.Nil(t, schemaResource.UpdateContext)
+	assert.Nil(t, NewTerraformResource(NewUserInvitationResourceHandle()).ToSchemaResource().UpdateContext)
+}
+
+func (r *terraformProviderInstanaResourceUnitTest) shouldDefineCustomizeDiffWhenResourceSpecificCustomizeDiffIsProvided(t *testing.T) {
+	assert.Nil(t, NewTerraformResource(NewAlertingChannelResourceHandle()).ToSchemaResource().CustomizeDiff)
+	assert.NotNil(t, NewTerraformResource(NewGlobalCustomPayloadConfigurationResourceHandle()).ToSchemaResource().CustomizeDiff)
+}
+
 func (r *terraformProviderInstanaResourceUnitTest) verifyTestObjectModelAppliedToResource(model *restapi.AlertingChannel, resourceData *schema.ResourceData, t *testing.T) {
 	assert.Equal(t, model.ID, resourceData.Id())
 	assert.Equal(t, resourceNameWithoutPrefixAndSuffix, resourceData.Get(AlertingChannelFieldName))
@@ -0,0 +1,200 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newAlertRuleMapper creates a new alertRuleMapper for the rule field with the given name. The alert types map the
+// name of the nested block of a rule type to the alert type of the Instana API. Rule types which are not contained use
+// the same name in terraform and the Instana API.
+func newAlertRuleMapper(fieldName string, alertTypes map[string]string) *alertRuleMapper {
+	return &alertRuleMapper{fieldName: fieldName, alertTypes: alertTypes}
+}
+
+// alertRuleMapper maps the rule of website and mobile app alert configs between the terraform state and the API model.
+// The terraform state contains a nested block per rule type while the API model identifies the rule by its alert type.
+type alertRuleMapper struct {
+	fieldName  string
+	alertTypes map[string]string
+}
+
+// toState returns the state of the rule field for the given alert type and the attributes of the rule
+func (m *alertRuleMapper) toState(alertType string, attributes map[string]interface{}) []map[string]interface{} {
+	ruleType := alertType
+	for schemaType, apiType := range m.alertTypes {
+		if apiType == alertType {
+			ruleType = schemaType
+		}
+	}
+	return []map[string]interface{}{
+		{ruleType: []interface{}{attributes}},
+	}
+}
+
+// fromState returns the alert type and the attributes of the configured rule. False is returned when no rule is
+// configured.
+func (m *alertRuleMapper) fromState(d *schema.ResourceData) (string, map[string]interface{}, bool) {
+	ruleSlice := d.Get(m.fieldName).([]interface{})
+	if len(ruleSlice) != 1 || ruleSlice[0] == nil {
+		return "", nil, false
+	}
+	rule := ruleSlice[0].(map[string]interface{})
+	for ruleType, v := range rule {
+		configSlice := v.([]interface{})
+		if len(configSlice) == 1 {
+			alertType := ruleType
+			if apiType, ok := m.alertTypes[ruleType]; ok {
+				alertType = apiType
+			}
+			return alertType, configSlice[0].(map[string]interface{}), true
+		}
+	}
+	return "", nil, false
+}
+
+// alertRuleAttributes the attributes which are shared by the rules of website and mobile app alert configs
+type alertRuleAttributes struct {
+	MetricName  string
+	Aggregation *restapi.Aggregation
+	Operator    *restapi.ExpressionOperator
+	Value       *string
+}
+
+func (a alertRuleAttributes) toState() map[string]interface{} {
+	result := map[string]interface{}{
+		WebsiteAlertConfigFieldRuleMetricName: a.MetricName,
+	}
+	if a.Aggregation != nil {
+		result[WebsiteAlertConfigFieldRuleAggregation] = string(*a.Aggregation)
+	}
+	if a.Operator != nil {
+		result[WebsiteAlertConfigFieldRuleOperator] = string(*a.Operator)
+	}
+	if a.Value != nil {
+		result[WebsiteAlertConfigFieldRuleValue] = *a.Value
+	}
+	return result
+}
+
+// mapAlertRuleAttributesFromState maps the shared attributes of a mobile app alert rule from the state. Attributes which are not set
+// are mapped to nil as the state contains empty strings for optional attributes which are not configured.
+func mapAlertRuleAttributesFromState(config map[string]interface{}) alertRuleAttributes {
+	attributes := alertRuleAttributes{
+		MetricName: config[WebsiteAlertConfigFieldRuleMetricName].(string),
+		Value:      mapOptionalAlertRuleAttributeFromState(config, WebsiteAlertConfigFieldRuleValue),
+	}
+	if aggregation := mapOptionalAlertRuleAttributeFromState(config, WebsiteAlertConfigFieldRuleAggregation); aggregation != nil {
+		value := restapi.Aggregation(*aggregation)
+		attributes.Aggregation = &value
+	}
+	if operator := mapOptionalAlertRuleAttributeFromState(config, WebsiteAlertConfigFieldRuleOperator); operator != nil {
+		value := restapi.ExpressionOperator(*operator)
+		attributes.Operator = &value
+	}
+	return attributes
+}
+
+func mapOptionalAlertRuleAttributeFromState(config map[string]interface{}, key string) *string {
+	if v, ok := config[key]; ok && v.(string) != "" {
+		value := v.(string)
+		return &value
+	}
+	return nil
+}
+
+// mapWebsiteTimeThresholdToState maps the time threshold of website and mobile app alert configs to the state
+func mapWebsiteTimeThresholdToState(input *restapi.WebsiteTimeThreshold) []map[string]interface{} {
+	timeThresholdConfig := make(map[string]interface{})
+
+	if input.TimeWindow != nil {
+		timeThresholdConfig[WebsiteAlertConfigFieldTimeThresholdTimeWindow] = input.TimeWindow
+	}
+	if input.Violations != nil {
+		timeThresholdConfig[WebsiteAlertConfigFieldTimeThresholdViolationsInPeriodViolations] = int(*input.Violations)
+	}
+	if input.ImpactMeasurementMethod != nil {
+		timeThresholdConfig[WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceImpactMeasurementMethod] = string(*input.ImpactMeasurementMethod)
+	}
+	if input.Users != nil {
+		timeThresholdConfig[WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUsers] = int(*input.Users)
+	}
+	if input.UserPercentage != nil {
+		timeThresholdConfig[WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUserPercentage] = *input.UserPercentage
+	}
+
+	timeThresholdType := mapWebsiteTimeThresholdTypeToSchema(input.Type)
+	timeThreshold := make(map[string]interface{})
+	timeThreshold[timeThresholdType] = []interface{}{timeThresholdConfig}
+	result := make([]map[string]interface{}, 1)
+	result[0] = timeThreshold
+	return result
+}
+
+func mapWebsiteTimeThresholdTypeToSchema(input string) string {
+	if input == "userImpactOfViolationsInSequence" {
+		return WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence
+	} else if input == "violationsInPeriod" {
+		return WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod
+	} else if input == "violationsInSequence" {
+		return WebsiteAlertConfigFieldTimeThresholdViolationsInSequence
+	}
+	return input
+}
+
+// mapWebsiteTimeThresholdFromState maps the time threshold of website and mobile app alert configs from the state
+func mapWebsiteTimeThresholdFromState(d *schema.ResourceData, fieldName string) *restapi.WebsiteTimeThreshold {
+	timeThresholdSlice := d.Get(fieldName).([]interface{})
+	timeThreshold := timeThresholdSlice[0].(map[string]interface{})
+	for timeThresholdType, v := range timeThreshold {
+		configSlice := v.([]interface{})
+		if len(configSlice) == 1 {
+			config := configSlice[0].(map[string]interface{})
+			var timeWindowPtr *int64
+			if v, ok := config[WebsiteAlertConfigFieldTimeThresholdTimeWindow]; ok {
+				timeWindow := int64(v.(int))
+				timeWindowPtr = &timeWindow
+			}
+			var violationsPtr *int32
+			if v, ok := config[WebsiteAlertConfigFieldTimeThresholdViolationsInPeriodViolations]; ok {
+				violations := int32(v.(int))
+				violationsPtr = &violations
+			}
+			var impactMeasurementMethodPtr *restapi.WebsiteImpactMeasurementMethod
+			if v, ok := config[WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceImpactMeasurementMethod]; ok {
+				impactMeasurementMethod := restapi.WebsiteImpactMeasurementMethod(v.(string))
+				impactMeasurementMethodPtr = &impactMeasurementMethod
+			}
+			var userPercentagePtr *float64
+			if v, ok := config[WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUserPercentage]; ok {
+				userPercentage := v.(float64)
+				userPercentagePtr = &userPercentage
+			}
+			var usersPtr *int32
+			if v, ok := config[WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUsers]; ok {
+				users := int32(v.(int))
+				usersPtr = &users
+			}
+			return &restapi.WebsiteTimeThreshold{
+				Type:                    mapWebsiteTimeThresholdTypeFromSchema(timeThresholdType),
+				TimeWindow:              timeWindowPtr,
+				Violations:              violationsPtr,
+				ImpactMeasurementMethod: impactMeasurementMethodPtr,
+				UserPercentage:          userPercentagePtr,
+				Users:                   usersPtr,
+			}
+		}
+	}
+	return &restapi.WebsiteTimeThreshold{}
+}
+
+func mapWebsiteTimeThresholdTypeFromSchema(input string) string {
+	if input == WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence {
+		return "userImpactOfViolationsInSequence"
+	} else if input == WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod {
+		return "violationsInPeriod"
+	} else if input == WebsiteAlertConfigFieldTimeThresholdViolationsInSequence {
+		return "violationsInSequence"
+	}
+	return input
+}
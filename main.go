@@ -7,10 +7,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
+// version the version of the provider which is injected at build time via -ldflags "-X main.version=<version>"
+var version = "dev"
+
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: func() *schema.Provider {
-			return instana.Provider()
+			return instana.Provider(version)
 		},
 	})
 }
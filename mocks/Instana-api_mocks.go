@@ -1,10 +1,16 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: instana/restapi/Instana-api.go
+//
+// Generated by this command:
+//
+//	mockgen -source=instana/restapi/Instana-api.go -destination=mocks/Instana-api_mocks.go -package=mocks
+//
 
 // Package mocks is a generated GoMock package.
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	restapi "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
@@ -76,6 +82,34 @@ func (mr *MockInstanaAPIMockRecorder) AlertingConfigurations() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertingConfigurations", reflect.TypeOf((*MockInstanaAPI)(nil).AlertingConfigurations))
 }
 
+// ApdexConfigs mocks base method.
+func (m *MockInstanaAPI) ApdexConfigs() restapi.RestResource[*restapi.ApdexConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApdexConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.ApdexConfig])
+	return ret0
+}
+
+// ApdexConfigs indicates an expected call of ApdexConfigs.
+func (mr *MockInstanaAPIMockRecorder) ApdexConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApdexConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ApdexConfigs))
+}
+
+// ApdexReports mocks base method.
+func (m *MockInstanaAPI) ApdexReports(apdexID string) restapi.ReadOnlyRestResource[*restapi.ApdexReport] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApdexReports", apdexID)
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.ApdexReport])
+	return ret0
+}
+
+// ApdexReports indicates an expected call of ApdexReports.
+func (mr *MockInstanaAPIMockRecorder) ApdexReports(apdexID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApdexReports", reflect.TypeOf((*MockInstanaAPI)(nil).ApdexReports), apdexID)
+}
+
 // ApplicationAlertConfigs mocks base method.
 func (m *MockInstanaAPI) ApplicationAlertConfigs() restapi.RestResource[*restapi.ApplicationAlertConfig] {
 	m.ctrl.T.Helper()
@@ -104,6 +138,34 @@ func (mr *MockInstanaAPIMockRecorder) ApplicationConfigs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ApplicationConfigs))
 }
 
+// AutomationActions mocks base method.
+func (m *MockInstanaAPI) AutomationActions() restapi.RestResource[*restapi.AutomationAction] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutomationActions")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.AutomationAction])
+	return ret0
+}
+
+// AutomationActions indicates an expected call of AutomationActions.
+func (mr *MockInstanaAPIMockRecorder) AutomationActions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutomationActions", reflect.TypeOf((*MockInstanaAPI)(nil).AutomationActions))
+}
+
+// AutomationPolicies mocks base method.
+func (m *MockInstanaAPI) AutomationPolicies() restapi.RestResource[*restapi.AutomationPolicy] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutomationPolicies")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.AutomationPolicy])
+	return ret0
+}
+
+// AutomationPolicies indicates an expected call of AutomationPolicies.
+func (mr *MockInstanaAPIMockRecorder) AutomationPolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutomationPolicies", reflect.TypeOf((*MockInstanaAPI)(nil).AutomationPolicies))
+}
+
 // BuiltinEventSpecifications mocks base method.
 func (m *MockInstanaAPI) BuiltinEventSpecifications() restapi.ReadOnlyRestResource[*restapi.BuiltinEventSpecification] {
 	m.ctrl.T.Helper()
@@ -146,6 +208,21 @@ func (mr *MockInstanaAPIMockRecorder) CustomEventSpecifications() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomEventSpecifications", reflect.TypeOf((*MockInstanaAPI)(nil).CustomEventSpecifications))
 }
 
+// CustomPayloadTagCatalog mocks base method.
+func (m *MockInstanaAPI) CustomPayloadTagCatalog(ctx context.Context) (*restapi.CustomPayloadTagCatalog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomPayloadTagCatalog", ctx)
+	ret0, _ := ret[0].(*restapi.CustomPayloadTagCatalog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CustomPayloadTagCatalog indicates an expected call of CustomPayloadTagCatalog.
+func (mr *MockInstanaAPIMockRecorder) CustomPayloadTagCatalog(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomPayloadTagCatalog", reflect.TypeOf((*MockInstanaAPI)(nil).CustomPayloadTagCatalog), ctx)
+}
+
 // GlobalApplicationAlertConfigs mocks base method.
 func (m *MockInstanaAPI) GlobalApplicationAlertConfigs() restapi.RestResource[*restapi.ApplicationAlertConfig] {
 	m.ctrl.T.Helper()
@@ -160,6 +237,76 @@ func (mr *MockInstanaAPIMockRecorder) GlobalApplicationAlertConfigs() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GlobalApplicationAlertConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).GlobalApplicationAlertConfigs))
 }
 
+// GlobalCustomPayloadConfiguration mocks base method.
+func (m *MockInstanaAPI) GlobalCustomPayloadConfiguration() restapi.RestResource[*restapi.GlobalCustomPayloadConfiguration] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GlobalCustomPayloadConfiguration")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.GlobalCustomPayloadConfiguration])
+	return ret0
+}
+
+// GlobalCustomPayloadConfiguration indicates an expected call of GlobalCustomPayloadConfiguration.
+func (mr *MockInstanaAPIMockRecorder) GlobalCustomPayloadConfiguration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GlobalCustomPayloadConfiguration", reflect.TypeOf((*MockInstanaAPI)(nil).GlobalCustomPayloadConfiguration))
+}
+
+// GlobalSyntheticAlertConfigs mocks base method.
+func (m *MockInstanaAPI) GlobalSyntheticAlertConfigs() restapi.RestResource[*restapi.SyntheticAlertConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GlobalSyntheticAlertConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.SyntheticAlertConfig])
+	return ret0
+}
+
+// GlobalSyntheticAlertConfigs indicates an expected call of GlobalSyntheticAlertConfigs.
+func (mr *MockInstanaAPIMockRecorder) GlobalSyntheticAlertConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GlobalSyntheticAlertConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).GlobalSyntheticAlertConfigs))
+}
+
+// GroupMappingSettings mocks base method.
+func (m *MockInstanaAPI) GroupMappingSettings() restapi.RestResource[*restapi.GroupMappingSettings] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupMappingSettings")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.GroupMappingSettings])
+	return ret0
+}
+
+// GroupMappingSettings indicates an expected call of GroupMappingSettings.
+func (mr *MockInstanaAPIMockRecorder) GroupMappingSettings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupMappingSettings", reflect.TypeOf((*MockInstanaAPI)(nil).GroupMappingSettings))
+}
+
+// GroupMappings mocks base method.
+func (m *MockInstanaAPI) GroupMappings() restapi.RestResource[*restapi.GroupMapping] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupMappings")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.GroupMapping])
+	return ret0
+}
+
+// GroupMappings indicates an expected call of GroupMappings.
+func (mr *MockInstanaAPIMockRecorder) GroupMappings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupMappings", reflect.TypeOf((*MockInstanaAPI)(nil).GroupMappings))
+}
+
+// GroupMemberships mocks base method.
+func (m *MockInstanaAPI) GroupMemberships() restapi.RestResource[*restapi.GroupMembership] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupMemberships")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.GroupMembership])
+	return ret0
+}
+
+// GroupMemberships indicates an expected call of GroupMemberships.
+func (mr *MockInstanaAPIMockRecorder) GroupMemberships() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupMemberships", reflect.TypeOf((*MockInstanaAPI)(nil).GroupMemberships))
+}
+
 // Groups mocks base method.
 func (m *MockInstanaAPI) Groups() restapi.RestResource[*restapi.Group] {
 	m.ctrl.T.Helper()
@@ -174,6 +321,161 @@ func (mr *MockInstanaAPIMockRecorder) Groups() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Groups", reflect.TypeOf((*MockInstanaAPI)(nil).Groups))
 }
 
+// HTTPEndpointConfigs mocks base method.
+func (m *MockInstanaAPI) HTTPEndpointConfigs() restapi.RestResource[*restapi.HTTPEndpointConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HTTPEndpointConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.HTTPEndpointConfig])
+	return ret0
+}
+
+// HTTPEndpointConfigs indicates an expected call of HTTPEndpointConfigs.
+func (mr *MockInstanaAPIMockRecorder) HTTPEndpointConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HTTPEndpointConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).HTTPEndpointConfigs))
+}
+
+// Health mocks base method.
+func (m *MockInstanaAPI) Health(ctx context.Context) (*restapi.HealthState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Health", ctx)
+	ret0, _ := ret[0].(*restapi.HealthState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Health indicates an expected call of Health.
+func (mr *MockInstanaAPIMockRecorder) Health(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockInstanaAPI)(nil).Health), ctx)
+}
+
+// HostAgents mocks base method.
+func (m *MockInstanaAPI) HostAgents() restapi.ReadOnlyRestResource[*restapi.HostAgent] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HostAgents")
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.HostAgent])
+	return ret0
+}
+
+// HostAgents indicates an expected call of HostAgents.
+func (mr *MockInstanaAPIMockRecorder) HostAgents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostAgents", reflect.TypeOf((*MockInstanaAPI)(nil).HostAgents))
+}
+
+// InfraAlertConfig mocks base method.
+func (m *MockInstanaAPI) InfraAlertConfig() restapi.RestResource[*restapi.InfraAlertConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InfraAlertConfig")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.InfraAlertConfig])
+	return ret0
+}
+
+// InfraAlertConfig indicates an expected call of InfraAlertConfig.
+func (mr *MockInstanaAPIMockRecorder) InfraAlertConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InfraAlertConfig", reflect.TypeOf((*MockInstanaAPI)(nil).InfraAlertConfig))
+}
+
+// MaintenanceWindowConfigs mocks base method.
+func (m *MockInstanaAPI) MaintenanceWindowConfigs() restapi.RestResource[*restapi.MaintenanceWindowConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaintenanceWindowConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.MaintenanceWindowConfig])
+	return ret0
+}
+
+// MaintenanceWindowConfigs indicates an expected call of MaintenanceWindowConfigs.
+func (mr *MockInstanaAPIMockRecorder) MaintenanceWindowConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaintenanceWindowConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).MaintenanceWindowConfigs))
+}
+
+// ManualServiceConfigs mocks base method.
+func (m *MockInstanaAPI) ManualServiceConfigs() restapi.RestResource[*restapi.ManualServiceConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManualServiceConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.ManualServiceConfig])
+	return ret0
+}
+
+// ManualServiceConfigs indicates an expected call of ManualServiceConfigs.
+func (mr *MockInstanaAPIMockRecorder) ManualServiceConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManualServiceConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ManualServiceConfigs))
+}
+
+// MobileAppAlertConfigs mocks base method.
+func (m *MockInstanaAPI) MobileAppAlertConfigs() restapi.RestResource[*restapi.MobileAppAlertConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MobileAppAlertConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.MobileAppAlertConfig])
+	return ret0
+}
+
+// MobileAppAlertConfigs indicates an expected call of MobileAppAlertConfigs.
+func (mr *MockInstanaAPIMockRecorder) MobileAppAlertConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MobileAppAlertConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).MobileAppAlertConfigs))
+}
+
+// MobileAppConfigs mocks base method.
+func (m *MockInstanaAPI) MobileAppConfigs() restapi.RestResource[*restapi.MobileAppConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MobileAppConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.MobileAppConfig])
+	return ret0
+}
+
+// MobileAppConfigs indicates an expected call of MobileAppConfigs.
+func (mr *MockInstanaAPIMockRecorder) MobileAppConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MobileAppConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).MobileAppConfigs))
+}
+
+// Releases mocks base method.
+func (m *MockInstanaAPI) Releases() restapi.RestResource[*restapi.Release] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Releases")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.Release])
+	return ret0
+}
+
+// Releases indicates an expected call of Releases.
+func (mr *MockInstanaAPIMockRecorder) Releases() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Releases", reflect.TypeOf((*MockInstanaAPI)(nil).Releases))
+}
+
+// ServiceConfigs mocks base method.
+func (m *MockInstanaAPI) ServiceConfigs() restapi.RestResource[*restapi.ServiceConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.ServiceConfig])
+	return ret0
+}
+
+// ServiceConfigs indicates an expected call of ServiceConfigs.
+func (mr *MockInstanaAPIMockRecorder) ServiceConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ServiceConfigs))
+}
+
+// SessionSettings mocks base method.
+func (m *MockInstanaAPI) SessionSettings() restapi.RestResource[*restapi.SessionSettings] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SessionSettings")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.SessionSettings])
+	return ret0
+}
+
+// SessionSettings indicates an expected call of SessionSettings.
+func (mr *MockInstanaAPIMockRecorder) SessionSettings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionSettings", reflect.TypeOf((*MockInstanaAPI)(nil).SessionSettings))
+}
+
 // SliConfigs mocks base method.
 func (m *MockInstanaAPI) SliConfigs() restapi.RestResource[*restapi.SliConfig] {
 	m.ctrl.T.Helper()
@@ -188,6 +490,20 @@ func (mr *MockInstanaAPIMockRecorder) SliConfigs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SliConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).SliConfigs))
 }
 
+// SloAlertConfig mocks base method.
+func (m *MockInstanaAPI) SloAlertConfig() restapi.RestResource[*restapi.SloAlertConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SloAlertConfig")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.SloAlertConfig])
+	return ret0
+}
+
+// SloAlertConfig indicates an expected call of SloAlertConfig.
+func (mr *MockInstanaAPIMockRecorder) SloAlertConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SloAlertConfig", reflect.TypeOf((*MockInstanaAPI)(nil).SloAlertConfig))
+}
+
 // SloConfigs mocks base method.
 func (m *MockInstanaAPI) SloConfigs() restapi.RestResource[*restapi.SloConfig] {
 	m.ctrl.T.Helper()
@@ -202,32 +518,46 @@ func (mr *MockInstanaAPIMockRecorder) SloConfigs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SloConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).SloConfigs))
 }
 
-// SloAlertConfig mocks base method.
-func (m *MockInstanaAPI) SloAlertConfig() restapi.RestResource[*restapi.SloAlertConfig] {
+// SloCorrectionConfig mocks base method.
+func (m *MockInstanaAPI) SloCorrectionConfig() restapi.RestResource[*restapi.SloCorrectionConfig] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SloAlertConfig")
-	ret0, _ := ret[0].(restapi.RestResource[*restapi.SloAlertConfig])
+	ret := m.ctrl.Call(m, "SloCorrectionConfig")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.SloCorrectionConfig])
 	return ret0
 }
 
-// SloAlertConfig indicates an expected call of SloConfigs.
-func (mr *MockInstanaAPIMockRecorder) SloAlertConfig() *gomock.Call {
+// SloCorrectionConfig indicates an expected call of SloCorrectionConfig.
+func (mr *MockInstanaAPIMockRecorder) SloCorrectionConfig() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SloAlertConfig", reflect.TypeOf((*MockInstanaAPI)(nil).SloAlertConfig))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SloCorrectionConfig", reflect.TypeOf((*MockInstanaAPI)(nil).SloCorrectionConfig))
 }
 
-// SloCorrectionConfig mocks base method.
-func (m *MockInstanaAPI) SloCorrectionConfig() restapi.RestResource[*restapi.SloCorrectionConfig] {
+// SyntheticCallsSettings mocks base method.
+func (m *MockInstanaAPI) SyntheticCallsSettings() restapi.RestResource[*restapi.SyntheticCallsSettings] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SloCorrectionConfig")
-	ret0, _ := ret[0].(restapi.RestResource[*restapi.SloCorrectionConfig])
+	ret := m.ctrl.Call(m, "SyntheticCallsSettings")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.SyntheticCallsSettings])
 	return ret0
 }
 
-// SloCorrectionConfig indicates an expected call of SloCorrectionConfig.
-func (mr *MockInstanaAPIMockRecorder) SloCorrectionConfig() *gomock.Call {
+// SyntheticCallsSettings indicates an expected call of SyntheticCallsSettings.
+func (mr *MockInstanaAPIMockRecorder) SyntheticCallsSettings() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SloCorrectionConfig", reflect.TypeOf((*MockInstanaAPI)(nil).SloCorrectionConfig()))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyntheticCallsSettings", reflect.TypeOf((*MockInstanaAPI)(nil).SyntheticCallsSettings))
+}
+
+// SyntheticCredentials mocks base method.
+func (m *MockInstanaAPI) SyntheticCredentials() restapi.RestResource[*restapi.SyntheticCredential] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyntheticCredentials")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.SyntheticCredential])
+	return ret0
+}
+
+// SyntheticCredentials indicates an expected call of SyntheticCredentials.
+func (mr *MockInstanaAPIMockRecorder) SyntheticCredentials() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyntheticCredentials", reflect.TypeOf((*MockInstanaAPI)(nil).SyntheticCredentials))
 }
 
 // SyntheticLocation mocks base method.
@@ -258,79 +588,115 @@ func (mr *MockInstanaAPIMockRecorder) SyntheticTest() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyntheticTest", reflect.TypeOf((*MockInstanaAPI)(nil).SyntheticTest))
 }
 
-// WebsiteAlertConfig mocks base method.
-func (m *MockInstanaAPI) WebsiteAlertConfig() restapi.RestResource[*restapi.WebsiteAlertConfig] {
+// UserGroups mocks base method.
+func (m *MockInstanaAPI) UserGroups(email string) restapi.ReadOnlyRestResource[*restapi.Group] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WebsiteAlertConfig")
-	ret0, _ := ret[0].(restapi.RestResource[*restapi.WebsiteAlertConfig])
+	ret := m.ctrl.Call(m, "UserGroups", email)
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.Group])
 	return ret0
 }
 
-// WebsiteAlertConfig indicates an expected call of WebsiteAlertConfig.
-func (mr *MockInstanaAPIMockRecorder) WebsiteAlertConfig() *gomock.Call {
+// UserGroups indicates an expected call of UserGroups.
+func (mr *MockInstanaAPIMockRecorder) UserGroups(email any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteAlertConfig", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteAlertConfig))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGroups", reflect.TypeOf((*MockInstanaAPI)(nil).UserGroups), email)
 }
 
-// WebsiteMonitoringConfig mocks base method.
-func (m *MockInstanaAPI) WebsiteMonitoringConfig() restapi.RestResource[*restapi.WebsiteMonitoringConfig] {
+// UserInvitations mocks base method.
+func (m *MockInstanaAPI) UserInvitations() restapi.RestResource[*restapi.UserInvitation] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WebsiteMonitoringConfig")
-	ret0, _ := ret[0].(restapi.RestResource[*restapi.WebsiteMonitoringConfig])
+	ret := m.ctrl.Call(m, "UserInvitations")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.UserInvitation])
 	return ret0
 }
 
-// WebsiteMonitoringConfig indicates an expected call of WebsiteMonitoringConfig.
-func (mr *MockInstanaAPIMockRecorder) WebsiteMonitoringConfig() *gomock.Call {
+// UserInvitations indicates an expected call of UserInvitations.
+func (mr *MockInstanaAPIMockRecorder) UserInvitations() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteMonitoringConfig", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteMonitoringConfig))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserInvitations", reflect.TypeOf((*MockInstanaAPI)(nil).UserInvitations))
 }
 
-func (m *MockInstanaAPI) InfraAlertConfig() restapi.RestResource[*restapi.InfraAlertConfig] {
+// Users mocks base method.
+func (m *MockInstanaAPI) Users() restapi.ReadOnlyRestResource[*restapi.User] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InfraAlertConfig")
-	ret0, _ := ret[0].(restapi.RestResource[*restapi.InfraAlertConfig])
+	ret := m.ctrl.Call(m, "Users")
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.User])
 	return ret0
 }
 
-// AutomationActions mocks base method.
-func (m *MockInstanaAPI) AutomationActions() restapi.RestResource[*restapi.AutomationAction] {
+// Users indicates an expected call of Users.
+func (mr *MockInstanaAPIMockRecorder) Users() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Users", reflect.TypeOf((*MockInstanaAPI)(nil).Users))
+}
+
+// UsersOverview mocks base method.
+func (m *MockInstanaAPI) UsersOverview() restapi.ReadOnlyRestResource[*restapi.User] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AutomationAction")
-	ret0, _ := ret[0].(restapi.RestResource[*restapi.AutomationAction])
+	ret := m.ctrl.Call(m, "UsersOverview")
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.User])
 	return ret0
 }
 
-// AutomationPolicies mocks base method.
-func (m *MockInstanaAPI) AutomationPolicies() restapi.RestResource[*restapi.AutomationPolicy] {
+// UsersOverview indicates an expected call of UsersOverview.
+func (mr *MockInstanaAPIMockRecorder) UsersOverview() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsersOverview", reflect.TypeOf((*MockInstanaAPI)(nil).UsersOverview))
+}
+
+// Version mocks base method.
+func (m *MockInstanaAPI) Version(ctx context.Context) (*restapi.VersionInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AutomationPolicy")
-	ret0, _ := ret[0].(restapi.RestResource[*restapi.AutomationPolicy])
-	return ret0
+	ret := m.ctrl.Call(m, "Version", ctx)
+	ret0, _ := ret[0].(*restapi.VersionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// HostAgents mocks base method.
-func (m *MockInstanaAPI) HostAgents() restapi.ReadOnlyRestResource[*restapi.HostAgent] {
+// Version indicates an expected call of Version.
+func (mr *MockInstanaAPIMockRecorder) Version(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockInstanaAPI)(nil).Version), ctx)
+}
+
+// WebsiteAlertConfig mocks base method.
+func (m *MockInstanaAPI) WebsiteAlertConfig() restapi.RestResource[*restapi.WebsiteAlertConfig] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HostAgent")
-	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.HostAgent])
+	ret := m.ctrl.Call(m, "WebsiteAlertConfig")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.WebsiteAlertConfig])
 	return ret0
 }
 
-// AutomationActions indicates an expected call of AutomationActions.
-func (mr *MockInstanaAPIMockRecorder) AutomationActions() *gomock.Call {
+// WebsiteAlertConfig indicates an expected call of WebsiteAlertConfig.
+func (mr *MockInstanaAPIMockRecorder) WebsiteAlertConfig() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutomationAction", reflect.TypeOf((*MockInstanaAPI)(nil).AutomationActions))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteAlertConfig", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteAlertConfig))
 }
 
-// AutomationPolicies indicates an expected call of AutomationPolicies.
-func (mr *MockInstanaAPIMockRecorder) AutomationPolicies() *gomock.Call {
+// WebsiteMonitoringConfig mocks base method.
+func (m *MockInstanaAPI) WebsiteMonitoringConfig() restapi.RestResource[*restapi.WebsiteMonitoringConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebsiteMonitoringConfig")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.WebsiteMonitoringConfig])
+	return ret0
+}
+
+// WebsiteMonitoringConfig indicates an expected call of WebsiteMonitoringConfig.
+func (mr *MockInstanaAPIMockRecorder) WebsiteMonitoringConfig() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutomationPolicy", reflect.TypeOf((*MockInstanaAPI)(nil).AutomationPolicies))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteMonitoringConfig", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteMonitoringConfig))
 }
 
-// HostAgents indicates an expected call of AutomationPolicies.
-func (mr *MockInstanaAPIMockRecorder) HostAgents() *gomock.Call {
+// WebsiteSourceMapUploads mocks base method.
+func (m *MockInstanaAPI) WebsiteSourceMapUploads() restapi.RestResource[*restapi.WebsiteSourceMapUpload] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebsiteSourceMapUploads")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.WebsiteSourceMapUpload])
+	return ret0
+}
+
+// WebsiteSourceMapUploads indicates an expected call of WebsiteSourceMapUploads.
+func (mr *MockInstanaAPIMockRecorder) WebsiteSourceMapUploads() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostAgent", reflect.TypeOf((*MockInstanaAPI)(nil).HostAgents))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteSourceMapUploads", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteSourceMapUploads))
 }
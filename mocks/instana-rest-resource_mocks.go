@@ -1,10 +1,16 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: instana/restapi/instana-rest-resource.go
+//
+// Generated by this command:
+//
+//	mockgen -source=instana/restapi/instana-rest-resource.go -destination=mocks/instana-rest-resource_mocks.go -package=mocks
+//
 
 // Package mocks is a generated GoMock package.
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	restapi "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
@@ -72,91 +78,91 @@ func (m *MockRestResource[T]) EXPECT() *MockRestResourceMockRecorder[T] {
 }
 
 // Create mocks base method.
-func (m *MockRestResource[T]) Create(data T) (T, error) {
+func (m *MockRestResource[T]) Create(ctx context.Context, data T) (T, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", data)
+	ret := m.ctrl.Call(m, "Create", ctx, data)
 	ret0, _ := ret[0].(T)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockRestResourceMockRecorder[T]) Create(data interface{}) *gomock.Call {
+func (mr *MockRestResourceMockRecorder[T]) Create(ctx, data any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRestResource[T])(nil).Create), data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRestResource[T])(nil).Create), ctx, data)
 }
 
 // Delete mocks base method.
-func (m *MockRestResource[T]) Delete(data T) error {
+func (m *MockRestResource[T]) Delete(ctx context.Context, data T) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", data)
+	ret := m.ctrl.Call(m, "Delete", ctx, data)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockRestResourceMockRecorder[T]) Delete(data interface{}) *gomock.Call {
+func (mr *MockRestResourceMockRecorder[T]) Delete(ctx, data any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRestResource[T])(nil).Delete), data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRestResource[T])(nil).Delete), ctx, data)
 }
 
 // DeleteByID mocks base method.
-func (m *MockRestResource[T]) DeleteByID(id string) error {
+func (m *MockRestResource[T]) DeleteByID(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteByID", id)
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteByID indicates an expected call of DeleteByID.
-func (mr *MockRestResourceMockRecorder[T]) DeleteByID(id interface{}) *gomock.Call {
+func (mr *MockRestResourceMockRecorder[T]) DeleteByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockRestResource[T])(nil).DeleteByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockRestResource[T])(nil).DeleteByID), ctx, id)
 }
 
 // GetAll mocks base method.
-func (m *MockRestResource[T]) GetAll() (*[]T, error) {
+func (m *MockRestResource[T]) GetAll(ctx context.Context) (*[]T, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", ctx)
 	ret0, _ := ret[0].(*[]T)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockRestResourceMockRecorder[T]) GetAll() *gomock.Call {
+func (mr *MockRestResourceMockRecorder[T]) GetAll(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockRestResource[T])(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockRestResource[T])(nil).GetAll), ctx)
 }
 
 // GetOne mocks base method.
-func (m *MockRestResource[T]) GetOne(id string) (T, error) {
+func (m *MockRestResource[T]) GetOne(ctx context.Context, id string) (T, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOne", id)
+	ret := m.ctrl.Call(m, "GetOne", ctx, id)
 	ret0, _ := ret[0].(T)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOne indicates an expected call of GetOne.
-func (mr *MockRestResourceMockRecorder[T]) GetOne(id interface{}) *gomock.Call {
+func (mr *MockRestResourceMockRecorder[T]) GetOne(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockRestResource[T])(nil).GetOne), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockRestResource[T])(nil).GetOne), ctx, id)
 }
 
 // Update mocks base method.
-func (m *MockRestResource[T]) Update(data T) (T, error) {
+func (m *MockRestResource[T]) Update(ctx context.Context, data T) (T, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", data)
+	ret := m.ctrl.Call(m, "Update", ctx, data)
 	ret0, _ := ret[0].(T)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockRestResourceMockRecorder[T]) Update(data interface{}) *gomock.Call {
+func (mr *MockRestResourceMockRecorder[T]) Update(ctx, data any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRestResource[T])(nil).Update), data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRestResource[T])(nil).Update), ctx, data)
 }
 
 // MockReadOnlyRestResource is a mock of ReadOnlyRestResource interface.
@@ -183,48 +189,48 @@ func (m *MockReadOnlyRestResource[T]) EXPECT() *MockReadOnlyRestResourceMockReco
 }
 
 // GetAll mocks base method.
-func (m *MockReadOnlyRestResource[T]) GetAll() (*[]T, error) {
+func (m *MockReadOnlyRestResource[T]) GetAll(ctx context.Context) (*[]T, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", ctx)
 	ret0, _ := ret[0].(*[]T)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockReadOnlyRestResourceMockRecorder[T]) GetAll() *gomock.Call {
+func (mr *MockReadOnlyRestResourceMockRecorder[T]) GetAll(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockReadOnlyRestResource[T])(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockReadOnlyRestResource[T])(nil).GetAll), ctx)
 }
 
 // GetByQuery mocks base method.
-func (m *MockReadOnlyRestResource[T]) GetByQuery(queryParams map[string]string) (*[]T, error) {
+func (m *MockReadOnlyRestResource[T]) GetByQuery(ctx context.Context, queryParams map[string]string) (*[]T, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByQuery", queryParams)
+	ret := m.ctrl.Call(m, "GetByQuery", ctx, queryParams)
 	ret0, _ := ret[0].(*[]T)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByQuery indicates an expected call of GetAll.
-func (mr *MockReadOnlyRestResourceMockRecorder[T]) GetByQuery(queryParams map[string]string) *gomock.Call {
+// GetByQuery indicates an expected call of GetByQuery.
+func (mr *MockReadOnlyRestResourceMockRecorder[T]) GetByQuery(ctx, queryParams any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByQuery", reflect.TypeOf((*MockReadOnlyRestResource[T])(nil).GetByQuery), queryParams)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByQuery", reflect.TypeOf((*MockReadOnlyRestResource[T])(nil).GetByQuery), ctx, queryParams)
 }
 
 // GetOne mocks base method.
-func (m *MockReadOnlyRestResource[T]) GetOne(id string) (T, error) {
+func (m *MockReadOnlyRestResource[T]) GetOne(ctx context.Context, id string) (T, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOne", id)
+	ret := m.ctrl.Call(m, "GetOne", ctx, id)
 	ret0, _ := ret[0].(T)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOne indicates an expected call of GetOne.
-func (mr *MockReadOnlyRestResourceMockRecorder[T]) GetOne(id interface{}) *gomock.Call {
+func (mr *MockReadOnlyRestResourceMockRecorder[T]) GetOne(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockReadOnlyRestResource[T])(nil).GetOne), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockReadOnlyRestResource[T])(nil).GetOne), ctx, id)
 }
 
 // MockJSONUnmarshaller is a mock of JSONUnmarshaller interface.
@@ -260,7 +266,7 @@ func (m *MockJSONUnmarshaller[T]) Unmarshal(data []byte) (T, error) {
 }
 
 // Unmarshal indicates an expected call of Unmarshal.
-func (mr *MockJSONUnmarshallerMockRecorder[T]) Unmarshal(data interface{}) *gomock.Call {
+func (mr *MockJSONUnmarshallerMockRecorder[T]) Unmarshal(data any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unmarshal", reflect.TypeOf((*MockJSONUnmarshaller[T])(nil).Unmarshal), data)
 }
@@ -275,7 +281,7 @@ func (m *MockJSONUnmarshaller[T]) UnmarshalArray(data []byte) (*[]T, error) {
 }
 
 // UnmarshalArray indicates an expected call of UnmarshalArray.
-func (mr *MockJSONUnmarshallerMockRecorder[T]) UnmarshalArray(data interface{}) *gomock.Call {
+func (mr *MockJSONUnmarshallerMockRecorder[T]) UnmarshalArray(data any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmarshalArray", reflect.TypeOf((*MockJSONUnmarshaller[T])(nil).UnmarshalArray), data)
 }
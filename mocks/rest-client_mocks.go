@@ -1,10 +1,16 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: instana/restapi/rest-client.go
+//
+// Generated by this command:
+//
+//	mockgen -source=instana/restapi/rest-client.go -destination=mocks/rest-client_mocks.go -package=mocks
+//
 
 // Package mocks is a generated GoMock package.
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	restapi "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
@@ -35,135 +41,238 @@ func (m *MockRestClient) EXPECT() *MockRestClientMockRecorder {
 }
 
 // Delete mocks base method.
-func (m *MockRestClient) Delete(resourceID, resourceBasePath string) error {
+func (m *MockRestClient) Delete(ctx context.Context, resourceID, resourceBasePath string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", resourceID, resourceBasePath)
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceID, resourceBasePath)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockRestClientMockRecorder) Delete(resourceID, resourceBasePath interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) Delete(ctx, resourceID, resourceBasePath any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRestClient)(nil).Delete), resourceID, resourceBasePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRestClient)(nil).Delete), ctx, resourceID, resourceBasePath)
+}
+
+// DeleteByPath mocks base method.
+func (m *MockRestClient) DeleteByPath(ctx context.Context, resourcePath string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByPath", ctx, resourcePath)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByPath indicates an expected call of DeleteByPath.
+func (mr *MockRestClientMockRecorder) DeleteByPath(ctx, resourcePath any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByPath", reflect.TypeOf((*MockRestClient)(nil).DeleteByPath), ctx, resourcePath)
+}
+
+// DeleteByQuery mocks base method.
+func (m *MockRestClient) DeleteByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByQuery", ctx, resourcePath, queryParams)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByQuery indicates an expected call of DeleteByQuery.
+func (mr *MockRestClientMockRecorder) DeleteByQuery(ctx, resourcePath, queryParams any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByQuery", reflect.TypeOf((*MockRestClient)(nil).DeleteByQuery), ctx, resourcePath, queryParams)
 }
 
 // Get mocks base method.
-func (m *MockRestClient) Get(resourcePath string) ([]byte, error) {
+func (m *MockRestClient) Get(ctx context.Context, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", resourcePath)
+	ret := m.ctrl.Call(m, "Get", ctx, resourcePath)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockRestClientMockRecorder) Get(resourcePath interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) Get(ctx, resourcePath any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRestClient)(nil).Get), resourcePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRestClient)(nil).Get), ctx, resourcePath)
 }
 
 // GetByQuery mocks base method.
-func (m *MockRestClient) GetByQuery(resourcePath string, queryParams map[string]string) ([]byte, error) {
+func (m *MockRestClient) GetByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByQuery", resourcePath, queryParams)
+	ret := m.ctrl.Call(m, "GetByQuery", ctx, resourcePath, queryParams)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Get indicates an expected call of Get.
-func (mr *MockRestClientMockRecorder) GetByQuery(resourcePath, queryParams interface{}) *gomock.Call {
+// GetByQuery indicates an expected call of GetByQuery.
+func (mr *MockRestClientMockRecorder) GetByQuery(ctx, resourcePath, queryParams any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByQuery", reflect.TypeOf((*MockRestClient)(nil).Get), resourcePath, queryParams)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByQuery", reflect.TypeOf((*MockRestClient)(nil).GetByQuery), ctx, resourcePath, queryParams)
+}
+
+// GetContent mocks base method.
+func (m *MockRestClient) GetContent(ctx context.Context, resourcePath, mediaType string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContent", ctx, resourcePath, mediaType)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContent indicates an expected call of GetContent.
+func (mr *MockRestClientMockRecorder) GetContent(ctx, resourcePath, mediaType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContent", reflect.TypeOf((*MockRestClient)(nil).GetContent), ctx, resourcePath, mediaType)
 }
 
 // GetOne mocks base method.
-func (m *MockRestClient) GetOne(id, resourcePath string) ([]byte, error) {
+func (m *MockRestClient) GetOne(ctx context.Context, id, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOne", id, resourcePath)
+	ret := m.ctrl.Call(m, "GetOne", ctx, id, resourcePath)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOne indicates an expected call of GetOne.
-func (mr *MockRestClientMockRecorder) GetOne(id, resourcePath interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) GetOne(ctx, id, resourcePath any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockRestClient)(nil).GetOne), id, resourcePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockRestClient)(nil).GetOne), ctx, id, resourcePath)
 }
 
 // Post mocks base method.
-func (m *MockRestClient) Post(data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
+func (m *MockRestClient) Post(ctx context.Context, data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Post", data, resourcePath)
+	ret := m.ctrl.Call(m, "Post", ctx, data, resourcePath)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Post indicates an expected call of Post.
-func (mr *MockRestClientMockRecorder) Post(data, resourcePath interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) Post(ctx, data, resourcePath any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockRestClient)(nil).Post), data, resourcePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockRestClient)(nil).Post), ctx, data, resourcePath)
 }
 
 // PostByQuery mocks base method.
-func (m *MockRestClient) PostByQuery(resourcePath string, queryParams map[string]string) ([]byte, error) {
+func (m *MockRestClient) PostByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PostByQuery", resourcePath, queryParams)
+	ret := m.ctrl.Call(m, "PostByQuery", ctx, resourcePath, queryParams)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PostByQuery indicates an expected call of PostByQuery.
-func (mr *MockRestClientMockRecorder) PostByQuery(resourcePath, queryParams interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) PostByQuery(ctx, resourcePath, queryParams any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostByQuery", reflect.TypeOf((*MockRestClient)(nil).PostByQuery), ctx, resourcePath, queryParams)
+}
+
+// PostContent mocks base method.
+func (m *MockRestClient) PostContent(ctx context.Context, resourcePath, mediaType string, content []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostContent", ctx, resourcePath, mediaType, content)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostContent indicates an expected call of PostContent.
+func (mr *MockRestClientMockRecorder) PostContent(ctx, resourcePath, mediaType, content any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostByQuery", reflect.TypeOf((*MockRestClient)(nil).PostByQuery), resourcePath, queryParams)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostContent", reflect.TypeOf((*MockRestClient)(nil).PostContent), ctx, resourcePath, mediaType, content)
 }
 
 // PostWithID mocks base method.
-func (m *MockRestClient) PostWithID(data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
+func (m *MockRestClient) PostWithID(ctx context.Context, data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PostWithID", data, resourcePath)
+	ret := m.ctrl.Call(m, "PostWithID", ctx, data, resourcePath)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PostWithID indicates an expected call of PostWithID.
-func (mr *MockRestClientMockRecorder) PostWithID(data, resourcePath interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) PostWithID(ctx, data, resourcePath any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostWithID", reflect.TypeOf((*MockRestClient)(nil).PostWithID), data, resourcePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostWithID", reflect.TypeOf((*MockRestClient)(nil).PostWithID), ctx, data, resourcePath)
 }
 
 // Put mocks base method.
-func (m *MockRestClient) Put(data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
+func (m *MockRestClient) Put(ctx context.Context, data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Put", data, resourcePath)
+	ret := m.ctrl.Call(m, "Put", ctx, data, resourcePath)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Put indicates an expected call of Put.
-func (mr *MockRestClientMockRecorder) Put(data, resourcePath interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) Put(ctx, data, resourcePath any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockRestClient)(nil).Put), ctx, data, resourcePath)
+}
+
+// PutByPath mocks base method.
+func (m *MockRestClient) PutByPath(ctx context.Context, resourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutByPath", ctx, resourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutByPath indicates an expected call of PutByPath.
+func (mr *MockRestClientMockRecorder) PutByPath(ctx, resourcePath any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockRestClient)(nil).Put), data, resourcePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutByPath", reflect.TypeOf((*MockRestClient)(nil).PutByPath), ctx, resourcePath)
 }
 
 // PutByQuery mocks base method.
-func (m *MockRestClient) PutByQuery(resourcePath, is string, queryParams map[string]string) ([]byte, error) {
+func (m *MockRestClient) PutByQuery(ctx context.Context, resourcePath, id string, queryParams map[string]string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PutByQuery", resourcePath, is, queryParams)
+	ret := m.ctrl.Call(m, "PutByQuery", ctx, resourcePath, id, queryParams)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PutByQuery indicates an expected call of PutByQuery.
-func (mr *MockRestClientMockRecorder) PutByQuery(resourcePath, is, queryParams interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) PutByQuery(ctx, resourcePath, id, queryParams any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutByQuery", reflect.TypeOf((*MockRestClient)(nil).PutByQuery), ctx, resourcePath, id, queryParams)
+}
+
+// PutContent mocks base method.
+func (m *MockRestClient) PutContent(ctx context.Context, resourcePath, mediaType string, content []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutContent", ctx, resourcePath, mediaType, content)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutContent indicates an expected call of PutContent.
+func (mr *MockRestClientMockRecorder) PutContent(ctx, resourcePath, mediaType, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutContent", reflect.TypeOf((*MockRestClient)(nil).PutContent), ctx, resourcePath, mediaType, content)
+}
+
+// PutMultipartForm mocks base method.
+func (m *MockRestClient) PutMultipartForm(ctx context.Context, resourcePath string, fields map[string]string, fileField, fileName string, fileContent []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutMultipartForm", ctx, resourcePath, fields, fileField, fileName, fileContent)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutMultipartForm indicates an expected call of PutMultipartForm.
+func (mr *MockRestClientMockRecorder) PutMultipartForm(ctx, resourcePath, fields, fileField, fileName, fileContent any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutByQuery", reflect.TypeOf((*MockRestClient)(nil).PutByQuery), resourcePath, is, queryParams)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMultipartForm", reflect.TypeOf((*MockRestClient)(nil).PutMultipartForm), ctx, resourcePath, fields, fileField, fileName, fileContent)
 }